@@ -0,0 +1,189 @@
+// Command privacy handles data subject requests from the command line, using
+// the same configuration as the server.
+//
+//	go run ./cmd/privacy export -email jane@example.com -out jane.zip
+//	go run ./cmd/privacy erase -email jane@example.com -reason "request of 2024-01-01" -confirm
+//	go run ./cmd/privacy verify
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"super2025-backend/internal/application/services"
+	domainErrors "super2025-backend/internal/domain/errors"
+	"super2025-backend/internal/infrastructure/config"
+	"super2025-backend/internal/infrastructure/database"
+	"super2025-backend/internal/infrastructure/file_storage"
+	"super2025-backend/internal/infrastructure/repositories"
+
+	"go.uber.org/zap"
+)
+
+const usage = `Usage: privacy <command> [flags]
+
+Commands:
+  export   Write a ZIP of all personal data held about an email address
+  erase    Permanently erase the personal data held about an email address
+  verify   Check that the erasure receipts have not been tampered with
+`
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	logger, _ := zap.NewProduction()
+	defer logger.Sync()
+
+	var err error
+	switch os.Args[1] {
+	case "export":
+		err = runExport(os.Args[2:], logger)
+	case "erase":
+		err = runErase(os.Args[2:], logger)
+	case "verify":
+		err = runVerify(os.Args[2:], logger)
+	default:
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "privacy:", err)
+		os.Exit(1)
+	}
+}
+
+// runExport writes a subject access export to a file
+func runExport(args []string, logger *zap.Logger) error {
+	flags := flag.NewFlagSet("export", flag.ExitOnError)
+	email := flags.String("email", "", "email address of the data subject (required)")
+	out := flags.String("out", "", "output file (default subject-access-<date>.zip)")
+	actor := flags.String("actor", "cli", "who is running the export, recorded in the archive")
+	flags.Parse(args)
+
+	if *email == "" {
+		flags.Usage()
+		return errors.New("-email is required")
+	}
+	if *out == "" {
+		*out = fmt.Sprintf("subject-access-%s.zip", time.Now().UTC().Format("2006-01-02"))
+	}
+
+	privacyService, err := newPrivacyService(logger)
+	if err != nil {
+		return err
+	}
+
+	archive, err := privacyService.ExportSubjectData(context.Background(), *email, *actor)
+	if err != nil {
+		if errors.Is(err, domainErrors.ErrCandidateNotFound) {
+			return errors.New("no personal data is held for this email")
+		}
+		return err
+	}
+
+	if err := os.WriteFile(*out, archive, 0600); err != nil {
+		return fmt.Errorf("failed to write %s: %w", *out, err)
+	}
+	fmt.Printf("Wrote %s (%d bytes)\n", *out, len(archive))
+	return nil
+}
+
+// runErase erases the personal data held about an email address and prints the receipt
+func runErase(args []string, logger *zap.Logger) error {
+	flags := flag.NewFlagSet("erase", flag.ExitOnError)
+	email := flags.String("email", "", "email address of the data subject (required)")
+	reason := flags.String("reason", "", "why the data is erased, recorded on the receipt; must not contain personal data")
+	actor := flags.String("actor", "cli", "who is running the erasure, recorded on the receipt")
+	confirm := flags.Bool("confirm", false, "confirm the erasure, which cannot be undone")
+	flags.Parse(args)
+
+	if *email == "" {
+		flags.Usage()
+		return errors.New("-email is required")
+	}
+	if !*confirm {
+		return errors.New("erasure cannot be undone; pass -confirm to proceed")
+	}
+
+	privacyService, err := newPrivacyService(logger)
+	if err != nil {
+		return err
+	}
+
+	receipt, err := privacyService.EraseSubjectData(context.Background(), *email, *reason, *actor)
+	if err != nil {
+		if errors.Is(err, domainErrors.ErrCandidateNotFound) {
+			return errors.New("no personal data is held for this email")
+		}
+		return err
+	}
+	return printJSON(receipt)
+}
+
+// runVerify checks the erasure receipt chain and fails if it is broken
+func runVerify(args []string, logger *zap.Logger) error {
+	flags := flag.NewFlagSet("verify", flag.ExitOnError)
+	flags.Parse(args)
+
+	privacyService, err := newPrivacyService(logger)
+	if err != nil {
+		return err
+	}
+
+	result, err := privacyService.VerifyErasureReceipts(context.Background())
+	if err != nil {
+		return err
+	}
+	if err := printJSON(result); err != nil {
+		return err
+	}
+	if !result.Valid {
+		return fmt.Errorf("erasure receipt chain is broken at sequence %d", result.BrokenAt)
+	}
+	return nil
+}
+
+// printJSON writes v to stdout as indented JSON
+func printJSON(v interface{}) error {
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(v)
+}
+
+// newPrivacyService connects to the database and storage configured for the server
+func newPrivacyService(logger *zap.Logger) (*services.PrivacyService, error) {
+	cfg, err := config.Load()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load configuration: %w", err)
+	}
+
+	db, err := database.NewPostgresDB(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to database: %w", err)
+	}
+
+	localStorage, err := file_storage.NewLocalStorage(cfg, logger)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize local storage: %w", err)
+	}
+
+	return services.NewPrivacyService(
+		repositories.NewPostgresCandidateRepository(db),
+		repositories.NewPostgresApplicationRepository(db),
+		repositories.NewPostgresCommentRepository(db),
+		repositories.NewPostgresEmailLogRepository(db),
+		repositories.NewPostgresPrivacyRepository(db),
+		localStorage,
+		[]byte(cfg.Security.ErasureHashSecret),
+		logger,
+	), nil
+}
@@ -1,8 +1,11 @@
 package main
 
 import (
+	"context"
+	"crypto/rand"
 	"fmt"
 	"log"
+	_ "time/tzdata" // interview time zones must resolve even without system zoneinfo
 
 	"super2025-backend/internal/application/services"
 	"super2025-backend/internal/domain/entities"
@@ -11,7 +14,9 @@ import (
 	"super2025-backend/internal/infrastructure/email"
 	"super2025-backend/internal/infrastructure/file_storage"
 	"super2025-backend/internal/infrastructure/repositories"
+	"super2025-backend/internal/infrastructure/scheduler"
 	"super2025-backend/internal/presentation/handlers"
+	"super2025-backend/internal/presentation/middleware"
 	"super2025-backend/internal/presentation/routes"
 
 	"github.com/gin-contrib/cors"
@@ -36,39 +41,154 @@ func main() {
 	
 	// Auto-migrate (simple)
 	fmt.Println("Running migrations...")
-	if err := db.AutoMigrate(&entities.Application{}); err != nil {
+	if err := db.AutoMigrate(
+		&entities.Candidate{},
+		&entities.ReferralCode{},
+		&entities.Tag{},
+		&entities.Application{},
+		&entities.ApplicationAttribution{},
+		&entities.ApplicationAnswer{},
+		&entities.ApplicationStatusEvent{},
+		&entities.ApplicationComment{},
+		&entities.Position{},
+		&entities.PositionClosure{},
+		&entities.PositionQuestion{},
+		&entities.ScreeningRule{},
+		&entities.ScreeningResult{},
+		&entities.Interview{},
+		&entities.SchedulingInvitation{},
+		&entities.InterviewSlot{},
+		&entities.Scorecard{},
+		&entities.Offer{},
+		&entities.PortalSession{},
+		&entities.Inquiry{},
+		&entities.ConsultationAvailability{},
+		&entities.Consultation{},
+		&entities.Post{},
+		&entities.TeamMember{},
+		&entities.Project{},
+		&entities.Subscriber{},
+		&entities.EmailLog{},
+		&entities.ErasureReceipt{},
+	); err != nil {
 		log.Fatal("Failed to migrate database:", err)
 	}
+	if err := database.Backfill(db); err != nil {
+		log.Fatal("Failed to backfill database:", err)
+	}
 	fmt.Println("Migrations completed")
 
 	// Initialize repositories
 	applicationRepo := repositories.NewPostgresApplicationRepository(db)
+	positionRepo := repositories.NewPostgresPositionRepository(db)
+	candidateRepo := repositories.NewPostgresCandidateRepository(db)
+	commentRepo := repositories.NewPostgresCommentRepository(db)
+	tagRepo := repositories.NewPostgresTagRepository(db)
+	interviewRepo := repositories.NewPostgresInterviewRepository(db)
+	schedulingRepo := repositories.NewPostgresSchedulingRepository(db)
+	scorecardRepo := repositories.NewPostgresScorecardRepository(db)
+	offerRepo := repositories.NewPostgresOfferRepository(db)
+	portalRepo := repositories.NewPostgresPortalRepository(db)
+	inquiryRepo := repositories.NewPostgresInquiryRepository(db)
+	consultationRepo := repositories.NewPostgresConsultationRepository(db)
+	postRepo := repositories.NewPostgresPostRepository(db)
+	teamMemberRepo := repositories.NewPostgresTeamMemberRepository(db)
+	projectRepo := repositories.NewPostgresProjectRepository(db)
+	subscriberRepo := repositories.NewPostgresSubscriberRepository(db)
+	referralRepo := repositories.NewPostgresReferralCodeRepository(db)
+	screeningRepo := repositories.NewPostgresScreeningRepository(db)
+	emailLogRepo := repositories.NewPostgresEmailLogRepository(db)
+	privacyRepo := repositories.NewPostgresPrivacyRepository(db)
+	reportRepo := repositories.NewPostgresReportRepository(db)
 
 	// Initialize services
 	localStorage, err := file_storage.NewLocalStorage(cfg, logger)
 	if err != nil {
 		log.Fatal("Failed to initialize local storage:", err)
 	}
-	emailService := email.NewEmailService(cfg, logger)
-	applicationService := services.NewApplicationService(applicationRepo, localStorage, emailService, logger)
+	imageStorage, err := file_storage.NewLocalImageStorage(cfg, logger)
+	if err != nil {
+		log.Fatal("Failed to initialize image storage:", err)
+	}
+	emailService := email.NewEmailService(cfg, emailLogRepo, logger)
+	linkSecret := linkSigningSecret(cfg, logger)
+	applicationService := services.NewApplicationService(applicationRepo, positionRepo, referralRepo, localStorage, emailService, cfg.Application.FrontendURL+"/careers/withdraw", linkSecret, logger)
+	positionService := services.NewPositionService(positionRepo, applicationRepo, applicationService, emailService, logger)
+	candidateService := services.NewCandidateService(candidateRepo, applicationRepo, logger)
+	commentService := services.NewCommentService(commentRepo, applicationRepo, logger)
+	tagService := services.NewTagService(tagRepo, applicationRepo, logger)
+	interviewService := services.NewInterviewService(interviewRepo, applicationRepo, positionRepo, applicationService, emailService, logger)
+	schedulingService := services.NewSchedulingService(schedulingRepo, applicationRepo, positionRepo, interviewService, emailService, cfg.Application.FrontendURL+"/careers/schedule", logger)
+	scorecardService := services.NewScorecardService(scorecardRepo, applicationRepo, interviewRepo, logger)
+	offerService := services.NewOfferService(offerRepo, applicationRepo, positionRepo, applicationService, emailService, cfg.Application.FrontendURL+"/careers/offer", linkSecret, logger)
+	portalService := services.NewPortalService(portalRepo, candidateRepo, applicationRepo, positionRepo, emailService, cfg.Application.FrontendURL+"/careers/portal", logger)
+	inquiryService := services.NewInquiryService(inquiryRepo, emailService, logger)
+	consultationService := services.NewConsultationService(consultationRepo, emailService, logger)
+	teamService := services.NewTeamService(teamMemberRepo, imageStorage, logger)
+	projectService := services.NewProjectService(projectRepo, imageStorage, logger)
+	newsletterService := services.NewNewsletterService(subscriberRepo, emailService, cfg.Application.FrontendURL+"/newsletter", cfg.Application.BaseURL+"/api/v1/subscriptions", linkSecret, logger)
+	blogService := services.NewBlogService(postRepo, cfg.Application.FrontendURL+"/blog", cfg.Application.BaseURL+"/api/v1/blog", logger)
+	referralService := services.NewReferralService(referralRepo, logger)
+	screeningService := services.NewScreeningService(screeningRepo, applicationRepo, positionRepo, tagRepo, applicationService, emailService, logger)
+	applicationService.SetScreener(screeningService)
+	if cfg.Security.ErasureHashSecret == "" {
+		logger.Warn("ERASURE_HASH_SECRET is not set; erasure requests will be refused")
+	}
+	privacyService := services.NewPrivacyService(candidateRepo, applicationRepo, commentRepo, emailLogRepo, privacyRepo, localStorage, []byte(cfg.Security.ErasureHashSecret), logger)
+	retentionService := services.NewRetentionService(privacyRepo, localStorage, retentionRules(cfg, logger), logger)
+	reportService := services.NewReportService(reportRepo, logger)
 
 	// Initialize handlers
-	applicationHandler := handlers.NewApplicationHandler(applicationService, localStorage, logger, cfg)
+	applicationHandler := handlers.NewApplicationHandler(applicationService, scorecardService, localStorage, logger, cfg)
+	positionHandler := handlers.NewPositionHandler(positionService, logger, cfg)
+	candidateHandler := handlers.NewCandidateHandler(candidateService, logger)
+	commentHandler := handlers.NewCommentHandler(commentService, logger)
+	tagHandler := handlers.NewTagHandler(tagService, logger)
+	interviewHandler := handlers.NewInterviewHandler(interviewService, logger)
+	schedulingHandler := handlers.NewSchedulingHandler(schedulingService, logger)
+	scorecardHandler := handlers.NewScorecardHandler(scorecardService, logger)
+	offerHandler := handlers.NewOfferHandler(offerService, logger)
+	portalHandler := handlers.NewPortalHandler(portalService, logger)
+	inquiryHandler := handlers.NewInquiryHandler(inquiryService, logger)
+	consultationHandler := handlers.NewConsultationHandler(consultationService, logger)
+	blogHandler := handlers.NewBlogHandler(blogService, logger, cfg)
+	teamHandler := handlers.NewTeamHandler(teamService, logger, cfg)
+	projectHandler := handlers.NewProjectHandler(projectService, logger, cfg)
+	imageHandler := handlers.NewImageHandler(imageStorage, logger)
+	newsletterHandler := handlers.NewNewsletterHandler(newsletterService, logger)
+	referralHandler := handlers.NewReferralHandler(referralService, logger)
+	screeningHandler := handlers.NewScreeningHandler(screeningService, logger)
+	privacyHandler := handlers.NewPrivacyHandler(privacyService, retentionService, logger)
+	reportHandler := handlers.NewReportHandler(reportService, logger)
+
+	// Start background jobs
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go scheduler.Every(ctx, "position-schedule", cfg.Scheduler.PositionInterval, positionService.ProcessSchedule, logger)
+	go scheduler.Every(ctx, "offer-expiry", cfg.Scheduler.OfferInterval, offerService.ExpireOffers, logger)
+	go scheduler.Every(ctx, "screening", cfg.Scheduler.ScreeningInterval, screeningService.ProcessDue, logger)
+	go scheduler.Every(ctx, "retention", cfg.Scheduler.RetentionInterval, retentionService.Apply, logger)
 
 	// Setup Gin router
 	r := gin.Default()
 
+	// Per-IP rate limits rely on the client IP, so forwarded headers are only
+	// trusted from the configured proxies
+	if err := r.SetTrustedProxies(cfg.Server.TrustedProxies); err != nil {
+		log.Fatal("Invalid TRUSTED_PROXIES:", err)
+	}
+
 	// CORS middleware
 	r.Use(cors.New(cors.Config{
 		AllowOrigins:     []string{"*"},
 		AllowMethods:     []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},
-		AllowHeaders:     []string{"Origin", "Content-Type", "Content-Length", "Accept-Encoding", "X-CSRF-Token", "Authorization"},
+		AllowHeaders:     []string{"Origin", "Content-Type", "Content-Length", "Accept-Encoding", "X-CSRF-Token", "Authorization", middleware.AdminKeyHeader, middleware.ActorHeader},
 		ExposeHeaders:    []string{"Content-Length"},
 		AllowCredentials: true,
 	}))
 
 	// Setup routes (this will include CORS middleware)
-	routes.SetupRoutes(r, applicationHandler, cfg)
+	routes.SetupRoutes(r, applicationHandler, positionHandler, candidateHandler, commentHandler, tagHandler, interviewHandler, schedulingHandler, scorecardHandler, offerHandler, portalHandler, inquiryHandler, consultationHandler, blogHandler, teamHandler, projectHandler, imageHandler, newsletterHandler, referralHandler, screeningHandler, privacyHandler, reportHandler, cfg)
 
 	log.Printf("Server starting on port %s", cfg.Server.Port)
 	if err := r.Run(":" + cfg.Server.Port); err != nil {
@@ -84,4 +204,46 @@ func initDatabase(cfg *config.Config, logger *zap.Logger) *gorm.DB {
 	}
 	logger.Info("Database connected successfully")
 	return db
-} 
\ No newline at end of file
+}
+
+// linkSigningSecret returns the secret for signed candidate links. Without
+// LINK_SIGNING_SECRET a random one is used, so links stop working on restart.
+func linkSigningSecret(cfg *config.Config, logger *zap.Logger) []byte {
+	if cfg.Security.LinkSigningSecret != "" {
+		return []byte(cfg.Security.LinkSigningSecret)
+	}
+
+	logger.Warn("LINK_SIGNING_SECRET is not set; using a random secret, emailed links will not survive a restart")
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		logger.Fatal("Failed to generate link signing secret", zap.Error(err))
+	}
+	return secret
+}
+
+// retentionRules returns the enabled data retention rules, applied in order, and logs them.
+// Rules are enabled by giving them a number of days.
+func retentionRules(cfg *config.Config, logger *zap.Logger) []entities.RetentionRule {
+	action := entities.RetentionAction(cfg.Retention.Action)
+	rules := []entities.RetentionRule{
+		{Name: "rejected", Status: entities.StatusRejected, Days: cfg.Retention.RejectedDays, Action: action},
+		{Name: "withdrawn", Status: entities.StatusWithdrawn, Days: cfg.Retention.WithdrawnDays, Action: action},
+		{Name: "metadata", Days: cfg.Retention.MetadataDays, Action: entities.RetentionClearMetadata},
+	}
+
+	enabled := make([]entities.RetentionRule, 0, len(rules))
+	for _, rule := range rules {
+		if rule.Days <= 0 {
+			continue
+		}
+		logger.Info("Data retention rule enabled",
+			zap.String("rule", rule.Name),
+			zap.Int("days", rule.Days),
+			zap.String("action", string(rule.Action)))
+		enabled = append(enabled, rule)
+	}
+	if len(enabled) == 0 {
+		logger.Info("Data retention is disabled; set RETENTION_*_DAYS to enable it")
+	}
+	return enabled
+}
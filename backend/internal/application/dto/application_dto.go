@@ -13,36 +13,76 @@ type CreateApplicationRequest struct {
 	Email       string `json:"email" validate:"required,email" example:"john.doe@example.com"`
 	Phone       string `json:"phone" validate:"omitempty,min=10,max=20" example:"+1234567890"`
 	CoverLetter string `json:"cover_letter" validate:"required,min=50,max=2000" example:"I am excited to apply for this position..."`
+
+	// Optional employee referral code
+	ReferralCode string `json:"referral_code,omitempty" validate:"omitempty,max=32" example:"K7M2QX9P"`
+
+	// Where the candidate came from, as captured by the careers site
+	Attribution AttributionRequest `json:"attribution,omitempty"`
+
+	// Answers to the position's custom questions, keyed by question ID
+	Answers map[string]interface{} `json:"answers,omitempty"`
+}
+
+// AttributionRequest carries the campaign parameters and pages captured when a candidate applies
+type AttributionRequest struct {
+	UTMSource   string `json:"utm_source,omitempty" example:"linkedin"`
+	UTMMedium   string `json:"utm_medium,omitempty" example:"job_board"`
+	UTMCampaign string `json:"utm_campaign,omitempty" example:"spring-hiring"`
+	UTMTerm     string `json:"utm_term,omitempty" example:"golang"`
+	UTMContent  string `json:"utm_content,omitempty" example:"banner-a"`
+	Referrer    string `json:"referrer,omitempty" example:"https://www.linkedin.com/"`
+	LandingPage string `json:"landing_page,omitempty" example:"https://super2025.com/careers?utm_source=linkedin"`
 }
 
 // ApplicationResponse represents the response for application operations
 type ApplicationResponse struct {
-	ID          string                      `json:"id" example:"123e4567-e89b-12d3-a456-426614174000"`
-	PositionID  string                      `json:"position_id" example:"senior-ai-engineer"`
-	Name        string                      `json:"name" example:"John Doe"`
-	Email       string                      `json:"email" example:"john.doe@example.com"`
-	Phone       string                      `json:"phone" example:"+1234567890"`
-	CoverLetter string                      `json:"cover_letter" example:"I am excited to apply for this position..."`
-	ResumeURL   string                      `json:"resume_url" example:"https://example.com/resumes/123.pdf"`
-	Status      entities.ApplicationStatus  `json:"status" example:"pending"`
-	CreatedAt   time.Time                   `json:"created_at" example:"2023-01-01T12:00:00Z"`
-	UpdatedAt   time.Time                   `json:"updated_at" example:"2023-01-01T12:00:00Z"`
-	ProcessedAt *time.Time                  `json:"processed_at,omitempty" example:"2023-01-01T12:00:00Z"`
-	ProcessedBy string                      `json:"processed_by,omitempty" example:"admin@example.com"`
-	Notes       string                      `json:"notes,omitempty" example:"Candidate has strong background"`
+	ID          string                     `json:"id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	PositionID  string                     `json:"position_id" example:"senior-ai-engineer"`
+	CandidateID string                     `json:"candidate_id,omitempty" example:"123e4567-e89b-12d3-a456-426614174001"`
+	Name        string                     `json:"name" example:"John Doe"`
+	Email       string                     `json:"email" example:"john.doe@example.com"`
+	Phone       string                     `json:"phone" example:"+1234567890"`
+	CoverLetter string                     `json:"cover_letter" example:"I am excited to apply for this position..."`
+	ResumeURL   string                     `json:"resume_url" example:"https://example.com/resumes/123.pdf"`
+	Status      entities.ApplicationStatus `json:"status" example:"pending"`
+	CreatedAt   time.Time                  `json:"created_at" example:"2023-01-01T12:00:00Z"`
+	UpdatedAt   time.Time                  `json:"updated_at" example:"2023-01-01T12:00:00Z"`
+	ProcessedAt *time.Time                 `json:"processed_at,omitempty" example:"2023-01-01T12:00:00Z"`
+	ProcessedBy string                     `json:"processed_by,omitempty" example:"admin@example.com"`
+	Notes       string                     `json:"notes,omitempty" example:"Candidate has strong background"`
+	Tags        []string                   `json:"tags" example:"strong-go"`
+	Source      string                     `json:"source,omitempty" example:"referral"`
+
+	// Set when the candidate's personal data was erased
+	ErasedAt *time.Time `json:"erased_at,omitempty" example:"2024-01-01T12:00:00Z"`
+
+	// Referral code the candidate applied with, if any
+	ReferralCodeID string `json:"referral_code_id,omitempty" example:"123e4567-e89b-12d3-a456-426614174002"`
+
+	// Campaign and referrer details, when loaded
+	Attribution *entities.ApplicationAttribution `json:"attribution,omitempty"`
+
+	// Answers to the position's custom questions, when loaded
+	Answers []*AnswerResponse `json:"answers,omitempty"`
+
+	// Scorecard summary, only included for reviewers allowed to see scorecards
+	ScorecardSummary *ScorecardSummary `json:"scorecard_summary,omitempty"`
 }
 
 // ListApplicationsRequest represents the request to list applications
 type ListApplicationsRequest struct {
-	PositionID string                      `json:"position_id,omitempty" form:"position_id" example:"senior-ai-engineer"`
-	Status     entities.ApplicationStatus  `json:"status,omitempty" form:"status" example:"pending"`
-	Email      string                      `json:"email,omitempty" form:"email" example:"john.doe@example.com"`
-	DateFrom   string                      `json:"date_from,omitempty" form:"date_from" example:"2023-01-01"`
-	DateTo     string                      `json:"date_to,omitempty" form:"date_to" example:"2023-12-31"`
-	Page       int                         `json:"page" form:"page" validate:"min=1" example:"1"`
-	PageSize   int                         `json:"page_size" form:"page_size" validate:"min=1,max=100" example:"20"`
-	SortBy     string                      `json:"sort_by,omitempty" form:"sort_by" validate:"omitempty,oneof=created_at updated_at name email status" example:"created_at"`
-	SortOrder  string                      `json:"sort_order,omitempty" form:"sort_order" validate:"omitempty,oneof=asc desc" example:"desc"`
+	PositionID string                     `json:"position_id,omitempty" form:"position_id" example:"senior-ai-engineer"`
+	Status     entities.ApplicationStatus `json:"status,omitempty" form:"status" example:"pending"`
+	Email      string                     `json:"email,omitempty" form:"email" example:"john.doe@example.com"`
+	DateFrom   string                     `json:"date_from,omitempty" form:"date_from" example:"2023-01-01"`
+	DateTo     string                     `json:"date_to,omitempty" form:"date_to" example:"2023-12-31"`
+	Tags       []string                   `json:"tags,omitempty" form:"tags" example:"strong-go,relocation"`
+	TagMatch   string                     `json:"tag_match,omitempty" form:"tag_match" validate:"omitempty,oneof=any all" example:"any"`
+	Page       int                        `json:"page" form:"page" validate:"min=1" example:"1"`
+	PageSize   int                        `json:"page_size" form:"page_size" validate:"min=1,max=100" example:"20"`
+	SortBy     string                     `json:"sort_by,omitempty" form:"sort_by" validate:"omitempty,oneof=created_at updated_at name email status" example:"created_at"`
+	SortOrder  string                     `json:"sort_order,omitempty" form:"sort_order" validate:"omitempty,oneof=asc desc" example:"desc"`
 }
 
 // ListApplicationsResponse represents the response for listing applications
@@ -63,9 +103,59 @@ type PaginationResponse struct {
 
 // UpdateApplicationStatusRequest represents the request to update application status
 type UpdateApplicationStatusRequest struct {
-	Status      entities.ApplicationStatus `json:"status" validate:"required,oneof=pending reviewing interview offered rejected withdrawn" example:"reviewing"`
+	Status      entities.ApplicationStatus `json:"status" validate:"required,oneof=pending reviewing interview offered hired rejected withdrawn" example:"reviewing"`
 	ProcessedBy string                     `json:"processed_by" validate:"required" example:"admin@example.com"`
 	Notes       string                     `json:"notes,omitempty" example:"Candidate has been reviewed"`
+	Override    bool                       `json:"override,omitempty" example:"false"`
+}
+
+// StatusTransitionErrorResponse represents a refused status transition
+type StatusTransitionErrorResponse struct {
+	Error           string                       `json:"error" example:"Invalid status transition"`
+	CurrentStatus   entities.ApplicationStatus   `json:"current_status" example:"rejected"`
+	RequestedStatus entities.ApplicationStatus   `json:"requested_status" example:"offered"`
+	AllowedStatuses []entities.ApplicationStatus `json:"allowed_statuses"`
+}
+
+// StatusEventResponse represents a single entry of an application's status history
+type StatusEventResponse struct {
+	ID         string                     `json:"id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	FromStatus entities.ApplicationStatus `json:"from_status,omitempty" example:"pending"`
+	ToStatus   entities.ApplicationStatus `json:"to_status" example:"reviewing"`
+	Actor      string                     `json:"actor" example:"admin@example.com"`
+	Notes      string                     `json:"notes,omitempty" example:"Candidate has been reviewed"`
+	Override   bool                       `json:"override" example:"false"`
+	CreatedAt  time.Time                  `json:"created_at" example:"2023-01-01T12:00:00Z"`
+}
+
+// StageDurationResponse represents the time an application spent in one status
+type StageDurationResponse struct {
+	Status          entities.ApplicationStatus `json:"status" example:"reviewing"`
+	EnteredAt       time.Time                  `json:"entered_at" example:"2023-01-01T12:00:00Z"`
+	ExitedAt        *time.Time                 `json:"exited_at,omitempty" example:"2023-01-03T12:00:00Z"`
+	DurationSeconds int64                      `json:"duration_seconds" example:"172800"`
+}
+
+// ApplicationTimelineResponse represents the status history of an application
+type ApplicationTimelineResponse struct {
+	ApplicationID string                     `json:"application_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	CurrentStatus entities.ApplicationStatus `json:"current_status" example:"reviewing"`
+	Events        []*StatusEventResponse     `json:"events"`
+	Stages        []*StageDurationResponse   `json:"stages"`
+}
+
+// WithdrawApplicationRequest represents a candidate withdrawing their application
+type WithdrawApplicationRequest struct {
+	Reason string `json:"reason,omitempty" validate:"max=2000" example:"I have accepted another offer."`
+}
+
+// WithdrawalResponse is what the candidate sees when opening a withdraw link
+type WithdrawalResponse struct {
+	CandidateName string                     `json:"candidate_name" example:"John Doe"`
+	Position      string                     `json:"position" example:"Senior AI Engineer"`
+	Status        entities.ApplicationStatus `json:"status" example:"reviewing"`
+	AppliedAt     time.Time                  `json:"applied_at" example:"2023-01-01T12:00:00Z"`
+	CanWithdraw   bool                       `json:"can_withdraw" example:"true"`
 }
 
 // FileUploadResponse represents the response for file upload
@@ -90,20 +180,36 @@ type SuccessResponse struct {
 
 // ToApplicationResponse converts an entity to a response DTO
 func ToApplicationResponse(app *entities.Application) *ApplicationResponse {
+	var candidateID string
+	if app.CandidateID != nil {
+		candidateID = *app.CandidateID
+	}
+	var referralCodeID string
+	if app.ReferralCodeID != nil {
+		referralCodeID = *app.ReferralCodeID
+	}
+
 	return &ApplicationResponse{
-		ID:          app.ID,
-		PositionID:  app.PositionID,
-		Name:        app.Name,
-		Email:       app.Email,
-		Phone:       app.Phone,
-		CoverLetter: app.CoverLetter,
-		ResumeURL:   app.ResumeURL,
-		Status:      app.Status,
-		CreatedAt:   app.CreatedAt,
-		UpdatedAt:   app.UpdatedAt,
-		ProcessedAt: app.ProcessedAt,
-		ProcessedBy: app.ProcessedBy,
-		Notes:       app.Notes,
+		ID:             app.ID,
+		PositionID:     app.PositionID,
+		CandidateID:    candidateID,
+		Name:           app.Name,
+		Email:          app.Email,
+		Phone:          app.Phone,
+		CoverLetter:    app.CoverLetter,
+		ResumeURL:      app.ResumeURL,
+		Status:         app.Status,
+		CreatedAt:      app.CreatedAt,
+		UpdatedAt:      app.UpdatedAt,
+		ProcessedAt:    app.ProcessedAt,
+		ProcessedBy:    app.ProcessedBy,
+		Notes:          app.Notes,
+		Tags:           entities.TagNames(app.Tags),
+		Source:         app.Source,
+		ReferralCodeID: referralCodeID,
+		Attribution:    app.Attribution,
+		Answers:        ToAnswerResponseList(app.Answers),
+		ErasedAt:       app.ErasedAt,
 	}
 }
 
@@ -116,6 +222,45 @@ func ToApplicationResponseList(apps []*entities.Application) []*ApplicationRespo
 	return responses
 }
 
+// ToApplicationTimelineResponse converts an application and its status events to a timeline DTO
+func ToApplicationTimelineResponse(app *entities.Application, events []*entities.ApplicationStatusEvent, now time.Time) *ApplicationTimelineResponse {
+	response := &ApplicationTimelineResponse{
+		ApplicationID: app.ID,
+		CurrentStatus: app.Status,
+		Events:        ToStatusEventResponseList(events),
+	}
+
+	stages := entities.StageDurations(events, now)
+	response.Stages = make([]*StageDurationResponse, len(stages))
+	for i, stage := range stages {
+		response.Stages[i] = &StageDurationResponse{
+			Status:          stage.Status,
+			EnteredAt:       stage.EnteredAt,
+			ExitedAt:        stage.ExitedAt,
+			DurationSeconds: int64(stage.Duration.Seconds()),
+		}
+	}
+
+	return response
+}
+
+// ToStatusEventResponseList converts status history entries to response DTOs
+func ToStatusEventResponseList(events []*entities.ApplicationStatusEvent) []*StatusEventResponse {
+	responses := make([]*StatusEventResponse, len(events))
+	for i, event := range events {
+		responses[i] = &StatusEventResponse{
+			ID:         event.ID,
+			FromStatus: event.FromStatus,
+			ToStatus:   event.ToStatus,
+			Actor:      event.Actor,
+			Notes:      event.Notes,
+			Override:   event.Override,
+			CreatedAt:  event.CreatedAt,
+		}
+	}
+	return responses
+}
+
 // CalculatePagination calculates pagination information
 func CalculatePagination(page, pageSize int, total int64) PaginationResponse {
 	totalPages := int((total + int64(pageSize) - 1) / int64(pageSize))
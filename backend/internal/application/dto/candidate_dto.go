@@ -0,0 +1,31 @@
+package dto
+
+import (
+	"time"
+
+	"super2025-backend/internal/domain/entities"
+)
+
+// CandidateResponse represents a candidate profile with all of their applications
+type CandidateResponse struct {
+	ID           string                 `json:"id" example:"123e4567-e89b-12d3-a456-426614174001"`
+	Email        string                 `json:"email" example:"john.doe@example.com"`
+	Name         string                 `json:"name" example:"John Doe"`
+	Phone        string                 `json:"phone" example:"+1234567890"`
+	CreatedAt    time.Time              `json:"created_at" example:"2023-01-01T12:00:00Z"`
+	UpdatedAt    time.Time              `json:"updated_at" example:"2023-01-01T12:00:00Z"`
+	Applications []*ApplicationResponse `json:"applications"`
+}
+
+// ToCandidateResponse converts a candidate and their applications to a response DTO
+func ToCandidateResponse(candidate *entities.Candidate, applications []*entities.Application) *CandidateResponse {
+	return &CandidateResponse{
+		ID:           candidate.ID,
+		Email:        candidate.Email,
+		Name:         candidate.Name,
+		Phone:        candidate.Phone,
+		CreatedAt:    candidate.CreatedAt,
+		UpdatedAt:    candidate.UpdatedAt,
+		Applications: ToApplicationResponseList(applications),
+	}
+}
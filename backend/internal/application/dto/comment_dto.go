@@ -0,0 +1,75 @@
+package dto
+
+import (
+	"time"
+
+	"super2025-backend/internal/domain/entities"
+)
+
+// CreateCommentRequest represents the request to add a reviewer comment
+type CreateCommentRequest struct {
+	Body       string                     `json:"body" validate:"required,max=10000" example:"Strong Go background, worth a technical interview"`
+	Visibility entities.CommentVisibility `json:"visibility" validate:"omitempty,oneof=private team" example:"team"`
+	ParentID   string                     `json:"parent_id,omitempty" example:"123e4567-e89b-12d3-a456-426614174002"`
+}
+
+// UpdateCommentRequest represents the request to edit a reviewer comment
+type UpdateCommentRequest struct {
+	Body       string                     `json:"body" validate:"required,max=10000" example:"Strong Go background, scheduled a technical interview"`
+	Visibility entities.CommentVisibility `json:"visibility" validate:"omitempty,oneof=private team" example:"team"`
+}
+
+// CommentResponse represents a reviewer comment and its replies
+type CommentResponse struct {
+	ID         string                     `json:"id" example:"123e4567-e89b-12d3-a456-426614174002"`
+	ParentID   string                     `json:"parent_id,omitempty" example:""`
+	Author     string                     `json:"author" example:"reviewer@super2025.com"`
+	Body       string                     `json:"body" example:"Strong Go background, worth a technical interview"`
+	Visibility entities.CommentVisibility `json:"visibility" example:"team"`
+	CreatedAt  time.Time                  `json:"created_at" example:"2023-01-01T12:00:00Z"`
+	EditedAt   *time.Time                 `json:"edited_at,omitempty" example:"2023-01-01T13:00:00Z"`
+	Replies    []*CommentResponse         `json:"replies,omitempty"`
+}
+
+// ListCommentsResponse represents the comment threads of an application
+type ListCommentsResponse struct {
+	Comments []*CommentResponse `json:"comments"`
+}
+
+// ToCommentResponse converts a comment entity to a response DTO
+func ToCommentResponse(comment *entities.ApplicationComment) *CommentResponse {
+	var parentID string
+	if comment.ParentID != nil {
+		parentID = *comment.ParentID
+	}
+
+	return &CommentResponse{
+		ID:         comment.ID,
+		ParentID:   parentID,
+		Author:     comment.Author,
+		Body:       comment.Body,
+		Visibility: comment.Visibility,
+		CreatedAt:  comment.CreatedAt,
+		EditedAt:   comment.EditedAt,
+	}
+}
+
+// ToCommentThreads groups chronologically ordered comments into threads
+func ToCommentThreads(comments []*entities.ApplicationComment) []*CommentResponse {
+	threads := make([]*CommentResponse, 0, len(comments))
+	roots := make(map[string]*CommentResponse)
+
+	for _, comment := range comments {
+		response := ToCommentResponse(comment)
+		if comment.ParentID == nil {
+			threads = append(threads, response)
+			roots[comment.ID] = response
+			continue
+		}
+		if root, ok := roots[*comment.ParentID]; ok {
+			root.Replies = append(root.Replies, response)
+		}
+	}
+
+	return threads
+}
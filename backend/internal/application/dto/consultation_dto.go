@@ -0,0 +1,115 @@
+package dto
+
+import (
+	"time"
+
+	"super2025-backend/internal/domain/entities"
+)
+
+// AvailabilityWindowDTO represents a recurring weekly booking window
+type AvailabilityWindowDTO struct {
+	Day   string `json:"day" validate:"required" example:"monday"`
+	Start string `json:"start" validate:"required" example:"09:00"`
+	End   string `json:"end" validate:"required" example:"12:00"`
+}
+
+// UpdateAvailabilityRequest represents the request to configure consultation availability
+type UpdateAvailabilityRequest struct {
+	Timezone       string                  `json:"timezone" validate:"required" example:"Europe/London"`
+	SlotMinutes    int                     `json:"slot_minutes" validate:"required,min=5,max=480" example:"30"`
+	MinNoticeHours int                     `json:"min_notice_hours" validate:"min=0" example:"24"`
+	MaxDaysAhead   int                     `json:"max_days_ahead" validate:"required,min=1,max=365" example:"30"`
+	Windows        []AvailabilityWindowDTO `json:"windows" validate:"dive"`
+	HostName       string                  `json:"host_name,omitempty" example:"Super 2025 Sales"`
+	HostEmail      string                  `json:"host_email,omitempty" validate:"omitempty,email" example:"sales@super2025.com"`
+}
+
+// AvailabilityResponse represents the configured consultation availability
+type AvailabilityResponse struct {
+	Timezone       string                  `json:"timezone" example:"Europe/London"`
+	SlotMinutes    int                     `json:"slot_minutes" example:"30"`
+	MinNoticeHours int                     `json:"min_notice_hours" example:"24"`
+	MaxDaysAhead   int                     `json:"max_days_ahead" example:"30"`
+	Windows        []AvailabilityWindowDTO `json:"windows"`
+	HostName       string                  `json:"host_name,omitempty" example:"Super 2025 Sales"`
+	HostEmail      string                  `json:"host_email,omitempty" example:"sales@super2025.com"`
+	UpdatedBy      string                  `json:"updated_by,omitempty" example:"admin@super2025.com"`
+	UpdatedAt      time.Time               `json:"updated_at" example:"2023-01-01T12:00:00Z"`
+}
+
+// ConsultationSlotResponse represents an open consultation slot in the visitor's time zone
+type ConsultationSlotResponse struct {
+	StartAt time.Time `json:"start_at" example:"2023-01-10T09:00:00-05:00"`
+	EndAt   time.Time `json:"end_at" example:"2023-01-10T09:30:00-05:00"`
+}
+
+// ConsultationSlotsResponse lists open consultation slots
+type ConsultationSlotsResponse struct {
+	Timezone    string                      `json:"timezone" example:"America/New_York"`
+	SlotMinutes int                         `json:"slot_minutes" example:"30"`
+	Slots       []*ConsultationSlotResponse `json:"slots"`
+}
+
+// BookConsultationRequest represents a visitor booking a consultation slot
+type BookConsultationRequest struct {
+	StartAt  time.Time `json:"start_at" validate:"required" example:"2023-01-10T09:00:00-05:00"`
+	Name     string    `json:"name" validate:"required,min=2,max=100" example:"Jane Smith"`
+	Email    string    `json:"email" validate:"required,email" example:"jane@acme.com"`
+	Topic    string    `json:"topic" validate:"max=2000" example:"AI strategy for our support team"`
+	Timezone string    `json:"timezone,omitempty" example:"America/New_York"`
+}
+
+// ConsultationResponse represents a booked consultation
+type ConsultationResponse struct {
+	ID        string    `json:"id" example:"123e4567-e89b-12d3-a456-426614174010"`
+	Name      string    `json:"name" example:"Jane Smith"`
+	Email     string    `json:"email" example:"jane@acme.com"`
+	Topic     string    `json:"topic,omitempty" example:"AI strategy for our support team"`
+	StartAt   time.Time `json:"start_at" example:"2023-01-10T09:00:00-05:00"`
+	EndAt     time.Time `json:"end_at" example:"2023-01-10T09:30:00-05:00"`
+	Timezone  string    `json:"timezone" example:"America/New_York"`
+	CreatedAt time.Time `json:"created_at" example:"2023-01-01T12:00:00Z"`
+}
+
+// ListConsultationsResponse represents booked consultations
+type ListConsultationsResponse struct {
+	Consultations []*ConsultationResponse `json:"consultations"`
+}
+
+// ToAvailabilityResponse converts the availability entity to its response
+func ToAvailabilityResponse(availability *entities.ConsultationAvailability) *AvailabilityResponse {
+	windows := make([]AvailabilityWindowDTO, len(availability.Windows))
+	for i, window := range availability.Windows {
+		windows[i] = AvailabilityWindowDTO{Day: window.Day, Start: window.Start, End: window.End}
+	}
+	return &AvailabilityResponse{
+		Timezone:       availability.Timezone,
+		SlotMinutes:    availability.SlotMinutes,
+		MinNoticeHours: availability.MinNoticeHours,
+		MaxDaysAhead:   availability.MaxDaysAhead,
+		Windows:        windows,
+		HostName:       availability.HostName,
+		HostEmail:      availability.HostEmail,
+		UpdatedBy:      availability.UpdatedBy,
+		UpdatedAt:      availability.UpdatedAt,
+	}
+}
+
+// ToConsultationResponse converts a consultation entity to its response, with
+// times in the consultation's time zone
+func ToConsultationResponse(consultation *entities.Consultation) *ConsultationResponse {
+	loc, err := time.LoadLocation(consultation.Timezone)
+	if err != nil {
+		loc = time.UTC
+	}
+	return &ConsultationResponse{
+		ID:        consultation.ID,
+		Name:      consultation.Name,
+		Email:     consultation.Email,
+		Topic:     consultation.Topic,
+		StartAt:   consultation.StartAt.In(loc),
+		EndAt:     consultation.EndAt.In(loc),
+		Timezone:  consultation.Timezone,
+		CreatedAt: consultation.CreatedAt,
+	}
+}
@@ -0,0 +1,69 @@
+package dto
+
+import (
+	"time"
+
+	"super2025-backend/internal/domain/entities"
+)
+
+// CreateInquiryRequest represents a message sent through the contact form
+type CreateInquiryRequest struct {
+	Name        string `json:"name" validate:"required,min=2,max=100" example:"Jane Smith"`
+	Email       string `json:"email" validate:"required,email" example:"jane@acme.com"`
+	Company     string `json:"company,omitempty" validate:"max=200" example:"Acme Inc."`
+	Role        string `json:"role,omitempty" validate:"max=100" example:"CTO"`
+	ProjectType string `json:"projectType,omitempty" validate:"max=50" example:"ai-ml"`
+	Budget      string `json:"budget,omitempty" validate:"max=50" example:"50k-100k"`
+	Timeline    string `json:"timeline,omitempty" validate:"max=50" example:"1-3months"`
+	Message     string `json:"message" validate:"required,min=10,max=5000" example:"We are looking for help with a recommendation engine."`
+
+	// Website is a honeypot: the field is hidden on the form, so only bots fill it in
+	Website string `json:"website,omitempty"`
+}
+
+// InquiryResponse represents an inquiry in API responses
+type InquiryResponse struct {
+	ID          string                 `json:"id" example:"123e4567-e89b-12d3-a456-426614174009"`
+	Name        string                 `json:"name" example:"Jane Smith"`
+	Email       string                 `json:"email" example:"jane@acme.com"`
+	Company     string                 `json:"company,omitempty" example:"Acme Inc."`
+	Role        string                 `json:"role,omitempty" example:"CTO"`
+	ProjectType string                 `json:"project_type,omitempty" example:"ai-ml"`
+	Budget      string                 `json:"budget,omitempty" example:"50k-100k"`
+	Timeline    string                 `json:"timeline,omitempty" example:"1-3months"`
+	Message     string                 `json:"message" example:"We are looking for help with a recommendation engine."`
+	Status      entities.InquiryStatus `json:"status" example:"new"`
+	HandledBy   string                 `json:"handled_by,omitempty" example:"sales@super2025.com"`
+	CreatedAt   time.Time              `json:"created_at" example:"2023-01-01T12:00:00Z"`
+	UpdatedAt   time.Time              `json:"updated_at" example:"2023-01-01T12:00:00Z"`
+}
+
+// ListInquiriesResponse represents a page of inquiries
+type ListInquiriesResponse struct {
+	Inquiries  []*InquiryResponse `json:"inquiries"`
+	Pagination PaginationResponse `json:"pagination"`
+}
+
+// UpdateInquiryStatusRequest represents the request to move an inquiry along
+type UpdateInquiryStatusRequest struct {
+	Status entities.InquiryStatus `json:"status" validate:"required,oneof=new contacted closed" example:"contacted"`
+}
+
+// ToInquiryResponse converts an inquiry entity to its response
+func ToInquiryResponse(inquiry *entities.Inquiry) *InquiryResponse {
+	return &InquiryResponse{
+		ID:          inquiry.ID,
+		Name:        inquiry.Name,
+		Email:       inquiry.Email,
+		Company:     inquiry.Company,
+		Role:        inquiry.Role,
+		ProjectType: inquiry.ProjectType,
+		Budget:      inquiry.Budget,
+		Timeline:    inquiry.Timeline,
+		Message:     inquiry.Message,
+		Status:      inquiry.Status,
+		HandledBy:   inquiry.HandledBy,
+		CreatedAt:   inquiry.CreatedAt,
+		UpdatedAt:   inquiry.UpdatedAt,
+	}
+}
@@ -0,0 +1,68 @@
+package dto
+
+import (
+	"time"
+
+	"super2025-backend/internal/domain/entities"
+)
+
+// ScheduleInterviewRequest represents the request to schedule an interview
+type ScheduleInterviewRequest struct {
+	Interviewers []string  `json:"interviewers" validate:"required,min=1,dive,email" example:"lead@super2025.com"`
+	StartAt      time.Time `json:"start_at" validate:"required" example:"2023-01-10T14:00:00Z"`
+	EndAt        time.Time `json:"end_at" validate:"required" example:"2023-01-10T15:00:00Z"`
+	Timezone     string    `json:"timezone" validate:"required" example:"Europe/Berlin"`
+	Location     string    `json:"location,omitempty" example:"Office, meeting room 2"`
+	VideoLink    string    `json:"video_link,omitempty" validate:"omitempty,url" example:"https://meet.example.com/abc-defg-hij"`
+	Notes        string    `json:"notes,omitempty" example:"Technical interview with the platform team"`
+}
+
+// UpdateInterviewRequest represents the request to reschedule or edit an interview
+type UpdateInterviewRequest = ScheduleInterviewRequest
+
+// InterviewResponse represents an interview in API responses
+type InterviewResponse struct {
+	ID            string                   `json:"id" example:"123e4567-e89b-12d3-a456-426614174003"`
+	ApplicationID string                   `json:"application_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Interviewers  []string                 `json:"interviewers" example:"lead@super2025.com"`
+	StartAt       time.Time                `json:"start_at" example:"2023-01-10T14:00:00Z"`
+	EndAt         time.Time                `json:"end_at" example:"2023-01-10T15:00:00Z"`
+	Timezone      string                   `json:"timezone" example:"Europe/Berlin"`
+	Location      string                   `json:"location,omitempty" example:"Office, meeting room 2"`
+	VideoLink     string                   `json:"video_link,omitempty" example:"https://meet.example.com/abc-defg-hij"`
+	Notes         string                   `json:"notes,omitempty" example:"Technical interview with the platform team"`
+	Status        entities.InterviewStatus `json:"status" example:"scheduled"`
+	Sequence      int                      `json:"sequence" example:"0"`
+	CreatedBy     string                   `json:"created_by" example:"recruiter@super2025.com"`
+	CancelledBy   string                   `json:"cancelled_by,omitempty" example:""`
+	CancelledAt   *time.Time               `json:"cancelled_at,omitempty"`
+	CreatedAt     time.Time                `json:"created_at" example:"2023-01-01T12:00:00Z"`
+	UpdatedAt     time.Time                `json:"updated_at" example:"2023-01-01T12:00:00Z"`
+}
+
+// ListInterviewsResponse represents the interviews of an application
+type ListInterviewsResponse struct {
+	Interviews []*InterviewResponse `json:"interviews"`
+}
+
+// ToInterviewResponse converts an interview entity to a response DTO
+func ToInterviewResponse(interview *entities.Interview) *InterviewResponse {
+	return &InterviewResponse{
+		ID:            interview.ID,
+		ApplicationID: interview.ApplicationID,
+		Interviewers:  nonNilStrings(interview.Interviewers),
+		StartAt:       interview.StartAt,
+		EndAt:         interview.EndAt,
+		Timezone:      interview.Timezone,
+		Location:      interview.Location,
+		VideoLink:     interview.VideoLink,
+		Notes:         interview.Notes,
+		Status:        interview.Status,
+		Sequence:      interview.Sequence,
+		CreatedBy:     interview.CreatedBy,
+		CancelledBy:   interview.CancelledBy,
+		CancelledAt:   interview.CancelledAt,
+		CreatedAt:     interview.CreatedAt,
+		UpdatedAt:     interview.UpdatedAt,
+	}
+}
@@ -0,0 +1,110 @@
+package dto
+
+import (
+	"time"
+
+	"super2025-backend/internal/domain/entities"
+)
+
+// offerDateFormat is the format of an offer's start date
+const offerDateFormat = "2006-01-02"
+
+// CreateOfferRequest represents the request to make an offer
+type CreateOfferRequest struct {
+	Salary    int64      `json:"salary" validate:"required,gt=0" example:"85000"`
+	Currency  string     `json:"currency" validate:"required,len=3" example:"EUR"`
+	StartDate string     `json:"start_date" validate:"required" example:"2023-03-01"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty" example:"2023-01-20T17:00:00Z"`
+	Terms     string     `json:"terms" example:"Full-time, 30 days paid vacation, hybrid work."`
+}
+
+// OfferResponse represents an offer in API responses
+type OfferResponse struct {
+	ID            string               `json:"id" example:"123e4567-e89b-12d3-a456-426614174007"`
+	ApplicationID string               `json:"application_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Salary        int64                `json:"salary" example:"85000"`
+	Currency      string               `json:"currency" example:"EUR"`
+	StartDate     string               `json:"start_date" example:"2023-03-01"`
+	ExpiresAt     time.Time            `json:"expires_at" example:"2023-01-20T17:00:00Z"`
+	Terms         string               `json:"terms" example:"Full-time, 30 days paid vacation, hybrid work."`
+	Status        entities.OfferStatus `json:"status" example:"pending"`
+	CreatedBy     string               `json:"created_by,omitempty" example:"recruiter@super2025.com"`
+	RespondedAt   *time.Time           `json:"responded_at,omitempty"`
+	DeclineReason string               `json:"decline_reason,omitempty"`
+	RescindedBy   string               `json:"rescinded_by,omitempty"`
+	CreatedAt     time.Time            `json:"created_at" example:"2023-01-13T12:00:00Z"`
+
+	// Link is only returned when the offer is created
+	Link string `json:"link,omitempty" example:"http://localhost:3000/careers/offer/123e4567-e89b-12d3-a456-426614174007.Zm9v..."`
+}
+
+// ListOffersResponse represents the offers of an application
+type ListOffersResponse struct {
+	Offers []*OfferResponse `json:"offers"`
+}
+
+// OfferLetterResponse is what the candidate sees when opening an offer link
+type OfferLetterResponse struct {
+	CandidateName string               `json:"candidate_name" example:"John Doe"`
+	Position      string               `json:"position" example:"Senior AI Engineer"`
+	Salary        int64                `json:"salary" example:"85000"`
+	Currency      string               `json:"currency" example:"EUR"`
+	StartDate     string               `json:"start_date" example:"2023-03-01"`
+	ExpiresAt     time.Time            `json:"expires_at" example:"2023-01-20T17:00:00Z"`
+	Terms         string               `json:"terms" example:"Full-time, 30 days paid vacation, hybrid work."`
+	Status        entities.OfferStatus `json:"status" example:"pending"`
+	RespondedAt   *time.Time           `json:"responded_at,omitempty"`
+}
+
+// OfferDecision is the candidate's answer to an offer
+type OfferDecision string
+
+const (
+	OfferDecisionAccept  OfferDecision = "accept"
+	OfferDecisionDecline OfferDecision = "decline"
+)
+
+// RespondToOfferRequest represents the candidate's answer to an offer
+type RespondToOfferRequest struct {
+	Decision OfferDecision `json:"decision" validate:"required,oneof=accept decline" example:"accept"`
+	Reason   string        `json:"reason,omitempty" example:"I have accepted another offer."`
+}
+
+// ParseOfferDate parses an offer start date such as 2023-03-01
+func ParseOfferDate(value string) (time.Time, error) {
+	return time.Parse(offerDateFormat, value)
+}
+
+// ToOfferResponse converts an offer entity to a response DTO
+func ToOfferResponse(offer *entities.Offer) *OfferResponse {
+	return &OfferResponse{
+		ID:            offer.ID,
+		ApplicationID: offer.ApplicationID,
+		Salary:        offer.Salary,
+		Currency:      offer.Currency,
+		StartDate:     offer.StartDate.Format(offerDateFormat),
+		ExpiresAt:     offer.ExpiresAt,
+		Terms:         offer.Terms,
+		Status:        offer.Status,
+		CreatedBy:     offer.CreatedBy,
+		RespondedAt:   offer.RespondedAt,
+		DeclineReason: offer.DeclineReason,
+		RescindedBy:   offer.RescindedBy,
+		CreatedAt:     offer.CreatedAt,
+	}
+}
+
+// ToOfferLetterResponse converts an offer to what the candidate sees
+func ToOfferLetterResponse(offer *entities.Offer, candidateName, position string) *OfferLetterResponse {
+	return &OfferLetterResponse{
+		CandidateName: candidateName,
+		Position:      position,
+		Salary:        offer.Salary,
+		Currency:      offer.Currency,
+		StartDate:     offer.StartDate.Format(offerDateFormat),
+		ExpiresAt:     offer.ExpiresAt,
+		Terms:         offer.Terms,
+		Status:        offer.Status,
+		RespondedAt:   offer.RespondedAt,
+	}
+}
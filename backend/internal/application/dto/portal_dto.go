@@ -0,0 +1,39 @@
+package dto
+
+import (
+	"time"
+
+	"super2025-backend/internal/domain/entities"
+)
+
+// PortalLoginRequest represents a candidate asking for a login link
+type PortalLoginRequest struct {
+	Email string `json:"email" validate:"required,email" example:"john.doe@example.com"`
+}
+
+// PortalSessionRequest represents a candidate redeeming a login link
+type PortalSessionRequest struct {
+	Token string `json:"token" validate:"required" example:"3q2-7wEjR..."`
+}
+
+// PortalSessionResponse carries the session token for the candidate portal
+type PortalSessionResponse struct {
+	SessionToken string    `json:"session_token" example:"Zk9yX2V4YW1wbGU..."`
+	ExpiresAt    time.Time `json:"expires_at" example:"2023-01-02T12:00:00Z"`
+}
+
+// PortalApplicationResponse is an application as the candidate sees it, without internal details
+type PortalApplicationResponse struct {
+	ID        string                     `json:"id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Position  string                     `json:"position" example:"Senior AI Engineer"`
+	Status    entities.ApplicationStatus `json:"status" example:"reviewing"`
+	AppliedAt time.Time                  `json:"applied_at" example:"2023-01-01T12:00:00Z"`
+	UpdatedAt time.Time                  `json:"updated_at" example:"2023-01-03T09:30:00Z"`
+}
+
+// PortalApplicationsResponse lists a candidate's applications in the portal
+type PortalApplicationsResponse struct {
+	Name         string                       `json:"name" example:"John Doe"`
+	Email        string                       `json:"email" example:"john.doe@example.com"`
+	Applications []*PortalApplicationResponse `json:"applications"`
+}
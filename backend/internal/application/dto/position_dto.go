@@ -0,0 +1,133 @@
+package dto
+
+import (
+	"time"
+
+	"super2025-backend/internal/domain/entities"
+)
+
+// CreatePositionRequest represents the request to create a new position
+type CreatePositionRequest struct {
+	ID             string                  `json:"id,omitempty" example:"senior-ai-engineer"`
+	Title          string                  `json:"title" validate:"required,min=2,max=200" example:"Senior AI Engineer"`
+	Department     string                  `json:"department" example:"Engineering"`
+	Location       string                  `json:"location" example:"Remote"`
+	EmploymentType entities.EmploymentType `json:"employment_type" validate:"omitempty,oneof=full_time part_time contract internship" example:"full_time"`
+	Description    string                  `json:"description" example:"Join our AI team to build machine learning solutions."`
+	Requirements   []string                `json:"requirements" example:"5+ years in AI/ML development"`
+	Benefits       []string                `json:"benefits" example:"Remote-first culture"`
+	Status         entities.PositionStatus `json:"status" validate:"omitempty,oneof=draft open closed" example:"draft"`
+
+	// Lifecycle
+	OpenAt        *time.Time                 `json:"open_at,omitempty" example:"2023-01-01T09:00:00Z"`
+	CloseAt       *time.Time                 `json:"close_at,omitempty" example:"2023-03-01T17:00:00Z"`
+	OnCloseStatus entities.ApplicationStatus `json:"on_close_status,omitempty" validate:"omitempty,oneof=reviewing rejected withdrawn" example:"rejected"`
+	NotifyOnClose bool                       `json:"notify_on_close" example:"true"`
+}
+
+// UpdatePositionRequest represents the request to update a position
+type UpdatePositionRequest struct {
+	Title          string                  `json:"title" validate:"required,min=2,max=200" example:"Senior AI Engineer"`
+	Department     string                  `json:"department" example:"Engineering"`
+	Location       string                  `json:"location" example:"Remote"`
+	EmploymentType entities.EmploymentType `json:"employment_type" validate:"omitempty,oneof=full_time part_time contract internship" example:"full_time"`
+	Description    string                  `json:"description" example:"Join our AI team to build machine learning solutions."`
+	Requirements   []string                `json:"requirements" example:"5+ years in AI/ML development"`
+	Benefits       []string                `json:"benefits" example:"Remote-first culture"`
+	Status         entities.PositionStatus `json:"status" validate:"omitempty,oneof=draft open closed" example:"open"`
+
+	// Lifecycle
+	OpenAt        *time.Time                 `json:"open_at,omitempty" example:"2023-01-01T09:00:00Z"`
+	CloseAt       *time.Time                 `json:"close_at,omitempty" example:"2023-03-01T17:00:00Z"`
+	OnCloseStatus entities.ApplicationStatus `json:"on_close_status,omitempty" validate:"omitempty,oneof=reviewing rejected withdrawn" example:"rejected"`
+	NotifyOnClose bool                       `json:"notify_on_close" example:"true"`
+}
+
+// PositionResponse represents the response for position operations
+type PositionResponse struct {
+	ID             string                  `json:"id" example:"senior-ai-engineer"`
+	Title          string                  `json:"title" example:"Senior AI Engineer"`
+	Department     string                  `json:"department" example:"Engineering"`
+	Location       string                  `json:"location" example:"Remote"`
+	EmploymentType entities.EmploymentType `json:"employment_type" example:"full_time"`
+	Description    string                  `json:"description" example:"Join our AI team to build machine learning solutions."`
+	Requirements   []string                `json:"requirements"`
+	Benefits       []string                `json:"benefits"`
+	Status         entities.PositionStatus `json:"status" example:"open"`
+
+	// Lifecycle
+	OpenAt        *time.Time                 `json:"open_at,omitempty" example:"2023-01-01T09:00:00Z"`
+	CloseAt       *time.Time                 `json:"close_at,omitempty" example:"2023-03-01T17:00:00Z"`
+	ClosedAt      *time.Time                 `json:"closed_at,omitempty" example:"2023-03-01T17:00:00Z"`
+	OnCloseStatus entities.ApplicationStatus `json:"on_close_status,omitempty" example:"rejected"`
+	NotifyOnClose bool                       `json:"notify_on_close" example:"true"`
+
+	CreatedAt time.Time `json:"created_at" example:"2023-01-01T12:00:00Z"`
+	UpdatedAt time.Time `json:"updated_at" example:"2023-01-01T12:00:00Z"`
+}
+
+// ListPositionsRequest represents the request to list positions
+type ListPositionsRequest struct {
+	Status         entities.PositionStatus `json:"status,omitempty" form:"status" example:"open"`
+	Department     string                  `json:"department,omitempty" form:"department" example:"Engineering"`
+	EmploymentType entities.EmploymentType `json:"employment_type,omitempty" form:"employment_type" example:"full_time"`
+	Page           int                     `json:"page" form:"page" validate:"min=1" example:"1"`
+	PageSize       int                     `json:"page_size" form:"page_size" validate:"min=1,max=100" example:"20"`
+}
+
+// ListPositionsResponse represents the response for listing positions
+type ListPositionsResponse struct {
+	Positions  []*PositionResponse `json:"positions"`
+	Pagination PaginationResponse  `json:"pagination"`
+}
+
+// ClosePositionRequest represents the request to close a position
+type ClosePositionRequest struct {
+	ClosedBy string `json:"closed_by" example:"hr@super2025.com"`
+}
+
+// PositionClosureResponse represents the outcome of closing a position
+type PositionClosureResponse struct {
+	Position      *PositionResponse         `json:"position"`
+	AlreadyClosed bool                      `json:"already_closed" example:"false"`
+	Closure       *entities.PositionClosure `json:"closure,omitempty"`
+}
+
+// ToPositionResponse converts an entity to a response DTO
+func ToPositionResponse(position *entities.Position) *PositionResponse {
+	return &PositionResponse{
+		ID:             position.ID,
+		Title:          position.Title,
+		Department:     position.Department,
+		Location:       position.Location,
+		EmploymentType: position.EmploymentType,
+		Description:    position.Description,
+		Requirements:   nonNilStrings(position.Requirements),
+		Benefits:       nonNilStrings(position.Benefits),
+		Status:         position.Status,
+		OpenAt:         position.OpenAt,
+		CloseAt:        position.CloseAt,
+		ClosedAt:       position.ClosedAt,
+		OnCloseStatus:  position.OnCloseStatus,
+		NotifyOnClose:  position.NotifyOnClose,
+		CreatedAt:      position.CreatedAt,
+		UpdatedAt:      position.UpdatedAt,
+	}
+}
+
+// ToPositionResponseList converts a slice of entities to response DTOs
+func ToPositionResponseList(positions []*entities.Position) []*PositionResponse {
+	responses := make([]*PositionResponse, len(positions))
+	for i, position := range positions {
+		responses[i] = ToPositionResponse(position)
+	}
+	return responses
+}
+
+// nonNilStrings ensures lists are serialized as [] rather than null
+func nonNilStrings(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
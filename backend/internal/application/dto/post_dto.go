@@ -0,0 +1,100 @@
+package dto
+
+import (
+	"strings"
+	"time"
+
+	"super2025-backend/internal/domain/entities"
+)
+
+// wordsPerMinute is the reading speed used to estimate reading time
+const wordsPerMinute = 200
+
+// CreatePostRequest represents the request to create a blog post
+type CreatePostRequest struct {
+	Slug        string              `json:"slug,omitempty" example:"future-of-ai"`
+	Title       string              `json:"title" validate:"required,min=2,max=200" example:"The Future of AI in Enterprise Applications"`
+	Excerpt     string              `json:"excerpt" validate:"max=500" example:"Exploring how custom LLMs are revolutionizing business processes."`
+	Body        string              `json:"body" validate:"required" example:"## Introduction\n\nLarge language models..."`
+	Category    string              `json:"category" validate:"max=100" example:"AI & Machine Learning"`
+	Author      string              `json:"author" validate:"required,min=2,max=100" example:"Priya Sharma"`
+	CoverImage  string              `json:"cover_image,omitempty" example:"/images/blog/ai-enterprise.jpg"`
+	Status      entities.PostStatus `json:"status" validate:"omitempty,oneof=draft published" example:"draft"`
+	PublishedAt *time.Time          `json:"published_at,omitempty" example:"2024-12-15T09:00:00Z"`
+}
+
+// UpdatePostRequest represents the request to update a blog post; it replaces every field
+type UpdatePostRequest = CreatePostRequest
+
+// PostSummaryResponse represents a blog post in listings, without its body
+type PostSummaryResponse struct {
+	ID             string              `json:"id" example:"123e4567-e89b-12d3-a456-426614174011"`
+	Slug           string              `json:"slug" example:"future-of-ai"`
+	Title          string              `json:"title" example:"The Future of AI in Enterprise Applications"`
+	Excerpt        string              `json:"excerpt" example:"Exploring how custom LLMs are revolutionizing business processes."`
+	Category       string              `json:"category" example:"AI & Machine Learning"`
+	CategorySlug   string              `json:"category_slug" example:"ai-machine-learning"`
+	Author         string              `json:"author" example:"Priya Sharma"`
+	CoverImage     string              `json:"cover_image,omitempty" example:"/images/blog/ai-enterprise.jpg"`
+	Status         entities.PostStatus `json:"status" example:"published"`
+	PublishedAt    *time.Time          `json:"published_at,omitempty" example:"2024-12-15T09:00:00Z"`
+	ReadingMinutes int                 `json:"reading_minutes" example:"8"`
+	CreatedAt      time.Time           `json:"created_at" example:"2024-12-14T12:00:00Z"`
+	UpdatedAt      time.Time           `json:"updated_at" example:"2024-12-15T09:00:00Z"`
+}
+
+// PostResponse represents a full blog post
+type PostResponse struct {
+	PostSummaryResponse
+	Body string `json:"body" example:"## Introduction\n\nLarge language models..."`
+}
+
+// ListPostsRequest represents the request to list blog posts
+type ListPostsRequest struct {
+	Status   entities.PostStatus `json:"status,omitempty" form:"status" example:"published"`
+	Category string              `json:"category,omitempty" form:"category" example:"ai-machine-learning"`
+	Page     int                 `json:"page" form:"page" validate:"min=1" example:"1"`
+	PageSize int                 `json:"page_size" form:"page_size" validate:"min=1,max=100" example:"10"`
+}
+
+// ListPostsResponse represents a page of blog posts
+type ListPostsResponse struct {
+	Posts      []*PostSummaryResponse `json:"posts"`
+	Pagination PaginationResponse     `json:"pagination"`
+}
+
+// ListPostCategoriesResponse represents the blog categories in use
+type ListPostCategoriesResponse struct {
+	Categories []*entities.PostCategory `json:"categories"`
+}
+
+// ToPostSummaryResponse converts a post entity to its listing response
+func ToPostSummaryResponse(post *entities.Post) *PostSummaryResponse {
+	minutes := (len(strings.Fields(post.Body)) + wordsPerMinute - 1) / wordsPerMinute
+	if minutes < 1 {
+		minutes = 1
+	}
+	return &PostSummaryResponse{
+		ID:             post.ID,
+		Slug:           post.Slug,
+		Title:          post.Title,
+		Excerpt:        post.Excerpt,
+		Category:       post.Category,
+		CategorySlug:   post.CategorySlug,
+		Author:         post.Author,
+		CoverImage:     post.CoverImage,
+		Status:         post.Status,
+		PublishedAt:    post.PublishedAt,
+		ReadingMinutes: minutes,
+		CreatedAt:      post.CreatedAt,
+		UpdatedAt:      post.UpdatedAt,
+	}
+}
+
+// ToPostResponse converts a post entity to its full response
+func ToPostResponse(post *entities.Post) *PostResponse {
+	return &PostResponse{
+		PostSummaryResponse: *ToPostSummaryResponse(post),
+		Body:                post.Body,
+	}
+}
@@ -0,0 +1,204 @@
+package dto
+
+import (
+	"time"
+
+	"super2025-backend/internal/domain/entities"
+)
+
+// ExportSubjectDataRequest represents a request for the personal data held about an email address
+type ExportSubjectDataRequest struct {
+	Email string `json:"email" validate:"required,email" example:"john.doe@example.com"`
+}
+
+// SubjectAccessExport is the personal data held about an email address. It is
+// written as data.json into a subject access export archive.
+type SubjectAccessExport struct {
+	Email        string                    `json:"email" example:"john.doe@example.com"`
+	GeneratedAt  time.Time                 `json:"generated_at" example:"2024-01-01T12:00:00Z"`
+	GeneratedBy  string                    `json:"generated_by" example:"dpo@company.com"`
+	Candidate    *CandidateProfileResponse `json:"candidate,omitempty"`
+	Applications []*ApplicationExport      `json:"applications"`
+	EmailLog     []*EmailLogResponse       `json:"email_log"`
+}
+
+// CandidateProfileResponse represents a candidate profile without their applications
+type CandidateProfileResponse struct {
+	ID        string    `json:"id" example:"123e4567-e89b-12d3-a456-426614174001"`
+	Email     string    `json:"email" example:"john.doe@example.com"`
+	Name      string    `json:"name" example:"John Doe"`
+	Phone     string    `json:"phone" example:"+1234567890"`
+	CreatedAt time.Time `json:"created_at" example:"2023-01-01T12:00:00Z"`
+	UpdatedAt time.Time `json:"updated_at" example:"2023-01-01T12:00:00Z"`
+}
+
+// ApplicationExport is an application with its status history and reviewer notes.
+// ResumeFile is the path of the resume within the archive, if it could be read.
+type ApplicationExport struct {
+	*ApplicationResponse
+	StatusHistory []*StatusEventResponse `json:"status_history"`
+	Comments      []*CommentResponse     `json:"comments"`
+	ResumeFile    string                 `json:"resume_file,omitempty" example:"resumes/123e4567-e89b-12d3-a456-426614174000-resume.pdf"`
+}
+
+// EmailLogResponse represents an email sent by the system
+type EmailLogResponse struct {
+	Recipient string                  `json:"recipient" example:"john.doe@example.com"`
+	Subject   string                  `json:"subject" example:"Application Received - Senior AI Engineer"`
+	Status    entities.EmailLogStatus `json:"status" example:"sent"`
+	Error     string                  `json:"error,omitempty"`
+	SentAt    time.Time               `json:"sent_at" example:"2023-01-01T12:00:00Z"`
+}
+
+// ToCandidateProfileResponse converts a candidate entity to a profile response DTO
+func ToCandidateProfileResponse(candidate *entities.Candidate) *CandidateProfileResponse {
+	return &CandidateProfileResponse{
+		ID:        candidate.ID,
+		Email:     candidate.Email,
+		Name:      candidate.Name,
+		Phone:     candidate.Phone,
+		CreatedAt: candidate.CreatedAt,
+		UpdatedAt: candidate.UpdatedAt,
+	}
+}
+
+// ToEmailLogResponseList converts email log entries to response DTOs
+func ToEmailLogResponseList(entries []*entities.EmailLog) []*EmailLogResponse {
+	responses := make([]*EmailLogResponse, len(entries))
+	for i, entry := range entries {
+		responses[i] = &EmailLogResponse{
+			Recipient: entry.Recipient,
+			Subject:   entry.Subject,
+			Status:    entry.Status,
+			Error:     entry.Error,
+			SentAt:    entry.CreatedAt,
+		}
+	}
+	return responses
+}
+
+// EraseSubjectDataRequest represents a request to erase the personal data held about an email address
+type EraseSubjectDataRequest struct {
+	Email string `json:"email" validate:"required,email" example:"john.doe@example.com"`
+	// Reason is kept on the receipt after the erasure and must not contain personal data
+	Reason string `json:"reason" validate:"max=500" example:"Erasure request received by email on 2024-01-01"`
+}
+
+// ErasureReceiptResponse represents an erasure receipt
+type ErasureReceiptResponse struct {
+	ID                     string    `json:"id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Sequence               int64     `json:"sequence" example:"1"`
+	SubjectHash            string    `json:"subject_hash" example:"b4c9a289323b21a01c3e940f150eb9b8c542587f1abfd8f0e1cc1ffc5e475514"`
+	ApplicationIDs         []string  `json:"application_ids"`
+	ApplicationsAnonymized int       `json:"applications_anonymized" example:"2"`
+	AnswersDeleted         int       `json:"answers_deleted" example:"3"`
+	CommentsDeleted        int       `json:"comments_deleted" example:"1"`
+	EmailLogsDeleted       int       `json:"email_logs_deleted" example:"4"`
+	ResumesDeleted         int       `json:"resumes_deleted" example:"2"`
+	CandidateDeleted       bool      `json:"candidate_deleted" example:"true"`
+	Reason                 string    `json:"reason,omitempty" example:"Erasure request received by email on 2024-01-01"`
+	ErasedBy               string    `json:"erased_by" example:"dpo@company.com"`
+	ErasedAt               time.Time `json:"erased_at" example:"2024-01-01T12:00:00Z"`
+	PreviousHash           string    `json:"previous_hash"`
+	Hash                   string    `json:"hash"`
+}
+
+// ListErasureReceiptsResponse represents a list of erasure receipts
+type ListErasureReceiptsResponse struct {
+	Receipts []*ErasureReceiptResponse `json:"receipts"`
+	Total    int                       `json:"total" example:"1"`
+}
+
+// ErasureChainResponse reports whether the erasure receipts are intact. HeadHash is
+// the hash of the latest receipt; recording it elsewhere also detects a rewritten chain.
+type ErasureChainResponse struct {
+	Valid    bool   `json:"valid" example:"true"`
+	Receipts int    `json:"receipts" example:"12"`
+	HeadHash string `json:"head_hash,omitempty"`
+	BrokenAt int64  `json:"broken_at,omitempty" example:"0"`
+}
+
+// ToErasureReceiptResponse converts an erasure receipt entity to a response DTO
+func ToErasureReceiptResponse(receipt *entities.ErasureReceipt) *ErasureReceiptResponse {
+	return &ErasureReceiptResponse{
+		ID:                     receipt.ID,
+		Sequence:               receipt.Sequence,
+		SubjectHash:            receipt.SubjectHash,
+		ApplicationIDs:         receipt.ApplicationIDs,
+		ApplicationsAnonymized: receipt.ApplicationsAnonymized,
+		AnswersDeleted:         receipt.AnswersDeleted,
+		CommentsDeleted:        receipt.CommentsDeleted,
+		EmailLogsDeleted:       receipt.EmailLogsDeleted,
+		ResumesDeleted:         receipt.ResumesDeleted,
+		CandidateDeleted:       receipt.CandidateDeleted,
+		Reason:                 receipt.Reason,
+		ErasedBy:               receipt.ErasedBy,
+		ErasedAt:               receipt.ErasedAt,
+		PreviousHash:           receipt.PreviousHash,
+		Hash:                   receipt.Hash,
+	}
+}
+
+// ToErasureReceiptResponseList converts erasure receipts to response DTOs
+func ToErasureReceiptResponseList(receipts []*entities.ErasureReceipt) []*ErasureReceiptResponse {
+	responses := make([]*ErasureReceiptResponse, len(receipts))
+	for i, receipt := range receipts {
+		responses[i] = ToErasureReceiptResponse(receipt)
+	}
+	return responses
+}
+
+// RetentionPreviewResponse reports what the retention rules would do if they ran now.
+// An application can be listed under more than one rule; the first one applied wins.
+type RetentionPreviewResponse struct {
+	GeneratedAt time.Time               `json:"generated_at" example:"2024-01-01T12:00:00Z"`
+	Rules       []*RetentionRulePreview `json:"rules"`
+	Total       int                     `json:"total" example:"14"`
+}
+
+// RetentionRulePreview lists the applications a retention rule applies to
+type RetentionRulePreview struct {
+	Name         string                         `json:"name" example:"rejected"`
+	Status       entities.ApplicationStatus     `json:"status,omitempty" example:"rejected"`
+	Days         int                            `json:"days" example:"180"`
+	Action       entities.RetentionAction       `json:"action" example:"anonymize"`
+	Cutoff       time.Time                      `json:"cutoff" example:"2023-07-05T12:00:00Z"`
+	Count        int                            `json:"count" example:"12"`
+	Applications []*RetentionApplicationPreview `json:"applications"`
+}
+
+// RetentionApplicationPreview identifies an application a retention rule applies to
+type RetentionApplicationPreview struct {
+	ID          string                     `json:"id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	PositionID  string                     `json:"position_id" example:"senior-ai-engineer"`
+	Status      entities.ApplicationStatus `json:"status" example:"rejected"`
+	CreatedAt   time.Time                  `json:"created_at" example:"2023-01-01T12:00:00Z"`
+	ProcessedAt *time.Time                 `json:"processed_at,omitempty" example:"2023-02-01T12:00:00Z"`
+	HasResume   bool                       `json:"has_resume" example:"true"`
+	Deleted     bool                       `json:"deleted" example:"false"`
+}
+
+// ToRetentionRulePreview converts a retention rule and the applications it applies to into a preview DTO
+func ToRetentionRulePreview(rule entities.RetentionRule, cutoff time.Time, applications []*entities.Application) *RetentionRulePreview {
+	preview := &RetentionRulePreview{
+		Name:         rule.Name,
+		Status:       rule.Status,
+		Days:         rule.Days,
+		Action:       rule.Action,
+		Cutoff:       cutoff,
+		Count:        len(applications),
+		Applications: make([]*RetentionApplicationPreview, len(applications)),
+	}
+	for i, application := range applications {
+		preview.Applications[i] = &RetentionApplicationPreview{
+			ID:          application.ID,
+			PositionID:  application.PositionID,
+			Status:      application.Status,
+			CreatedAt:   application.CreatedAt,
+			ProcessedAt: application.ProcessedAt,
+			HasResume:   application.ResumeURL != "",
+			Deleted:     application.DeletedAt.Valid,
+		}
+	}
+	return preview
+}
@@ -0,0 +1,84 @@
+package dto
+
+import (
+	"time"
+
+	"super2025-backend/internal/domain/entities"
+)
+
+// CreateProjectRequest represents the request to add a showcase project
+type CreateProjectRequest struct {
+	Slug         string                   `json:"slug,omitempty" validate:"omitempty,max=200" example:"fintech-banking-platform"`
+	Title        string                   `json:"title" validate:"required,min=2,max=200" example:"FinTech Banking Platform"`
+	Category     string                   `json:"category,omitempty" validate:"max=100" example:"Full Stack"`
+	Description  string                   `json:"description,omitempty" validate:"max=5000"`
+	Technologies []string                 `json:"technologies,omitempty" example:"Next.js,Go,PostgreSQL"`
+	Metrics      []entities.ProjectMetric `json:"metrics,omitempty"`
+	Status       string                   `json:"status,omitempty" validate:"max=50" example:"Live"`
+	Timeline     string                   `json:"timeline,omitempty" validate:"max=50" example:"8 months"`
+	URL          string                   `json:"url,omitempty" validate:"omitempty,url"`
+	SortOrder    int                      `json:"sort_order,omitempty" example:"1"`
+	Published    bool                     `json:"published" example:"true"`
+}
+
+// UpdateProjectRequest represents the request to update a project.
+// The image is managed through the image upload endpoint.
+type UpdateProjectRequest = CreateProjectRequest
+
+// ProjectResponse represents a showcase project in API responses
+type ProjectResponse struct {
+	ID           string                   `json:"id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Slug         string                   `json:"slug" example:"fintech-banking-platform"`
+	Title        string                   `json:"title" example:"FinTech Banking Platform"`
+	Category     string                   `json:"category,omitempty" example:"Full Stack"`
+	Description  string                   `json:"description,omitempty"`
+	Technologies []string                 `json:"technologies"`
+	Metrics      []entities.ProjectMetric `json:"metrics"`
+	Status       string                   `json:"status,omitempty" example:"Live"`
+	Timeline     string                   `json:"timeline,omitempty" example:"8 months"`
+	URL          string                   `json:"url,omitempty"`
+	Image        entities.ImageVariants   `json:"image"`
+	SortOrder    int                      `json:"sort_order" example:"1"`
+	Published    bool                     `json:"published" example:"true"`
+	CreatedAt    time.Time                `json:"created_at" example:"2023-01-01T12:00:00Z"`
+	UpdatedAt    time.Time                `json:"updated_at" example:"2023-01-01T12:00:00Z"`
+}
+
+// ListProjectsResponse represents the showcase projects in display order
+type ListProjectsResponse struct {
+	Projects []*ProjectResponse `json:"projects"`
+}
+
+// ToProjectResponse converts a project entity to response DTO
+func ToProjectResponse(project *entities.Project) *ProjectResponse {
+	technologies := []string(project.Technologies)
+	if technologies == nil {
+		technologies = []string{}
+	}
+	metrics := []entities.ProjectMetric(project.Metrics)
+	if metrics == nil {
+		metrics = []entities.ProjectMetric{}
+	}
+	image := project.Image
+	if image == nil {
+		image = entities.ImageVariants{}
+	}
+
+	return &ProjectResponse{
+		ID:           project.ID,
+		Slug:         project.Slug,
+		Title:        project.Title,
+		Category:     project.Category,
+		Description:  project.Description,
+		Technologies: technologies,
+		Metrics:      metrics,
+		Status:       project.Status,
+		Timeline:     project.Timeline,
+		URL:          project.URL,
+		Image:        image,
+		SortOrder:    project.SortOrder,
+		Published:    project.Published,
+		CreatedAt:    project.CreatedAt,
+		UpdatedAt:    project.UpdatedAt,
+	}
+}
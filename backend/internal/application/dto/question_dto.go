@@ -0,0 +1,107 @@
+package dto
+
+import (
+	"strconv"
+
+	"super2025-backend/internal/domain/entities"
+)
+
+// QuestionRequest represents one custom question in a position's application form.
+// Questions with an ID update an existing question; questions without one are added.
+type QuestionRequest struct {
+	ID       string                `json:"id,omitempty" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Label    string                `json:"label" validate:"required,max=500" example:"How many years of Go experience do you have?"`
+	HelpText string                `json:"help_text,omitempty" validate:"max=1000" example:"Professional experience only"`
+	Type     entities.QuestionType `json:"type" validate:"required,oneof=text single_choice multi_choice yes_no number url" example:"number"`
+	Required bool                  `json:"required" example:"true"`
+	Options  []string              `json:"options,omitempty" example:"Remote,Hybrid,On-site"`
+}
+
+// ReplaceQuestionsRequest sets the complete list of custom questions of a position, in display order
+type ReplaceQuestionsRequest struct {
+	Questions []QuestionRequest `json:"questions"`
+}
+
+// QuestionResponse represents a custom question in the application form schema
+type QuestionResponse struct {
+	ID       string                `json:"id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Label    string                `json:"label" example:"How many years of Go experience do you have?"`
+	HelpText string                `json:"help_text,omitempty" example:"Professional experience only"`
+	Type     entities.QuestionType `json:"type" example:"number"`
+	Required bool                  `json:"required" example:"true"`
+	Options  []string              `json:"options" example:"Remote,Hybrid,On-site"`
+}
+
+// PositionQuestionsResponse represents the custom questions of a position's application form
+type PositionQuestionsResponse struct {
+	PositionID string              `json:"position_id" example:"senior-ai-engineer"`
+	Questions  []*QuestionResponse `json:"questions"`
+}
+
+// AnswerResponse represents a candidate's answer to a custom question. Value is a
+// string, a list of strings for multi_choice, a boolean for yes_no, a number for
+// number, or null when the question was left unanswered.
+type AnswerResponse struct {
+	QuestionID string                `json:"question_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Label      string                `json:"label" example:"How many years of Go experience do you have?"`
+	Type       entities.QuestionType `json:"type" example:"number"`
+	Value      interface{}           `json:"value"`
+}
+
+// ToPositionQuestionsResponse converts the questions of a position to the form schema
+func ToPositionQuestionsResponse(positionID string, questions []*entities.PositionQuestion) *PositionQuestionsResponse {
+	response := &PositionQuestionsResponse{
+		PositionID: positionID,
+		Questions:  make([]*QuestionResponse, len(questions)),
+	}
+	for i, question := range questions {
+		response.Questions[i] = &QuestionResponse{
+			ID:       question.ID,
+			Label:    question.Label,
+			HelpText: question.HelpText,
+			Type:     question.Type,
+			Required: question.Required,
+			Options:  nonNilStrings(question.Options),
+		}
+	}
+	return response
+}
+
+// ToAnswerResponseList converts stored answers to response DTOs with typed values
+func ToAnswerResponseList(answers []entities.ApplicationAnswer) []*AnswerResponse {
+	if len(answers) == 0 {
+		return nil
+	}
+
+	responses := make([]*AnswerResponse, len(answers))
+	for i, answer := range answers {
+		responses[i] = &AnswerResponse{
+			QuestionID: answer.QuestionID,
+			Label:      answer.Label,
+			Type:       answer.Type,
+			Value:      answerValue(answer),
+		}
+	}
+	return responses
+}
+
+// answerValue returns the JSON value of a stored answer
+func answerValue(answer entities.ApplicationAnswer) interface{} {
+	if answer.Type == entities.QuestionTypeMultiChoice {
+		return nonNilStrings(answer.Values)
+	}
+	if len(answer.Values) == 0 {
+		return nil
+	}
+
+	value := answer.Values[0]
+	switch answer.Type {
+	case entities.QuestionTypeYesNo:
+		return value == "yes"
+	case entities.QuestionTypeNumber:
+		if number, err := strconv.ParseFloat(value, 64); err == nil {
+			return number
+		}
+	}
+	return value
+}
@@ -0,0 +1,72 @@
+package dto
+
+import (
+	"time"
+
+	"super2025-backend/internal/domain/entities"
+	"super2025-backend/internal/domain/repositories"
+)
+
+// CreateReferralCodeRequest represents the request to issue a referral code to an employee.
+// A code is generated unless one is given.
+type CreateReferralCodeRequest struct {
+	Code          string `json:"code,omitempty" validate:"omitempty,min=4,max=32,alphanum" example:"JANE2025"`
+	EmployeeName  string `json:"employee_name" validate:"required,min=2,max=100" example:"Jane Smith"`
+	EmployeeEmail string `json:"employee_email" validate:"required,email" example:"jane@super2025.com"`
+}
+
+// UpdateReferralCodeRequest represents the request to update a referral code.
+// Deactivated codes are no longer accepted on new applications.
+type UpdateReferralCodeRequest struct {
+	EmployeeName  string `json:"employee_name,omitempty" validate:"omitempty,min=2,max=100" example:"Jane Smith"`
+	EmployeeEmail string `json:"employee_email,omitempty" validate:"omitempty,email" example:"jane@super2025.com"`
+	Active        *bool  `json:"active,omitempty" example:"false"`
+}
+
+// ReferralCodeResponse represents a referral code in API responses
+type ReferralCodeResponse struct {
+	ID            string    `json:"id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Code          string    `json:"code" example:"K7M2QX9P"`
+	EmployeeName  string    `json:"employee_name" example:"Jane Smith"`
+	EmployeeEmail string    `json:"employee_email" example:"jane@super2025.com"`
+	Active        bool      `json:"active" example:"true"`
+	CreatedBy     string    `json:"created_by,omitempty" example:"admin@example.com"`
+	CreatedAt     time.Time `json:"created_at" example:"2023-01-01T12:00:00Z"`
+	UpdatedAt     time.Time `json:"updated_at" example:"2023-01-01T12:00:00Z"`
+}
+
+// ListReferralCodesResponse represents a list of referral codes
+type ListReferralCodesResponse struct {
+	Codes []*ReferralCodeResponse `json:"codes"`
+}
+
+// ReferrerBonusResponse groups the bonus-eligible referrals of one referral code
+type ReferrerBonusResponse struct {
+	ReferralCodeID string                        `json:"referral_code_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Code           string                        `json:"code" example:"K7M2QX9P"`
+	EmployeeName   string                        `json:"employee_name" example:"Jane Smith"`
+	EmployeeEmail  string                        `json:"employee_email" example:"jane@super2025.com"`
+	Count          int                           `json:"count" example:"2"`
+	Referrals      []*repositories.ReferralBonus `json:"referrals"`
+}
+
+// ReferralBonusReportResponse represents the referral bonus payout report
+type ReferralBonusReportResponse struct {
+	Filter    ReportRequest            `json:"filter"`
+	Total     int                      `json:"total" example:"3"`
+	Referrers []*ReferrerBonusResponse `json:"referrers"`
+}
+
+// ToReferralCodeResponse converts a referral code entity to response DTO
+func ToReferralCodeResponse(code *entities.ReferralCode) *ReferralCodeResponse {
+	return &ReferralCodeResponse{
+		ID:            code.ID,
+		Code:          code.Code,
+		EmployeeName:  code.EmployeeName,
+		EmployeeEmail: code.EmployeeEmail,
+		Active:        code.Active,
+		CreatedBy:     code.CreatedBy,
+		CreatedAt:     code.CreatedAt,
+		UpdatedAt:     code.UpdatedAt,
+	}
+}
@@ -0,0 +1,25 @@
+package dto
+
+import (
+	"super2025-backend/internal/domain/repositories"
+)
+
+// ReportRequest represents the common filters accepted by report endpoints
+type ReportRequest struct {
+	PositionID string `json:"position_id,omitempty" form:"position_id" example:"senior-ai-engineer"`
+	DateFrom   string `json:"date_from,omitempty" form:"date_from" example:"2023-01-01"`
+	DateTo     string `json:"date_to,omitempty" form:"date_to" example:"2023-12-31"`
+}
+
+// TimeInStageResponse represents the time-in-stage report
+type TimeInStageResponse struct {
+	Filter ReportRequest              `json:"filter"`
+	Stages []*repositories.StageStats `json:"stages"`
+}
+
+// SourceEffectivenessResponse represents the source-effectiveness report
+type SourceEffectivenessResponse struct {
+	Filter  ReportRequest               `json:"filter"`
+	GroupBy repositories.SourceGrouping `json:"group_by" example:"source"`
+	Sources []*repositories.SourceStats `json:"sources"`
+}
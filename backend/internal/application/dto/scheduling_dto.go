@@ -0,0 +1,118 @@
+package dto
+
+import (
+	"time"
+
+	"super2025-backend/internal/domain/entities"
+)
+
+// SlotRequest represents a time offered to a candidate
+type SlotRequest struct {
+	StartAt time.Time `json:"start_at" validate:"required" example:"2023-01-10T14:00:00Z"`
+	EndAt   time.Time `json:"end_at" validate:"required" example:"2023-01-10T15:00:00Z"`
+}
+
+// CreateSchedulingInvitationRequest represents the request to offer interview slots to a candidate
+type CreateSchedulingInvitationRequest struct {
+	Slots        []SlotRequest `json:"slots" validate:"required,min=1,max=50"`
+	Interviewers []string      `json:"interviewers" validate:"required,min=1,dive,email" example:"lead@super2025.com"`
+	Timezone     string        `json:"timezone" validate:"required" example:"Europe/Berlin"`
+	Location     string        `json:"location,omitempty" example:"Office, meeting room 2"`
+	VideoLink    string        `json:"video_link,omitempty" validate:"omitempty,url" example:"https://meet.example.com/abc-defg-hij"`
+	Notes        string        `json:"notes,omitempty" example:"Technical interview with the platform team"`
+	ExpiresAt    *time.Time    `json:"expires_at,omitempty" example:"2023-01-08T17:00:00Z"`
+}
+
+// SlotResponse represents an offered interview slot
+type SlotResponse struct {
+	ID       string     `json:"id" example:"123e4567-e89b-12d3-a456-426614174005"`
+	StartAt  time.Time  `json:"start_at" example:"2023-01-10T14:00:00Z"`
+	EndAt    time.Time  `json:"end_at" example:"2023-01-10T15:00:00Z"`
+	BookedAt *time.Time `json:"booked_at,omitempty"`
+}
+
+// SchedulingInvitationResponse represents a scheduling invitation for recruiters
+type SchedulingInvitationResponse struct {
+	ID            string          `json:"id" example:"123e4567-e89b-12d3-a456-426614174004"`
+	ApplicationID string          `json:"application_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Interviewers  []string        `json:"interviewers" example:"lead@super2025.com"`
+	Timezone      string          `json:"timezone" example:"Europe/Berlin"`
+	Location      string          `json:"location,omitempty" example:"Office, meeting room 2"`
+	VideoLink     string          `json:"video_link,omitempty" example:"https://meet.example.com/abc-defg-hij"`
+	Notes         string          `json:"notes,omitempty"`
+	ExpiresAt     time.Time       `json:"expires_at" example:"2023-01-08T17:00:00Z"`
+	CreatedBy     string          `json:"created_by" example:"recruiter@super2025.com"`
+	BookedAt      *time.Time      `json:"booked_at,omitempty"`
+	InterviewID   string          `json:"interview_id,omitempty"`
+	Slots         []*SlotResponse `json:"slots"`
+	CreatedAt     time.Time       `json:"created_at" example:"2023-01-01T12:00:00Z"`
+
+	// Link is only returned when the invitation is created
+	Link string `json:"link,omitempty" example:"http://localhost:3000/careers/schedule/3q2-7wEjR..."`
+}
+
+// ListSchedulingInvitationsResponse represents the scheduling invitations of an application
+type ListSchedulingInvitationsResponse struct {
+	Invitations []*SchedulingInvitationResponse `json:"invitations"`
+}
+
+// SchedulingPageResponse is what the candidate sees when opening a scheduling link
+type SchedulingPageResponse struct {
+	CandidateName string          `json:"candidate_name" example:"John Doe"`
+	Position      string          `json:"position" example:"Senior AI Engineer"`
+	Timezone      string          `json:"timezone" example:"Europe/Berlin"`
+	Location      string          `json:"location,omitempty" example:"Office, meeting room 2"`
+	VideoCall     bool            `json:"video_call" example:"true"`
+	ExpiresAt     time.Time       `json:"expires_at" example:"2023-01-08T17:00:00Z"`
+	Booked        bool            `json:"booked" example:"false"`
+	BookedSlot    *SlotResponse   `json:"booked_slot,omitempty"`
+	Slots         []*SlotResponse `json:"slots"`
+}
+
+// BookSlotRequest represents the candidate's choice of slot
+type BookSlotRequest struct {
+	SlotID string `json:"slot_id" validate:"required" example:"123e4567-e89b-12d3-a456-426614174005"`
+}
+
+// ToSlotResponse converts a slot entity to a response DTO
+func ToSlotResponse(slot entities.InterviewSlot) *SlotResponse {
+	return &SlotResponse{
+		ID:       slot.ID,
+		StartAt:  slot.StartAt,
+		EndAt:    slot.EndAt,
+		BookedAt: slot.BookedAt,
+	}
+}
+
+// ToSlotResponses converts slot entities to response DTOs
+func ToSlotResponses(slots []entities.InterviewSlot) []*SlotResponse {
+	responses := make([]*SlotResponse, len(slots))
+	for i, slot := range slots {
+		responses[i] = ToSlotResponse(slot)
+	}
+	return responses
+}
+
+// ToSchedulingInvitationResponse converts an invitation entity to a response DTO
+func ToSchedulingInvitationResponse(invitation *entities.SchedulingInvitation) *SchedulingInvitationResponse {
+	var interviewID string
+	if invitation.InterviewID != nil {
+		interviewID = *invitation.InterviewID
+	}
+
+	return &SchedulingInvitationResponse{
+		ID:            invitation.ID,
+		ApplicationID: invitation.ApplicationID,
+		Interviewers:  nonNilStrings(invitation.Interviewers),
+		Timezone:      invitation.Timezone,
+		Location:      invitation.Location,
+		VideoLink:     invitation.VideoLink,
+		Notes:         invitation.Notes,
+		ExpiresAt:     invitation.ExpiresAt,
+		CreatedBy:     invitation.CreatedBy,
+		BookedAt:      invitation.BookedAt,
+		InterviewID:   interviewID,
+		Slots:         ToSlotResponses(invitation.Slots),
+		CreatedAt:     invitation.CreatedAt,
+	}
+}
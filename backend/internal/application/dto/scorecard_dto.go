@@ -0,0 +1,74 @@
+package dto
+
+import (
+	"time"
+
+	"super2025-backend/internal/domain/entities"
+)
+
+// SubmitScorecardRequest represents an interviewer's scorecard
+type SubmitScorecardRequest struct {
+	InterviewID    string                  `json:"interview_id,omitempty" example:"123e4567-e89b-12d3-a456-426614174003"`
+	Ratings        map[string]int          `json:"ratings" validate:"required,min=1,dive,min=1,max=5" example:"system-design:4"`
+	Recommendation entities.Recommendation `json:"recommendation" validate:"required,oneof=strong_no no yes strong_yes" example:"yes"`
+	Feedback       string                  `json:"feedback" example:"Solid fundamentals, clear communication."`
+}
+
+// ScorecardResponse represents a scorecard in API responses
+type ScorecardResponse struct {
+	ID             string                  `json:"id" example:"123e4567-e89b-12d3-a456-426614174006"`
+	ApplicationID  string                  `json:"application_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	InterviewID    string                  `json:"interview_id,omitempty" example:"123e4567-e89b-12d3-a456-426614174003"`
+	Interviewer    string                  `json:"interviewer" example:"lead@super2025.com"`
+	Ratings        map[string]int          `json:"ratings"`
+	Recommendation entities.Recommendation `json:"recommendation" example:"yes"`
+	Feedback       string                  `json:"feedback" example:"Solid fundamentals, clear communication."`
+	CreatedAt      time.Time               `json:"created_at" example:"2023-01-10T16:00:00Z"`
+	UpdatedAt      time.Time               `json:"updated_at" example:"2023-01-10T16:00:00Z"`
+}
+
+// ListScorecardsResponse represents the scorecards of an application visible to the viewer.
+// Hidden is set when the viewer is an interviewer who has not submitted their own yet.
+type ListScorecardsResponse struct {
+	Scorecards []*ScorecardResponse `json:"scorecards"`
+	Hidden     bool                 `json:"hidden" example:"false"`
+}
+
+// CompetencySummary represents the ratings of one competency across scorecards
+type CompetencySummary struct {
+	Competency string  `json:"competency" example:"system-design"`
+	Average    float64 `json:"average" example:"3.67"`
+	Ratings    int     `json:"ratings" example:"3"`
+}
+
+// ScorecardSummary aggregates the scorecards of an application
+type ScorecardSummary struct {
+	Scorecards      int                             `json:"scorecards" example:"3"`
+	Competencies    []*CompetencySummary            `json:"competencies"`
+	Recommendations map[entities.Recommendation]int `json:"recommendations"`
+}
+
+// ToScorecardResponse converts a scorecard entity to a response DTO
+func ToScorecardResponse(scorecard *entities.Scorecard) *ScorecardResponse {
+	var interviewID string
+	if scorecard.InterviewID != nil {
+		interviewID = *scorecard.InterviewID
+	}
+
+	ratings := make(map[string]int, len(scorecard.Ratings))
+	for competency, rating := range scorecard.Ratings {
+		ratings[competency] = rating
+	}
+
+	return &ScorecardResponse{
+		ID:             scorecard.ID,
+		ApplicationID:  scorecard.ApplicationID,
+		InterviewID:    interviewID,
+		Interviewer:    scorecard.Interviewer,
+		Ratings:        ratings,
+		Recommendation: scorecard.Recommendation,
+		Feedback:       scorecard.Feedback,
+		CreatedAt:      scorecard.CreatedAt,
+		UpdatedAt:      scorecard.UpdatedAt,
+	}
+}
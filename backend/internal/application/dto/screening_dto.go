@@ -0,0 +1,128 @@
+package dto
+
+import (
+	"time"
+
+	"super2025-backend/internal/domain/entities"
+)
+
+// ScreeningRuleRequest represents a request to create or replace a screening rule.
+// All conditions must hold for the rule to fire. Only the settings of the chosen action are kept.
+type ScreeningRuleRequest struct {
+	Name        string                        `json:"name" validate:"required,max=200" example:"No work authorization"`
+	Active      *bool                         `json:"active,omitempty" example:"true"`
+	Conditions  []entities.ScreeningCondition `json:"conditions" validate:"required,min=1,max=20"`
+	Action      entities.ScreeningAction      `json:"action" validate:"required,oneof=tag status notify" example:"status"`
+	Tag         string                        `json:"tag,omitempty" example:"junior"`
+	Status      entities.ApplicationStatus    `json:"status,omitempty" example:"rejected"`
+	DelayHours  int                           `json:"delay_hours,omitempty" validate:"min=0,max=720" example:"48"`
+	NotifyEmail string                        `json:"notify_email,omitempty" validate:"omitempty,email" example:"recruiting@example.com"`
+	SortOrder   int                           `json:"sort_order,omitempty" example:"0"`
+}
+
+// ScreeningRuleResponse represents a screening rule in API responses
+type ScreeningRuleResponse struct {
+	ID          string                        `json:"id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	PositionID  string                        `json:"position_id" example:"senior-ai-engineer"`
+	Name        string                        `json:"name" example:"No work authorization"`
+	Active      bool                          `json:"active" example:"true"`
+	Conditions  []entities.ScreeningCondition `json:"conditions"`
+	Action      entities.ScreeningAction      `json:"action" example:"status"`
+	Tag         string                        `json:"tag,omitempty" example:"junior"`
+	Status      entities.ApplicationStatus    `json:"status,omitempty" example:"rejected"`
+	DelayHours  int                           `json:"delay_hours" example:"48"`
+	NotifyEmail string                        `json:"notify_email,omitempty" example:"recruiting@example.com"`
+	SortOrder   int                           `json:"sort_order" example:"0"`
+	CreatedBy   string                        `json:"created_by" example:"alice@company.com"`
+	CreatedAt   time.Time                     `json:"created_at" example:"2024-01-01T12:00:00Z"`
+	UpdatedAt   time.Time                     `json:"updated_at" example:"2024-01-01T12:00:00Z"`
+}
+
+// ListScreeningRulesResponse represents the screening rules of a position in evaluation order
+type ListScreeningRulesResponse struct {
+	PositionID string                   `json:"position_id" example:"senior-ai-engineer"`
+	Rules      []*ScreeningRuleResponse `json:"rules"`
+}
+
+// ScreeningResultResponse represents a screening rule that fired for an application
+type ScreeningResultResponse struct {
+	ID          string                     `json:"id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	RuleID      string                     `json:"rule_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	RuleName    string                     `json:"rule_name" example:"No work authorization"`
+	Action      entities.ScreeningAction   `json:"action" example:"status"`
+	Detail      string                     `json:"detail,omitempty" example:"rejected"`
+	Outcome     entities.ScreeningOutcome  `json:"outcome" example:"scheduled"`
+	Error       string                     `json:"error,omitempty"`
+	FromStatus  entities.ApplicationStatus `json:"from_status,omitempty" example:"pending"`
+	ToStatus    entities.ApplicationStatus `json:"to_status,omitempty" example:"rejected"`
+	DueAt       *time.Time                 `json:"due_at,omitempty" example:"2024-01-03T12:00:00Z"`
+	ProcessedAt *time.Time                 `json:"processed_at,omitempty"`
+	CreatedAt   time.Time                  `json:"created_at" example:"2024-01-01T12:00:00Z"`
+}
+
+// ApplicationScreeningResponse represents the screening results of an application
+type ApplicationScreeningResponse struct {
+	ApplicationID string                     `json:"application_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Results       []*ScreeningResultResponse `json:"results"`
+}
+
+// ToScreeningRuleResponse converts a screening rule entity to response DTO
+func ToScreeningRuleResponse(rule *entities.ScreeningRule) *ScreeningRuleResponse {
+	conditions := []entities.ScreeningCondition(rule.Conditions)
+	if conditions == nil {
+		conditions = []entities.ScreeningCondition{}
+	}
+	return &ScreeningRuleResponse{
+		ID:          rule.ID,
+		PositionID:  rule.PositionID,
+		Name:        rule.Name,
+		Active:      rule.Active,
+		Conditions:  conditions,
+		Action:      rule.Action,
+		Tag:         rule.Tag,
+		Status:      rule.Status,
+		DelayHours:  rule.DelayHours,
+		NotifyEmail: rule.NotifyEmail,
+		SortOrder:   rule.SortOrder,
+		CreatedBy:   rule.CreatedBy,
+		CreatedAt:   rule.CreatedAt,
+		UpdatedAt:   rule.UpdatedAt,
+	}
+}
+
+// ToListScreeningRulesResponse converts the screening rules of a position to a list response
+func ToListScreeningRulesResponse(positionID string, rules []*entities.ScreeningRule) *ListScreeningRulesResponse {
+	response := &ListScreeningRulesResponse{
+		PositionID: positionID,
+		Rules:      make([]*ScreeningRuleResponse, len(rules)),
+	}
+	for i, rule := range rules {
+		response.Rules[i] = ToScreeningRuleResponse(rule)
+	}
+	return response
+}
+
+// ToApplicationScreeningResponse converts the screening results of an application to a response
+func ToApplicationScreeningResponse(applicationID string, results []*entities.ScreeningResult) *ApplicationScreeningResponse {
+	response := &ApplicationScreeningResponse{
+		ApplicationID: applicationID,
+		Results:       make([]*ScreeningResultResponse, len(results)),
+	}
+	for i, result := range results {
+		response.Results[i] = &ScreeningResultResponse{
+			ID:          result.ID,
+			RuleID:      result.RuleID,
+			RuleName:    result.RuleName,
+			Action:      result.Action,
+			Detail:      result.Detail,
+			Outcome:     result.Outcome,
+			Error:       result.Error,
+			FromStatus:  result.FromStatus,
+			ToStatus:    result.ToStatus,
+			DueAt:       result.DueAt,
+			ProcessedAt: result.ProcessedAt,
+			CreatedAt:   result.CreatedAt,
+		}
+	}
+	return response
+}
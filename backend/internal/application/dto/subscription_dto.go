@@ -0,0 +1,47 @@
+package dto
+
+import (
+	"time"
+
+	"super2025-backend/internal/domain/entities"
+)
+
+// SubscribeRequest represents a visitor subscribing to the newsletter
+type SubscribeRequest struct {
+	Email  string   `json:"email" validate:"required,email" example:"jane@acme.com"`
+	Name   string   `json:"name,omitempty" validate:"max=100" example:"Jane Smith"`
+	Topics []string `json:"topics,omitempty" example:"company,jobs"`
+	Source string   `json:"source,omitempty" validate:"max=50" example:"careers"`
+
+	// Website is a honeypot: the field is hidden on the form, so only bots fill it in
+	Website string `json:"website,omitempty"`
+}
+
+// SubscriptionResponse represents the state of a subscription to the person it belongs to
+type SubscriptionResponse struct {
+	Email  string                    `json:"email" example:"jane@acme.com"`
+	Topics []string                  `json:"topics" example:"company,jobs"`
+	Status entities.SubscriberStatus `json:"status" example:"confirmed"`
+}
+
+// SubscriberExportRow is a confirmed subscriber as exported for the newsletter tool
+type SubscriberExportRow struct {
+	Email          string
+	Name           string
+	Topics         []string
+	ConfirmedAt    time.Time
+	UnsubscribeURL string
+}
+
+// ToSubscriptionResponse converts a subscriber entity to response DTO
+func ToSubscriptionResponse(subscriber *entities.Subscriber) *SubscriptionResponse {
+	topics := []string(subscriber.Topics)
+	if topics == nil {
+		topics = []string{}
+	}
+	return &SubscriptionResponse{
+		Email:  subscriber.Email,
+		Topics: topics,
+		Status: subscriber.Status,
+	}
+}
@@ -0,0 +1,12 @@
+package dto
+
+// AddTagsRequest represents the request to tag an application
+type AddTagsRequest struct {
+	Tags []string `json:"tags" validate:"required,min=1,max=20" example:"strong-go,relocation"`
+}
+
+// ApplicationTagsResponse represents the tags of an application
+type ApplicationTagsResponse struct {
+	ApplicationID string   `json:"application_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Tags          []string `json:"tags" example:"strong-go"`
+}
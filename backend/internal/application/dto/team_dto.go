@@ -0,0 +1,100 @@
+package dto
+
+import (
+	"time"
+
+	"super2025-backend/internal/domain/entities"
+)
+
+// CreateTeamMemberRequest represents the request to add a team member
+type CreateTeamMemberRequest struct {
+	Slug        string            `json:"slug,omitempty" validate:"omitempty,max=200" example:"jane-smith"`
+	Name        string            `json:"name" validate:"required,min=2,max=100" example:"Jane Smith"`
+	Title       string            `json:"title" validate:"required,max=100" example:"Co-Founder & CTO"`
+	Role        entities.TeamRole `json:"role" validate:"required,oneof=leadership tech client" example:"leadership"`
+	Location    string            `json:"location,omitempty" validate:"max=100" example:"Bogotá"`
+	Country     string            `json:"country,omitempty" validate:"max=100" example:"Colombia"`
+	Bio         string            `json:"bio,omitempty" validate:"max=2000"`
+	Quote       string            `json:"quote,omitempty" validate:"max=500"`
+	Skills      []string          `json:"skills,omitempty" example:"Go,Kubernetes"`
+	Experience  string            `json:"experience,omitempty" validate:"max=50" example:"10+ years"`
+	LinkedInURL string            `json:"linkedin_url,omitempty" validate:"omitempty,url"`
+	GitHubURL   string            `json:"github_url,omitempty" validate:"omitempty,url"`
+	TwitterURL  string            `json:"twitter_url,omitempty" validate:"omitempty,url"`
+	Email       string            `json:"email,omitempty" validate:"omitempty,email" example:"jane@super2025.com"`
+	SortOrder   int               `json:"sort_order,omitempty" example:"1"`
+	Published   bool              `json:"published" example:"true"`
+}
+
+// UpdateTeamMemberRequest represents the request to update a team member.
+// The photo is managed through the photo upload endpoint.
+type UpdateTeamMemberRequest = CreateTeamMemberRequest
+
+// ReorderRequest sets the display order of team members or projects
+type ReorderRequest struct {
+	IDs []string `json:"ids" validate:"required,min=1"`
+}
+
+// TeamMemberResponse represents a team member in API responses
+type TeamMemberResponse struct {
+	ID          string                 `json:"id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Slug        string                 `json:"slug" example:"jane-smith"`
+	Name        string                 `json:"name" example:"Jane Smith"`
+	Title       string                 `json:"title" example:"Co-Founder & CTO"`
+	Role        entities.TeamRole      `json:"role" example:"leadership"`
+	Location    string                 `json:"location,omitempty" example:"Bogotá"`
+	Country     string                 `json:"country,omitempty" example:"Colombia"`
+	Bio         string                 `json:"bio,omitempty"`
+	Quote       string                 `json:"quote,omitempty"`
+	Skills      []string               `json:"skills"`
+	Experience  string                 `json:"experience,omitempty" example:"10+ years"`
+	LinkedInURL string                 `json:"linkedin_url,omitempty"`
+	GitHubURL   string                 `json:"github_url,omitempty"`
+	TwitterURL  string                 `json:"twitter_url,omitempty"`
+	Email       string                 `json:"email,omitempty" example:"jane@super2025.com"`
+	Photo       entities.ImageVariants `json:"photo"`
+	SortOrder   int                    `json:"sort_order" example:"1"`
+	Published   bool                   `json:"published" example:"true"`
+	CreatedAt   time.Time              `json:"created_at" example:"2023-01-01T12:00:00Z"`
+	UpdatedAt   time.Time              `json:"updated_at" example:"2023-01-01T12:00:00Z"`
+}
+
+// ListTeamMembersResponse represents the team in display order
+type ListTeamMembersResponse struct {
+	Members []*TeamMemberResponse `json:"members"`
+}
+
+// ToTeamMemberResponse converts a team member entity to response DTO
+func ToTeamMemberResponse(member *entities.TeamMember) *TeamMemberResponse {
+	skills := []string(member.Skills)
+	if skills == nil {
+		skills = []string{}
+	}
+	photo := member.Photo
+	if photo == nil {
+		photo = entities.ImageVariants{}
+	}
+
+	return &TeamMemberResponse{
+		ID:          member.ID,
+		Slug:        member.Slug,
+		Name:        member.Name,
+		Title:       member.Title,
+		Role:        member.Role,
+		Location:    member.Location,
+		Country:     member.Country,
+		Bio:         member.Bio,
+		Quote:       member.Quote,
+		Skills:      skills,
+		Experience:  member.Experience,
+		LinkedInURL: member.LinkedInURL,
+		GitHubURL:   member.GitHubURL,
+		TwitterURL:  member.TwitterURL,
+		Email:       member.Email,
+		Photo:       photo,
+		SortOrder:   member.SortOrder,
+		Published:   member.Published,
+		CreatedAt:   member.CreatedAt,
+		UpdatedAt:   member.UpdatedAt,
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"path/filepath"
 	"strings"
 	"time"
+	"unicode"
 
 	"github.com/google/uuid"
 	"go.uber.org/zap"
@@ -20,38 +21,88 @@ import (
 type FileStorageService interface {
 	Upload(ctx context.Context, filename string, content []byte) (string, error)
 	Delete(ctx context.Context, url string) error
+	Download(ctx context.Context, url string) ([]byte, error)
 	GetSignedURL(ctx context.Context, url string, expiration time.Duration) (string, error)
 }
 
 // EmailService defines the interface for email operations
 type EmailService interface {
-	SendApplicationConfirmation(candidateEmail, candidateName, position string) error
+	SendApplicationConfirmation(candidateEmail, candidateName, position, withdrawLink string) error
 	SendHRNotification(candidateEmail, candidateName, position, resumeURL string) error
+	SendPositionClosedNotification(candidateEmail, candidateName, position string) error
+	SendInterviewInvitation(interview *entities.Interview, candidateEmail, candidateName, position string) error
+	SendInterviewCancellation(interview *entities.Interview, candidateEmail, candidateName, position string) error
+	SendSchedulingInvitation(invitation *entities.SchedulingInvitation, candidateEmail, candidateName, position, link string) error
+	SendOfferLetter(offer *entities.Offer, candidateEmail, candidateName, position, link string) error
+	SendOfferResponseNotification(offer *entities.Offer, candidateName, position string) error
+	SendWithdrawalNotification(candidateEmail, candidateName, position, reason string) error
+	SendPortalLoginLink(candidateEmail, candidateName, link string, expiresAt time.Time) error
+	SendInquiryNotification(inquiry *entities.Inquiry) error
+	SendConsultationConfirmation(consultation *entities.Consultation, availability *entities.ConsultationAvailability) error
+	SendSubscriptionConfirmation(email, name, confirmLink string) error
+	SendSubscriptionWelcome(email, name, unsubscribeLink, oneClickUnsubscribeURL string) error
+	SendReferralStatusUpdate(referral *entities.ReferralCode, candidateName, position string, status entities.ApplicationStatus) error
+	SendScreeningNotification(recipient string, rule *entities.ScreeningRule, application *entities.Application, position string) error
 }
 
+// Screener evaluates screening rules against a newly submitted application
+type Screener interface {
+	Screen(ctx context.Context, applicationID string)
+}
+
+const (
+	// withdrawLinkTTL is how long the withdraw link in the confirmation email works
+	withdrawLinkTTL = 180 * 24 * time.Hour
+	// withdrawLinkPurpose scopes signed withdraw links
+	withdrawLinkPurpose = "withdraw"
+)
+
 // ApplicationService implements business logic for job applications
 type ApplicationService struct {
 	applicationRepo repositories.ApplicationRepository
+	positionRepo    repositories.PositionRepository
+	referralRepo    repositories.ReferralCodeRepository
 	fileStorage     FileStorageService
 	emailService    EmailService
+	linkBaseURL     string
+	siteHost        string
+	linkSecret      []byte
+	screener        Screener
 	logger          *zap.Logger
 }
 
-// NewApplicationService creates a new application service
+// NewApplicationService creates a new application service. Withdraw links sent
+// to candidates are linkBaseURL followed by a token signed with linkSecret.
+// linkBaseURL is on the careers site, so its host also identifies internal referrers.
 func NewApplicationService(
 	applicationRepo repositories.ApplicationRepository,
+	positionRepo repositories.PositionRepository,
+	referralRepo repositories.ReferralCodeRepository,
 	fileStorage FileStorageService,
 	emailService EmailService,
+	linkBaseURL string,
+	linkSecret []byte,
 	logger *zap.Logger,
 ) *ApplicationService {
 	return &ApplicationService{
 		applicationRepo: applicationRepo,
+		positionRepo:    positionRepo,
+		referralRepo:    referralRepo,
 		fileStorage:     fileStorage,
 		emailService:    emailService,
+		linkBaseURL:     strings.TrimRight(linkBaseURL, "/") + "/",
+		siteHost:        attributionHost(linkBaseURL),
+		linkSecret:      linkSecret,
 		logger:          logger,
 	}
 }
 
+// SetScreener sets the screener run on every new application. The screener
+// changes application statuses through this service, so it is set after construction.
+func (s *ApplicationService) SetScreener(screener Screener) {
+	s.screener = screener
+}
+
 // CreateApplication creates a new job application
 func (s *ApplicationService) CreateApplication(
 	ctx context.Context,
@@ -60,6 +111,27 @@ func (s *ApplicationService) CreateApplication(
 	resumeFilename string,
 	metadata map[string]string,
 ) (*dto.ApplicationResponse, error) {
+	// Only open positions accept applications
+	position, err := s.positionRepo.GetByID(ctx, req.PositionID)
+	if err != nil {
+		if err == domainErrors.ErrPositionNotFound {
+			return nil, err
+		}
+		s.logger.Error("Failed to get position", zap.String("position_id", req.PositionID), zap.Error(err))
+		return nil, domainErrors.ErrDatabaseQuery
+	}
+	if position.Status == entities.PositionStatusDraft {
+		return nil, domainErrors.ErrPositionNotFound
+	}
+	if !position.AcceptsApplications(time.Now()) {
+		return nil, domainErrors.ErrPositionClosed
+	}
+
+	// The name ends up in email subjects
+	if strings.IndexFunc(req.Name, unicode.IsControl) >= 0 {
+		return nil, fmt.Errorf("%w: name must not contain control characters", domainErrors.ErrValidationFailed)
+	}
+
 	// Validate if application already exists for this position and email
 	existing, err := s.applicationRepo.GetByEmailAndPosition(ctx, req.Email, req.PositionID)
 	if err != nil && err != domainErrors.ErrApplicationNotFound {
@@ -70,6 +142,21 @@ func (s *ApplicationService) CreateApplication(
 		return nil, domainErrors.ErrApplicationAlreadyExists
 	}
 
+	// Answers to the position's custom questions are checked before anything is stored
+	answers, err := s.buildAnswers(ctx, position.ID, req.Answers)
+	if err != nil {
+		return nil, err
+	}
+
+	// Attribute the application to the employee whose referral code was given
+	var referral *entities.ReferralCode
+	if strings.TrimSpace(req.ReferralCode) != "" {
+		referral, err = s.resolveReferral(ctx, req.ReferralCode, req.Email)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// Upload resume file
 	resumeURL, err := s.uploadResume(ctx, resumeFile, resumeFilename, req.Email)
 	if err != nil {
@@ -89,13 +176,24 @@ func (s *ApplicationService) CreateApplication(
 		Status:      entities.StatusPending,
 		IPAddress:   metadata["ip_address"],
 		UserAgent:   metadata["user_agent"],
-		Source:      metadata["source"],
+		Answers:     answers,
 		CreatedAt:   time.Now(),
 		UpdatedAt:   time.Now(),
 	}
+	application.Attribution = buildAttribution(req.Attribution, application.CreatedAt)
+	application.Source = attributionSource(application.Attribution, s.siteHost)
+	if referral != nil {
+		application.ReferralCodeID = &referral.ID
+		application.Source = entities.ApplicationSourceReferral
+	}
 
-	// Save to database
-	if err := s.applicationRepo.Create(ctx, application); err != nil {
+	// Save to database, linked to the candidate whose profile is created or refreshed with it
+	candidate := &entities.Candidate{
+		Email: req.Email,
+		Name:  req.Name,
+		Phone: req.Phone,
+	}
+	if err := s.applicationRepo.Create(ctx, application, candidate); err != nil {
 		s.logger.Error("Failed to create application", zap.Error(err))
 		
 		// Clean up uploaded file
@@ -106,9 +204,10 @@ func (s *ApplicationService) CreateApplication(
 		return nil, domainErrors.ErrDatabaseQuery
 	}
 
-	// Send confirmation email (async)
+	// Send confirmation email with a link to withdraw (async)
+	withdrawLink := s.linkBaseURL + signExpiringLink(s.linkSecret, withdrawLinkPurpose, application.ID, time.Now().Add(withdrawLinkTTL))
 	go func() {
-		if err := s.emailService.SendApplicationConfirmation(application.Email, application.Name, application.PositionID); err != nil {
+		if err := s.emailService.SendApplicationConfirmation(application.Email, application.Name, position.Title, withdrawLink); err != nil {
 			s.logger.Error("Failed to send confirmation email", 
 				zap.String("email", application.Email),
 				zap.Error(err))
@@ -121,7 +220,7 @@ func (s *ApplicationService) CreateApplication(
 		if err := s.emailService.SendHRNotification(
 			application.Email,     // Candidate's email (for reference)
 			application.Name,      // Candidate's name
-			application.PositionID, // Position applied for
+			position.Title,        // Position applied for
 			application.ResumeURL, // Resume download link
 		); err != nil {
 			s.logger.Error("Failed to send HR notification email", 
@@ -136,6 +235,11 @@ func (s *ApplicationService) CreateApplication(
 		zap.String("email", application.Email),
 		zap.String("position", application.PositionID))
 
+	// Run the position's screening rules (async); the request context ends with the response
+	if s.screener != nil {
+		go s.screener.Screen(context.Background(), application.ID)
+	}
+
 	return dto.ToApplicationResponse(application), nil
 }
 
@@ -162,6 +266,8 @@ func (s *ApplicationService) ListApplications(ctx context.Context, req *dto.List
 		Email:      req.Email,
 		DateFrom:   req.DateFrom,
 		DateTo:     req.DateTo,
+		Tags:       req.Tags,
+		TagMatch:   req.TagMatch,
 		Page:       req.Page,
 		PageSize:   req.PageSize,
 		SortBy:     req.SortBy,
@@ -181,6 +287,21 @@ func (s *ApplicationService) ListApplications(ctx context.Context, req *dto.List
 	if filter.SortOrder == "" {
 		filter.SortOrder = "desc"
 	}
+	if filter.TagMatch == "" {
+		filter.TagMatch = repositories.TagMatchAny
+	}
+	// Tags that normalize to the same name would never all match with tag_match=all
+	tags := make([]string, 0, len(filter.Tags))
+	seen := make(map[string]bool, len(filter.Tags))
+	for _, tag := range filter.Tags {
+		tag = entities.NormalizeTagName(tag)
+		if tag == "" || seen[tag] {
+			continue
+		}
+		seen[tag] = true
+		tags = append(tags, tag)
+	}
+	filter.Tags = tags
 
 	applications, total, err := s.applicationRepo.List(ctx, filter)
 	if err != nil {
@@ -211,34 +332,265 @@ func (s *ApplicationService) UpdateApplicationStatus(
 		return nil, domainErrors.ErrDatabaseQuery
 	}
 
-	// Update status using domain logic
-	if err := application.UpdateStatus(req.Status, req.ProcessedBy); err != nil {
+	// Notes describe this transition and are kept on its status event;
+	// reviewer discussion belongs in comments
+	change := StatusChange{
+		Status:   req.Status,
+		Actor:    req.ProcessedBy,
+		Notes:    req.Notes,
+		Override: req.Override,
+	}
+	if err := s.ChangeStatus(ctx, application, change); err != nil {
+		return nil, err
+	}
+
+	return dto.ToApplicationResponse(application), nil
+}
+
+// StatusChange describes a requested application status transition
+type StatusChange struct {
+	Status   entities.ApplicationStatus
+	Actor    string
+	Notes    string
+	Override bool
+}
+
+// ChangeStatus applies a status transition to a loaded application and saves it
+// together with its status history entry. Every status change goes through here.
+func (s *ApplicationService) ChangeStatus(ctx context.Context, application *entities.Application, change StatusChange) error {
+	event, err := s.applyStatusChange(application, change)
+	if err != nil {
+		return err
+	}
+
+	// Save to database together with the status history entry
+	if err := s.applicationRepo.UpdateWithStatusEvent(ctx, application, event); err != nil {
+		if err == domainErrors.ErrApplicationChanged {
+			return err
+		}
+		s.logger.Error("Failed to update application status",
+			zap.String("id", application.ID), zap.Error(err))
+		return domainErrors.ErrDatabaseQuery
+	}
+
+	s.statusChanged(ctx, application, event)
+	return nil
+}
+
+// applyStatusChange moves a loaded application to the new status without saving it
+// and returns the status history entry to save with it. Callers that save the change
+// in their own transaction call statusChanged once it is committed.
+func (s *ApplicationService) applyStatusChange(application *entities.Application, change StatusChange) (*entities.ApplicationStatusEvent, error) {
+	// Update status using domain logic; only admins may override the transition graph
+	previousStatus := application.Status
+	updateStatus := application.UpdateStatus
+	if change.Override {
+		updateStatus = application.OverrideStatus
+	}
+	if err := updateStatus(change.Status, change.Actor); err != nil {
 		s.logger.Error("Invalid status transition", 
-			zap.String("id", id),
-			zap.String("from", string(application.Status)),
-			zap.String("to", string(req.Status)),
+			zap.String("id", application.ID),
+			zap.String("from", string(previousStatus)),
+			zap.String("to", string(change.Status)),
 			zap.Error(err))
 		return nil, err
 	}
 
-	// Update notes if provided
-	if req.Notes != "" {
-		application.Notes = req.Notes
+	return entities.NewStatusEvent(application.ID, previousStatus, application.Status, change.Actor, change.Notes, change.Override), nil
+}
+
+// statusChanged logs a saved status change and notifies the referrer, if any
+func (s *ApplicationService) statusChanged(ctx context.Context, application *entities.Application, event *entities.ApplicationStatusEvent) {
+	s.logger.Info("Application status updated",
+		zap.String("id", application.ID),
+		zap.String("from", string(event.FromStatus)),
+		zap.String("status", string(application.Status)),
+		zap.String("processed_by", event.Actor),
+		zap.Bool("override", event.Override))
+
+	if application.ReferralCodeID != nil {
+		s.notifyReferrer(ctx, application)
 	}
+}
 
-	// Save to database
-	if err := s.applicationRepo.Update(ctx, application); err != nil {
-		s.logger.Error("Failed to update application status", 
-			zap.String("id", id), zap.Error(err))
+// buildAnswers checks a candidate's answers against the custom questions of a
+// position. Every problem is reported at once, keyed by question ID.
+func (s *ApplicationService) buildAnswers(ctx context.Context, positionID string, given map[string]interface{}) ([]entities.ApplicationAnswer, error) {
+	questions, err := s.positionRepo.ListQuestions(ctx, positionID)
+	if err != nil {
+		s.logger.Error("Failed to list position questions", zap.String("position_id", positionID), zap.Error(err))
 		return nil, domainErrors.ErrDatabaseQuery
 	}
 
-	s.logger.Info("Application status updated", 
-		zap.String("id", id),
-		zap.String("status", string(req.Status)),
-		zap.String("processed_by", req.ProcessedBy))
+	problems := make(map[string]string)
+	known := make(map[string]bool, len(questions))
+	var answers []entities.ApplicationAnswer
+	for _, question := range questions {
+		known[question.ID] = true
+		values, err := question.NormalizeAnswer(given[question.ID])
+		if err != nil {
+			problems[question.ID] = err.Error()
+			continue
+		}
+		if len(values) == 0 {
+			continue
+		}
+		answers = append(answers, entities.ApplicationAnswer{
+			QuestionID: question.ID,
+			Label:      question.Label,
+			Type:       question.Type,
+			Values:     values,
+			SortOrder:  question.SortOrder,
+			CreatedAt:  time.Now(),
+		})
+	}
+	for id := range given {
+		if !known[id] {
+			problems[id] = "unknown question"
+		}
+	}
 
-	return dto.ToApplicationResponse(application), nil
+	if len(problems) > 0 {
+		return nil, &entities.AnswerValidationError{Fields: problems}
+	}
+	return answers, nil
+}
+
+// resolveReferral looks up the active referral code a candidate applied with.
+// Employees cannot refer themselves.
+func (s *ApplicationService) resolveReferral(ctx context.Context, code, candidateEmail string) (*entities.ReferralCode, error) {
+	referral, err := s.referralRepo.GetByCode(ctx, entities.NormalizeReferralCode(code))
+	if err != nil {
+		if err == domainErrors.ErrReferralCodeNotFound {
+			return nil, err
+		}
+		s.logger.Error("Failed to get referral code", zap.Error(err))
+		return nil, domainErrors.ErrDatabaseQuery
+	}
+	if !referral.Active {
+		return nil, domainErrors.ErrReferralCodeNotFound
+	}
+	if strings.EqualFold(strings.TrimSpace(candidateEmail), referral.EmployeeEmail) {
+		return nil, domainErrors.ErrSelfReferral
+	}
+	return referral, nil
+}
+
+// notifyReferrer tells the employee who referred the candidate about a status change (async).
+// Notes and reasons stay internal; the referrer only learns the new status.
+func (s *ApplicationService) notifyReferrer(ctx context.Context, application *entities.Application) {
+	referral, err := s.referralRepo.GetByID(ctx, *application.ReferralCodeID)
+	if err != nil {
+		s.logger.Error("Failed to get referral code for notification",
+			zap.String("application_id", application.ID),
+			zap.Error(err))
+		return
+	}
+
+	position := positionTitle(ctx, s.positionRepo, application)
+	candidateName, status := application.Name, application.Status
+	go func() {
+		if err := s.emailService.SendReferralStatusUpdate(referral, candidateName, position, status); err != nil {
+			s.logger.Error("Failed to send referral status update",
+				zap.String("application_id", application.ID),
+				zap.String("referral_code_id", referral.ID),
+				zap.Error(err))
+		}
+	}()
+}
+
+// GetApplicationTimeline retrieves the status history of an application with time spent in each stage
+func (s *ApplicationService) GetApplicationTimeline(ctx context.Context, id string) (*dto.ApplicationTimelineResponse, error) {
+	application, err := s.applicationRepo.GetByID(ctx, id)
+	if err != nil {
+		if err == domainErrors.ErrApplicationNotFound {
+			return nil, err
+		}
+		s.logger.Error("Failed to get application for timeline", zap.String("id", id), zap.Error(err))
+		return nil, domainErrors.ErrDatabaseQuery
+	}
+
+	events, err := s.applicationRepo.ListStatusEvents(ctx, id)
+	if err != nil {
+		s.logger.Error("Failed to get status events", zap.String("id", id), zap.Error(err))
+		return nil, domainErrors.ErrDatabaseQuery
+	}
+
+	return dto.ToApplicationTimelineResponse(application, events, time.Now()), nil
+}
+
+// GetWithdrawal returns the application behind a candidate's withdraw link
+func (s *ApplicationService) GetWithdrawal(ctx context.Context, token string) (*dto.WithdrawalResponse, error) {
+	application, err := s.openWithdrawLink(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	return s.withdrawalResponse(ctx, application), nil
+}
+
+// WithdrawApplication withdraws the application behind a candidate's withdraw link
+// and notifies HR. Withdrawing an already withdrawn application does nothing.
+func (s *ApplicationService) WithdrawApplication(ctx context.Context, token string, req *dto.WithdrawApplicationRequest) (*dto.WithdrawalResponse, error) {
+	application, err := s.openWithdrawLink(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	if application.Status == entities.StatusWithdrawn {
+		return s.withdrawalResponse(ctx, application), nil
+	}
+
+	reason := strings.TrimSpace(req.Reason)
+	notes := "Withdrawn by candidate"
+	if reason != "" {
+		notes += ": " + reason
+	}
+	change := StatusChange{
+		Status: entities.StatusWithdrawn,
+		Actor:  candidateActor,
+		Notes:  notes,
+	}
+	if err := s.ChangeStatus(ctx, application, change); err != nil {
+		return nil, err
+	}
+
+	position := positionTitle(ctx, s.positionRepo, application)
+	go func() {
+		if err := s.emailService.SendWithdrawalNotification(application.Email, application.Name, position, reason); err != nil {
+			s.logger.Error("Failed to send withdrawal notification",
+				zap.String("application_id", application.ID),
+				zap.Error(err))
+		}
+	}()
+
+	return s.withdrawalResponse(ctx, application), nil
+}
+
+// openWithdrawLink verifies a withdraw link and loads its application
+func (s *ApplicationService) openWithdrawLink(ctx context.Context, token string) (*entities.Application, error) {
+	id, err := verifyExpiringLink(s.linkSecret, withdrawLinkPurpose, token, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	application, err := s.applicationRepo.GetByID(ctx, id)
+	if err != nil {
+		if err == domainErrors.ErrApplicationNotFound {
+			return nil, domainErrors.ErrInvalidLink
+		}
+		s.logger.Error("Failed to get application for withdrawal", zap.String("id", id), zap.Error(err))
+		return nil, domainErrors.ErrDatabaseQuery
+	}
+	return application, nil
+}
+
+func (s *ApplicationService) withdrawalResponse(ctx context.Context, application *entities.Application) *dto.WithdrawalResponse {
+	return &dto.WithdrawalResponse{
+		CandidateName: application.Name,
+		Position:      positionTitle(ctx, s.positionRepo, application),
+		Status:        application.Status,
+		AppliedAt:     application.CreatedAt,
+		CanWithdraw:   application.Status.CanTransitionTo(entities.StatusWithdrawn),
+	}
 }
 
 // DeleteApplication deletes an application
@@ -332,5 +684,5 @@ func (s *ApplicationService) isValidResumeFile(filename string) bool {
 // TestEmailConfiguration sends a test email to verify email setup
 func (s *ApplicationService) TestEmailConfiguration(ctx context.Context, testEmail string) error {
 	// Send a simple test email
-	return s.emailService.SendApplicationConfirmation(testEmail, "Test User", "Email Configuration Test")
+	return s.emailService.SendApplicationConfirmation(testEmail, "Test User", "Email Configuration Test", "")
 } 
\ No newline at end of file
@@ -0,0 +1,110 @@
+package services
+
+import (
+	"net/url"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"super2025-backend/internal/application/dto"
+	"super2025-backend/internal/domain/entities"
+)
+
+const (
+	// maxAttributionValueLength limits stored UTM values, in characters
+	maxAttributionValueLength = 100
+	// maxAttributionURLLength limits stored referrer and landing page URLs, in bytes
+	maxAttributionURLLength = 500
+)
+
+// buildAttribution normalizes the attribution captured with an application. UTM
+// parameters missing from the request are taken from the landing page URL.
+// Returns nil when nothing was captured.
+func buildAttribution(req dto.AttributionRequest, now time.Time) *entities.ApplicationAttribution {
+	landingPage := cleanAttributionURL(req.LandingPage, true)
+	var landingQuery url.Values
+	if landingPage != "" {
+		if parsed, err := url.Parse(landingPage); err == nil {
+			landingQuery = parsed.Query()
+		}
+	}
+	utm := func(value, param string) string {
+		if strings.TrimSpace(value) == "" {
+			value = landingQuery.Get(param)
+		}
+		return attributionValue(value)
+	}
+
+	attribution := &entities.ApplicationAttribution{
+		UTMSource:   utm(req.UTMSource, "utm_source"),
+		UTMMedium:   utm(req.UTMMedium, "utm_medium"),
+		UTMCampaign: utm(req.UTMCampaign, "utm_campaign"),
+		UTMTerm:     utm(req.UTMTerm, "utm_term"),
+		UTMContent:  utm(req.UTMContent, "utm_content"),
+		Referrer:    cleanAttributionURL(req.Referrer, false),
+		LandingPage: landingPage,
+		CreatedAt:   now,
+	}
+	if *attribution == (entities.ApplicationAttribution{CreatedAt: now}) {
+		return nil
+	}
+	return attribution
+}
+
+// attributionSource decides which source an application counts towards: the UTM
+// source if there is one, otherwise the host of an external referrer, otherwise direct.
+// Referrers on the careers site itself are internal navigation and are ignored.
+func attributionSource(attribution *entities.ApplicationAttribution, siteHost string) string {
+	if attribution == nil {
+		return entities.ApplicationSourceDirect
+	}
+	if attribution.UTMSource != "" {
+		return attribution.UTMSource
+	}
+
+	referrerHost := attributionHost(attribution.Referrer)
+	if referrerHost != "" && referrerHost != siteHost && referrerHost != attributionHost(attribution.LandingPage) {
+		return referrerHost
+	}
+	return entities.ApplicationSourceDirect
+}
+
+// attributionHost returns the lower-case host of a URL without a leading "www."
+func attributionHost(rawURL string) string {
+	parsed, err := url.Parse(rawURL)
+	if err != nil {
+		return ""
+	}
+	return strings.TrimPrefix(strings.ToLower(parsed.Hostname()), "www.")
+}
+
+// attributionValue normalizes a UTM value: trimmed, lower-case and length-limited
+func attributionValue(value string) string {
+	value = strings.ToLower(strings.TrimSpace(value))
+	if utf8.RuneCountInString(value) > maxAttributionValueLength {
+		value = string([]rune(value)[:maxAttributionValueLength])
+	}
+	return value
+}
+
+// cleanAttributionURL keeps only absolute http(s) URLs, without credentials or
+// fragment. The query string is only kept when keepQuery is set, since referrer
+// queries can carry search terms or personal data.
+func cleanAttributionURL(rawURL string, keepQuery bool) string {
+	parsed, err := url.Parse(strings.TrimSpace(rawURL))
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return ""
+	}
+	parsed.User = nil
+	parsed.Fragment = ""
+	parsed.RawFragment = ""
+	if !keepQuery {
+		parsed.RawQuery = ""
+	}
+
+	cleaned := parsed.String()
+	if len(cleaned) > maxAttributionURLLength {
+		cleaned = cleaned[:maxAttributionURLLength]
+	}
+	return cleaned
+}
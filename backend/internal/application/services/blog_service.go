@@ -0,0 +1,339 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"go.uber.org/zap"
+
+	"super2025-backend/internal/application/dto"
+	"super2025-backend/internal/domain/entities"
+	domainErrors "super2025-backend/internal/domain/errors"
+	"super2025-backend/internal/domain/repositories"
+	"super2025-backend/internal/infrastructure/feed"
+)
+
+const (
+	// feedSize is the number of latest posts included in the feeds
+	feedSize = 20
+	// maxSlugSuffix bounds the numbered suffixes tried when a generated slug is taken
+	maxSlugSuffix = 50
+)
+
+// Feed formats served by BlogService.Feed
+const (
+	FeedRSS  = "rss"
+	FeedAtom = "atom"
+)
+
+// BlogService implements business logic for blog posts and their feeds
+type BlogService struct {
+	postRepo    repositories.PostRepository
+	siteURL     string
+	feedBaseURL string
+	logger      *zap.Logger
+}
+
+// NewBlogService creates a new blog service. Post links in feeds are siteURL followed
+// by the slug; feeds advertise themselves under feedBaseURL.
+func NewBlogService(postRepo repositories.PostRepository, siteURL, feedBaseURL string, logger *zap.Logger) *BlogService {
+	return &BlogService{
+		postRepo:    postRepo,
+		siteURL:     strings.TrimRight(siteURL, "/"),
+		feedBaseURL: strings.TrimRight(feedBaseURL, "/"),
+		logger:      logger,
+	}
+}
+
+// CreatePost creates a blog post. Without an explicit slug one is derived from the
+// title, numbered if needed to keep it unique.
+func (s *BlogService) CreatePost(ctx context.Context, req *dto.CreatePostRequest) (*dto.PostResponse, error) {
+	post := &entities.Post{}
+	if err := applyPostRequest(post, req, time.Now()); err != nil {
+		return nil, err
+	}
+
+	slug, err := s.uniqueSlug(ctx, req.Slug, post.Title, "")
+	if err != nil {
+		return nil, err
+	}
+	post.Slug = slug
+
+	if err := s.postRepo.Create(ctx, post); err != nil {
+		s.logger.Error("Failed to create post", zap.Error(err))
+		return nil, domainErrors.ErrDatabaseQuery
+	}
+
+	s.logger.Info("Post created",
+		zap.String("id", post.ID),
+		zap.String("slug", post.Slug),
+		zap.String("status", string(post.Status)))
+
+	return dto.ToPostResponse(post), nil
+}
+
+// GetPost retrieves a post by slug. Drafts and scheduled posts are only visible when includeDrafts is set.
+func (s *BlogService) GetPost(ctx context.Context, slug string, includeDrafts bool) (*dto.PostResponse, error) {
+	post, err := s.postRepo.GetBySlug(ctx, slug)
+	if err != nil {
+		if err == domainErrors.ErrPostNotFound {
+			return nil, err
+		}
+		s.logger.Error("Failed to get post", zap.String("slug", slug), zap.Error(err))
+		return nil, domainErrors.ErrDatabaseQuery
+	}
+	if !includeDrafts && !post.IsLive(time.Now()) {
+		return nil, domainErrors.ErrPostNotFound
+	}
+	return dto.ToPostResponse(post), nil
+}
+
+// ListPosts retrieves posts, newest first. Without includeDrafts only live posts are listed.
+func (s *BlogService) ListPosts(ctx context.Context, req *dto.ListPostsRequest, includeDrafts bool) (*dto.ListPostsResponse, error) {
+	filter := repositories.PostFilter{
+		Status:       req.Status,
+		CategorySlug: entities.Slugify(req.Category),
+		Page:         req.Page,
+		PageSize:     req.PageSize,
+	}
+	if !includeDrafts {
+		now := time.Now()
+		filter.Status = ""
+		filter.LiveAt = &now
+	}
+
+	// Set defaults
+	if filter.Page == 0 {
+		filter.Page = 1
+	}
+	if filter.PageSize == 0 {
+		filter.PageSize = 10
+	}
+
+	posts, total, err := s.postRepo.List(ctx, filter)
+	if err != nil {
+		s.logger.Error("Failed to list posts", zap.Error(err))
+		return nil, domainErrors.ErrDatabaseQuery
+	}
+
+	response := &dto.ListPostsResponse{
+		Posts:      make([]*dto.PostSummaryResponse, len(posts)),
+		Pagination: dto.CalculatePagination(filter.Page, filter.PageSize, total),
+	}
+	for i, post := range posts {
+		response.Posts[i] = dto.ToPostSummaryResponse(post)
+	}
+	return response, nil
+}
+
+// ListCategories retrieves the categories in use with their post counts
+func (s *BlogService) ListCategories(ctx context.Context, includeDrafts bool) (*dto.ListPostCategoriesResponse, error) {
+	var filter repositories.PostFilter
+	if !includeDrafts {
+		now := time.Now()
+		filter.LiveAt = &now
+	}
+
+	categories, err := s.postRepo.ListCategories(ctx, filter)
+	if err != nil {
+		s.logger.Error("Failed to list post categories", zap.Error(err))
+		return nil, domainErrors.ErrDatabaseQuery
+	}
+	if categories == nil {
+		categories = []*entities.PostCategory{}
+	}
+	return &dto.ListPostCategoriesResponse{Categories: categories}, nil
+}
+
+// UpdatePost replaces the fields of a post
+func (s *BlogService) UpdatePost(ctx context.Context, id string, req *dto.UpdatePostRequest) (*dto.PostResponse, error) {
+	post, err := s.getPost(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := applyPostRequest(post, req, time.Now()); err != nil {
+		return nil, err
+	}
+	if req.Slug != "" && req.Slug != post.Slug {
+		slug, err := s.uniqueSlug(ctx, req.Slug, post.Title, post.ID)
+		if err != nil {
+			return nil, err
+		}
+		post.Slug = slug
+	}
+
+	if err := s.postRepo.Update(ctx, post); err != nil {
+		s.logger.Error("Failed to update post", zap.String("id", id), zap.Error(err))
+		return nil, domainErrors.ErrDatabaseQuery
+	}
+
+	s.logger.Info("Post updated",
+		zap.String("id", id),
+		zap.String("slug", post.Slug),
+		zap.String("status", string(post.Status)))
+
+	return dto.ToPostResponse(post), nil
+}
+
+// DeletePost deletes a post
+func (s *BlogService) DeletePost(ctx context.Context, id string) error {
+	if err := s.postRepo.Delete(ctx, id); err != nil {
+		if err == domainErrors.ErrPostNotFound {
+			return err
+		}
+		s.logger.Error("Failed to delete post", zap.String("id", id), zap.Error(err))
+		return domainErrors.ErrDatabaseQuery
+	}
+
+	s.logger.Info("Post deleted", zap.String("id", id))
+	return nil
+}
+
+// Feed renders the latest live posts as an RSS or Atom feed
+func (s *BlogService) Feed(ctx context.Context, format string) ([]byte, error) {
+	now := time.Now()
+	posts, _, err := s.postRepo.List(ctx, repositories.PostFilter{LiveAt: &now, Page: 1, PageSize: feedSize})
+	if err != nil {
+		s.logger.Error("Failed to list posts for feed", zap.Error(err))
+		return nil, domainErrors.ErrDatabaseQuery
+	}
+
+	f := feed.Feed{
+		Title:       "Super 2025 Blog",
+		Link:        s.siteURL,
+		SelfLink:    s.feedBaseURL + "/feed." + format,
+		Description: "Articles from the Super 2025 team",
+		Updated:     now,
+	}
+	for i, post := range posts {
+		if i == 0 {
+			f.Updated = post.UpdatedAt
+		}
+		f.Items = append(f.Items, feed.Item{
+			ID:        "urn:uuid:" + post.ID,
+			Title:     post.Title,
+			Link:      s.siteURL + "/" + post.Slug,
+			Summary:   post.Excerpt,
+			Author:    post.Author,
+			Category:  post.Category,
+			Published: *post.PublishedAt,
+			Updated:   post.UpdatedAt,
+		})
+	}
+
+	var data []byte
+	switch format {
+	case FeedRSS:
+		data, err = f.RenderRSS()
+	case FeedAtom:
+		data, err = f.RenderAtom()
+	default:
+		return nil, fmt.Errorf("%w: unknown feed format %q", domainErrors.ErrValidationFailed, format)
+	}
+	if err != nil {
+		s.logger.Error("Failed to render feed", zap.String("format", format), zap.Error(err))
+		return nil, domainErrors.ErrInternalServer
+	}
+	return data, nil
+}
+
+// uniqueSlug returns the slug for a post. An explicit slug must be free; a slug
+// derived from the title gets a numbered suffix until it is.
+func (s *BlogService) uniqueSlug(ctx context.Context, requested, title, excludeID string) (string, error) {
+	if requested != "" {
+		if requested != entities.Slugify(requested) || len(requested) > 200 {
+			return "", fmt.Errorf("%w: slug must be a lowercase slug such as future-of-ai", domainErrors.ErrValidationFailed)
+		}
+		taken, err := s.postRepo.SlugExists(ctx, requested, excludeID)
+		if err != nil {
+			s.logger.Error("Failed to check post slug", zap.Error(err))
+			return "", domainErrors.ErrDatabaseQuery
+		}
+		if taken {
+			return "", domainErrors.ErrPostSlugTaken
+		}
+		return requested, nil
+	}
+
+	base := entities.Slugify(title)
+	if len(base) > 190 {
+		base = strings.TrimRight(base[:190], "-")
+	}
+	if base == "" {
+		return "", fmt.Errorf("%w: a slug is required when the title has no letters or digits", domainErrors.ErrValidationFailed)
+	}
+	for n := 1; n <= maxSlugSuffix; n++ {
+		slug := base
+		if n > 1 {
+			slug = fmt.Sprintf("%s-%d", base, n)
+		}
+		taken, err := s.postRepo.SlugExists(ctx, slug, excludeID)
+		if err != nil {
+			s.logger.Error("Failed to check post slug", zap.Error(err))
+			return "", domainErrors.ErrDatabaseQuery
+		}
+		if !taken {
+			return slug, nil
+		}
+	}
+	return "", domainErrors.ErrPostSlugTaken
+}
+
+func (s *BlogService) getPost(ctx context.Context, id string) (*entities.Post, error) {
+	post, err := s.postRepo.GetByID(ctx, id)
+	if err != nil {
+		if err == domainErrors.ErrPostNotFound {
+			return nil, err
+		}
+		s.logger.Error("Failed to get post", zap.String("id", id), zap.Error(err))
+		return nil, domainErrors.ErrDatabaseQuery
+	}
+	return post, nil
+}
+
+// applyPostRequest validates a create or update request and copies it onto post.
+// Publishing without a date publishes now; an existing publication date is kept.
+func applyPostRequest(post *entities.Post, req *dto.CreatePostRequest, now time.Time) error {
+	title := strings.TrimSpace(req.Title)
+	author := strings.TrimSpace(req.Author)
+	status := req.Status
+	if status == "" {
+		status = entities.PostStatusDraft
+	}
+
+	switch {
+	case utf8.RuneCountInString(title) < 2 || utf8.RuneCountInString(title) > 200:
+		return fmt.Errorf("%w: title must be between 2 and 200 characters", domainErrors.ErrValidationFailed)
+	case strings.TrimSpace(req.Body) == "":
+		return fmt.Errorf("%w: body is required", domainErrors.ErrValidationFailed)
+	case utf8.RuneCountInString(author) < 2 || utf8.RuneCountInString(author) > 100:
+		return fmt.Errorf("%w: author must be between 2 and 100 characters", domainErrors.ErrValidationFailed)
+	case utf8.RuneCountInString(req.Excerpt) > 500:
+		return fmt.Errorf("%w: excerpt must be at most 500 characters", domainErrors.ErrValidationFailed)
+	case utf8.RuneCountInString(req.Category) > 100:
+		return fmt.Errorf("%w: category must be at most 100 characters", domainErrors.ErrValidationFailed)
+	case !status.IsValid():
+		return fmt.Errorf("%w: status must be draft or published", domainErrors.ErrValidationFailed)
+	}
+
+	post.Title = title
+	post.Excerpt = strings.TrimSpace(req.Excerpt)
+	post.Body = req.Body
+	post.Category = strings.TrimSpace(req.Category)
+	post.Author = author
+	post.CoverImage = strings.TrimSpace(req.CoverImage)
+	post.Status = status
+	if req.PublishedAt != nil {
+		publishedAt := req.PublishedAt.UTC()
+		post.PublishedAt = &publishedAt
+	}
+	if post.Status == entities.PostStatusPublished && post.PublishedAt == nil {
+		publishedAt := now.UTC()
+		post.PublishedAt = &publishedAt
+	}
+	return nil
+}
@@ -0,0 +1,51 @@
+package services
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"super2025-backend/internal/application/dto"
+	domainErrors "super2025-backend/internal/domain/errors"
+	"super2025-backend/internal/domain/repositories"
+)
+
+// CandidateService implements business logic for candidates
+type CandidateService struct {
+	candidateRepo   repositories.CandidateRepository
+	applicationRepo repositories.ApplicationRepository
+	logger          *zap.Logger
+}
+
+// NewCandidateService creates a new candidate service
+func NewCandidateService(
+	candidateRepo repositories.CandidateRepository,
+	applicationRepo repositories.ApplicationRepository,
+	logger *zap.Logger,
+) *CandidateService {
+	return &CandidateService{
+		candidateRepo:   candidateRepo,
+		applicationRepo: applicationRepo,
+		logger:          logger,
+	}
+}
+
+// GetCandidate retrieves a candidate profile with all of their applications
+func (s *CandidateService) GetCandidate(ctx context.Context, id string) (*dto.CandidateResponse, error) {
+	candidate, err := s.candidateRepo.GetByID(ctx, id)
+	if err != nil {
+		if err == domainErrors.ErrCandidateNotFound {
+			return nil, err
+		}
+		s.logger.Error("Failed to get candidate", zap.String("id", id), zap.Error(err))
+		return nil, domainErrors.ErrDatabaseQuery
+	}
+
+	applications, err := s.applicationRepo.ListByCandidate(ctx, candidate.ID)
+	if err != nil {
+		s.logger.Error("Failed to get candidate applications", zap.String("id", id), zap.Error(err))
+		return nil, domainErrors.ErrDatabaseQuery
+	}
+
+	return dto.ToCandidateResponse(candidate, applications), nil
+}
@@ -0,0 +1,201 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"super2025-backend/internal/application/dto"
+	"super2025-backend/internal/domain/entities"
+	domainErrors "super2025-backend/internal/domain/errors"
+	"super2025-backend/internal/domain/repositories"
+)
+
+// maxCommentLength bounds the size of a single reviewer comment
+const maxCommentLength = 10000
+
+// CommentService implements business logic for reviewer comments
+type CommentService struct {
+	commentRepo     repositories.CommentRepository
+	applicationRepo repositories.ApplicationRepository
+	logger          *zap.Logger
+}
+
+// NewCommentService creates a new comment service
+func NewCommentService(
+	commentRepo repositories.CommentRepository,
+	applicationRepo repositories.ApplicationRepository,
+	logger *zap.Logger,
+) *CommentService {
+	return &CommentService{
+		commentRepo:     commentRepo,
+		applicationRepo: applicationRepo,
+		logger:          logger,
+	}
+}
+
+// CreateComment adds a reviewer comment, or a reply when ParentID is set
+func (s *CommentService) CreateComment(ctx context.Context, applicationID, author string, req *dto.CreateCommentRequest) (*dto.CommentResponse, error) {
+	if err := s.ensureApplication(ctx, applicationID); err != nil {
+		return nil, err
+	}
+	if err := validateComment(author, req.Body, req.Visibility); err != nil {
+		return nil, err
+	}
+
+	comment := &entities.ApplicationComment{
+		ApplicationID: applicationID,
+		Author:        author,
+		Body:          strings.TrimSpace(req.Body),
+		Visibility:    req.Visibility,
+	}
+	if comment.Visibility == "" {
+		comment.Visibility = entities.VisibilityTeam
+	}
+
+	// Threads are one level deep: replying to a reply attaches to its root
+	if req.ParentID != "" {
+		parent, err := s.getComment(ctx, applicationID, req.ParentID)
+		if err != nil {
+			return nil, err
+		}
+		if !parent.VisibleTo(author) {
+			return nil, domainErrors.ErrCommentNotFound
+		}
+		rootID := parent.ID
+		if parent.ParentID != nil {
+			rootID = *parent.ParentID
+		}
+		comment.ParentID = &rootID
+	}
+
+	if err := s.commentRepo.Create(ctx, comment); err != nil {
+		s.logger.Error("Failed to create comment", zap.String("application_id", applicationID), zap.Error(err))
+		return nil, domainErrors.ErrDatabaseQuery
+	}
+
+	s.logger.Info("Comment created",
+		zap.String("id", comment.ID),
+		zap.String("application_id", applicationID),
+		zap.String("author", author))
+
+	return dto.ToCommentResponse(comment), nil
+}
+
+// ListComments retrieves the comment threads of an application visible to viewer
+func (s *CommentService) ListComments(ctx context.Context, applicationID, viewer string) (*dto.ListCommentsResponse, error) {
+	if err := s.ensureApplication(ctx, applicationID); err != nil {
+		return nil, err
+	}
+
+	comments, err := s.commentRepo.ListByApplication(ctx, applicationID)
+	if err != nil {
+		s.logger.Error("Failed to list comments", zap.String("application_id", applicationID), zap.Error(err))
+		return nil, domainErrors.ErrDatabaseQuery
+	}
+
+	visible := make([]*entities.ApplicationComment, 0, len(comments))
+	for _, comment := range comments {
+		if comment.VisibleTo(viewer) {
+			visible = append(visible, comment)
+		}
+	}
+
+	return &dto.ListCommentsResponse{Comments: dto.ToCommentThreads(visible)}, nil
+}
+
+// UpdateComment edits a comment. Only its author may edit it.
+func (s *CommentService) UpdateComment(ctx context.Context, applicationID, commentID, author string, req *dto.UpdateCommentRequest) (*dto.CommentResponse, error) {
+	if err := validateComment(author, req.Body, req.Visibility); err != nil {
+		return nil, err
+	}
+
+	comment, err := s.getAuthoredComment(ctx, applicationID, commentID, author)
+	if err != nil {
+		return nil, err
+	}
+
+	comment.Edit(strings.TrimSpace(req.Body), req.Visibility)
+	if err := s.commentRepo.Update(ctx, comment); err != nil {
+		s.logger.Error("Failed to update comment", zap.String("id", commentID), zap.Error(err))
+		return nil, domainErrors.ErrDatabaseQuery
+	}
+
+	return dto.ToCommentResponse(comment), nil
+}
+
+// DeleteComment deletes a comment and its replies. Only its author may delete it.
+func (s *CommentService) DeleteComment(ctx context.Context, applicationID, commentID, author string) error {
+	if _, err := s.getAuthoredComment(ctx, applicationID, commentID, author); err != nil {
+		return err
+	}
+
+	if err := s.commentRepo.Delete(ctx, applicationID, commentID); err != nil {
+		if err == domainErrors.ErrCommentNotFound {
+			return err
+		}
+		s.logger.Error("Failed to delete comment", zap.String("id", commentID), zap.Error(err))
+		return domainErrors.ErrDatabaseQuery
+	}
+
+	s.logger.Info("Comment deleted", zap.String("id", commentID), zap.String("author", author))
+	return nil
+}
+
+// ensureApplication checks that the application exists
+func (s *CommentService) ensureApplication(ctx context.Context, applicationID string) error {
+	if _, err := s.applicationRepo.GetByID(ctx, applicationID); err != nil {
+		if err == domainErrors.ErrApplicationNotFound {
+			return err
+		}
+		s.logger.Error("Failed to get application for comments", zap.String("id", applicationID), zap.Error(err))
+		return domainErrors.ErrDatabaseQuery
+	}
+	return nil
+}
+
+// getComment loads a comment and normalizes repository errors
+func (s *CommentService) getComment(ctx context.Context, applicationID, commentID string) (*entities.ApplicationComment, error) {
+	comment, err := s.commentRepo.GetByID(ctx, applicationID, commentID)
+	if err != nil {
+		if err == domainErrors.ErrCommentNotFound {
+			return nil, err
+		}
+		s.logger.Error("Failed to get comment", zap.String("id", commentID), zap.Error(err))
+		return nil, domainErrors.ErrDatabaseQuery
+	}
+	return comment, nil
+}
+
+// getAuthoredComment loads a comment and checks that author wrote it
+func (s *CommentService) getAuthoredComment(ctx context.Context, applicationID, commentID, author string) (*entities.ApplicationComment, error) {
+	comment, err := s.getComment(ctx, applicationID, commentID)
+	if err != nil {
+		return nil, err
+	}
+	if !comment.VisibleTo(author) {
+		return nil, domainErrors.ErrCommentNotFound
+	}
+	if comment.Author != author {
+		return nil, domainErrors.ErrForbidden
+	}
+	return comment, nil
+}
+
+// validateComment checks the author, body and visibility of a comment
+func validateComment(author, body string, visibility entities.CommentVisibility) error {
+	body = strings.TrimSpace(body)
+	switch {
+	case author == "":
+		return fmt.Errorf("%w: comment author is required", domainErrors.ErrValidationFailed)
+	case body == "":
+		return fmt.Errorf("%w: comment body is required", domainErrors.ErrValidationFailed)
+	case len(body) > maxCommentLength:
+		return fmt.Errorf("%w: comment body must be at most %d characters", domainErrors.ErrValidationFailed, maxCommentLength)
+	case visibility != "" && !visibility.IsValid():
+		return fmt.Errorf("%w: visibility must be private or team", domainErrors.ErrValidationFailed)
+	}
+	return nil
+}
@@ -0,0 +1,373 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/mail"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"go.uber.org/zap"
+
+	"super2025-backend/internal/application/dto"
+	"super2025-backend/internal/domain/entities"
+	domainErrors "super2025-backend/internal/domain/errors"
+	"super2025-backend/internal/domain/repositories"
+)
+
+const (
+	// consultationDateFormat is the format of the date range when listing slots
+	consultationDateFormat = "2006-01-02"
+	// maxSlotRangeDays bounds the date range of one slot listing
+	maxSlotRangeDays = 31
+	// maxConsultationTopicLength bounds the topic a visitor can describe
+	maxConsultationTopicLength = 2000
+)
+
+// ConsultationService implements consultation booking against a weekly availability
+type ConsultationService struct {
+	consultationRepo repositories.ConsultationRepository
+	emailService     EmailService
+	logger           *zap.Logger
+}
+
+// NewConsultationService creates a new consultation service
+func NewConsultationService(consultationRepo repositories.ConsultationRepository, emailService EmailService, logger *zap.Logger) *ConsultationService {
+	return &ConsultationService{
+		consultationRepo: consultationRepo,
+		emailService:     emailService,
+		logger:           logger,
+	}
+}
+
+// GetAvailability returns the configured weekly availability
+func (s *ConsultationService) GetAvailability(ctx context.Context) (*dto.AvailabilityResponse, error) {
+	availability, err := s.getAvailability(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return dto.ToAvailabilityResponse(availability), nil
+}
+
+// UpdateAvailability replaces the weekly availability. Existing bookings are kept.
+func (s *ConsultationService) UpdateAvailability(ctx context.Context, actor string, req *dto.UpdateAvailabilityRequest) (*dto.AvailabilityResponse, error) {
+	availability, err := buildAvailability(req)
+	if err != nil {
+		return nil, err
+	}
+	availability.UpdatedBy = actor
+	availability.UpdatedAt = time.Now()
+
+	if err := s.consultationRepo.SaveAvailability(ctx, availability); err != nil {
+		s.logger.Error("Failed to save consultation availability", zap.Error(err))
+		return nil, domainErrors.ErrDatabaseQuery
+	}
+
+	s.logger.Info("Consultation availability updated",
+		zap.Int("windows", len(availability.Windows)),
+		zap.String("actor", actor))
+
+	return dto.ToAvailabilityResponse(availability), nil
+}
+
+// ListSlots returns the open slots between two dates (inclusive) in the visitor's time zone
+func (s *ConsultationService) ListSlots(ctx context.Context, fromDate, toDate, timezone string) (*dto.ConsultationSlotsResponse, error) {
+	availability, err := s.getAvailability(ctx)
+	if err != nil && !errors.Is(err, domainErrors.ErrAvailabilityNotConfigured) {
+		return nil, err
+	}
+
+	if timezone == "" && availability != nil {
+		timezone = availability.Timezone
+	}
+	loc, err := loadVisitorLocation(timezone)
+	if err != nil {
+		return nil, err
+	}
+
+	from, err := time.ParseInLocation(consultationDateFormat, fromDate, loc)
+	if err != nil {
+		return nil, fmt.Errorf("%w: from must be a date in YYYY-MM-DD format", domainErrors.ErrValidationFailed)
+	}
+	to, err := time.ParseInLocation(consultationDateFormat, toDate, loc)
+	if err != nil {
+		return nil, fmt.Errorf("%w: to must be a date in YYYY-MM-DD format", domainErrors.ErrValidationFailed)
+	}
+	to = to.AddDate(0, 0, 1)
+	if !to.After(from) {
+		return nil, fmt.Errorf("%w: to must not be before from", domainErrors.ErrValidationFailed)
+	}
+	if to.Sub(from) > maxSlotRangeDays*24*time.Hour+time.Hour {
+		return nil, fmt.Errorf("%w: the date range can span at most %d days", domainErrors.ErrValidationFailed, maxSlotRangeDays)
+	}
+
+	response := &dto.ConsultationSlotsResponse{
+		Timezone: loc.String(),
+		Slots:    []*dto.ConsultationSlotResponse{},
+	}
+	if availability == nil {
+		return response, nil
+	}
+	response.SlotMinutes = availability.SlotMinutes
+
+	slots, err := s.openSlots(ctx, availability, from, to, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	for _, slot := range slots {
+		response.Slots = append(response.Slots, &dto.ConsultationSlotResponse{
+			StartAt: slot.StartAt.In(loc),
+			EndAt:   slot.EndAt.In(loc),
+		})
+	}
+	return response, nil
+}
+
+// BookConsultation books an open slot and sends calendar invitations to the visitor and the host
+func (s *ConsultationService) BookConsultation(ctx context.Context, req *dto.BookConsultationRequest) (*dto.ConsultationResponse, error) {
+	availability, err := s.getAvailability(ctx)
+	if err != nil {
+		if errors.Is(err, domainErrors.ErrAvailabilityNotConfigured) {
+			return nil, domainErrors.ErrConsultationSlotUnavailable
+		}
+		return nil, err
+	}
+
+	consultation, err := buildConsultation(req, availability.Timezone)
+	if err != nil {
+		return nil, err
+	}
+
+	// The requested time must be one of the slots currently on offer
+	slots, err := s.openSlots(ctx, availability, consultation.StartAt, consultation.StartAt.Add(time.Second), time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if len(slots) == 0 || !slots[0].StartAt.Equal(consultation.StartAt) {
+		return nil, domainErrors.ErrConsultationSlotUnavailable
+	}
+	consultation.StartAt = slots[0].StartAt.UTC()
+	consultation.EndAt = slots[0].EndAt.UTC()
+
+	if err := s.consultationRepo.Book(ctx, consultation); err != nil {
+		if errors.Is(err, domainErrors.ErrConsultationSlotUnavailable) {
+			return nil, err
+		}
+		s.logger.Error("Failed to book consultation", zap.Error(err))
+		return nil, domainErrors.ErrDatabaseQuery
+	}
+
+	// Send calendar invitations (async)
+	sent := *consultation
+	host := *availability
+	go func() {
+		if err := s.emailService.SendConsultationConfirmation(&sent, &host); err != nil {
+			s.logger.Error("Failed to send consultation confirmation",
+				zap.String("consultation_id", sent.ID),
+				zap.Error(err))
+		}
+	}()
+
+	s.logger.Info("Consultation booked",
+		zap.String("id", consultation.ID),
+		zap.Time("start_at", consultation.StartAt),
+		zap.String("email", consultation.Email))
+
+	return dto.ToConsultationResponse(consultation), nil
+}
+
+// ListConsultations returns the consultations booked in [from, to)
+func (s *ConsultationService) ListConsultations(ctx context.Context, from, to time.Time) (*dto.ListConsultationsResponse, error) {
+	consultations, err := s.consultationRepo.ListBetween(ctx, from, to)
+	if err != nil {
+		s.logger.Error("Failed to list consultations", zap.Error(err))
+		return nil, domainErrors.ErrDatabaseQuery
+	}
+
+	response := &dto.ListConsultationsResponse{
+		Consultations: make([]*dto.ConsultationResponse, len(consultations)),
+	}
+	for i, consultation := range consultations {
+		response.Consultations[i] = dto.ToConsultationResponse(consultation)
+	}
+	return response, nil
+}
+
+// openSlots returns the slots starting in [from, to) that respect the booking
+// notice and horizon and do not overlap an existing booking
+func (s *ConsultationService) openSlots(ctx context.Context, availability *entities.ConsultationAvailability, from, to, now time.Time) ([]entities.TimeSlot, error) {
+	earliest := now.Add(time.Duration(availability.MinNoticeHours) * time.Hour)
+	latest := now.AddDate(0, 0, availability.MaxDaysAhead)
+	if from.Before(earliest) {
+		from = earliest
+	}
+	if to.After(latest) {
+		to = latest
+	}
+	if !to.After(from) {
+		return nil, nil
+	}
+
+	candidates := availability.Slots(from, to)
+	if len(candidates) == 0 {
+		return nil, nil
+	}
+
+	booked, err := s.consultationRepo.ListBetween(ctx, candidates[0].StartAt, candidates[len(candidates)-1].EndAt)
+	if err != nil {
+		s.logger.Error("Failed to list booked consultations", zap.Error(err))
+		return nil, domainErrors.ErrDatabaseQuery
+	}
+
+	open := make([]entities.TimeSlot, 0, len(candidates))
+	for _, slot := range candidates {
+		taken := false
+		for _, consultation := range booked {
+			if slot.Overlaps(consultation.StartAt, consultation.EndAt) {
+				taken = true
+				break
+			}
+		}
+		if !taken {
+			open = append(open, slot)
+		}
+	}
+	return open, nil
+}
+
+func (s *ConsultationService) getAvailability(ctx context.Context) (*entities.ConsultationAvailability, error) {
+	availability, err := s.consultationRepo.GetAvailability(ctx)
+	if err != nil {
+		if errors.Is(err, domainErrors.ErrAvailabilityNotConfigured) {
+			return nil, err
+		}
+		s.logger.Error("Failed to get consultation availability", zap.Error(err))
+		return nil, domainErrors.ErrDatabaseQuery
+	}
+	return availability, nil
+}
+
+// buildAvailability validates an availability update
+func buildAvailability(req *dto.UpdateAvailabilityRequest) (*entities.ConsultationAvailability, error) {
+	if _, err := time.LoadLocation(req.Timezone); err != nil || req.Timezone == "" {
+		return nil, fmt.Errorf("%w: timezone must be an IANA time zone such as Europe/Berlin", domainErrors.ErrValidationFailed)
+	}
+	if req.SlotMinutes < 5 || req.SlotMinutes > 480 {
+		return nil, fmt.Errorf("%w: slot_minutes must be between 5 and 480", domainErrors.ErrValidationFailed)
+	}
+	if req.MinNoticeHours < 0 {
+		return nil, fmt.Errorf("%w: min_notice_hours must not be negative", domainErrors.ErrValidationFailed)
+	}
+	if req.MaxDaysAhead < 1 || req.MaxDaysAhead > 365 {
+		return nil, fmt.Errorf("%w: max_days_ahead must be between 1 and 365", domainErrors.ErrValidationFailed)
+	}
+
+	hostEmail := strings.TrimSpace(req.HostEmail)
+	if hostEmail != "" {
+		address, err := mail.ParseAddress(hostEmail)
+		if err != nil {
+			return nil, fmt.Errorf("%w: host_email is not a valid email address", domainErrors.ErrValidationFailed)
+		}
+		hostEmail = address.Address
+	}
+
+	type period struct {
+		day        time.Weekday
+		start, end time.Duration
+	}
+	windows := make(entities.AvailabilityWindows, len(req.Windows))
+	periods := make([]period, len(req.Windows))
+	for i, w := range req.Windows {
+		window := entities.AvailabilityWindow{Day: strings.ToLower(strings.TrimSpace(w.Day)), Start: w.Start, End: w.End}
+		day, ok := window.Weekday()
+		if !ok {
+			return nil, fmt.Errorf("%w: window %d: day must be a weekday name such as monday", domainErrors.ErrValidationFailed, i+1)
+		}
+		start, end, err := window.Bounds()
+		if err != nil {
+			return nil, fmt.Errorf("%w: window %d: %v", domainErrors.ErrValidationFailed, i+1, err)
+		}
+		if end-start < time.Duration(req.SlotMinutes)*time.Minute {
+			return nil, fmt.Errorf("%w: window %d must end after its start and fit at least one slot", domainErrors.ErrValidationFailed, i+1)
+		}
+		windows[i] = window
+		periods[i] = period{day: day, start: start, end: end}
+	}
+
+	// Overlapping windows would offer the same time twice
+	sort.Slice(periods, func(i, j int) bool {
+		if periods[i].day != periods[j].day {
+			return periods[i].day < periods[j].day
+		}
+		return periods[i].start < periods[j].start
+	})
+	for i := 1; i < len(periods); i++ {
+		if periods[i].day == periods[i-1].day && periods[i].start < periods[i-1].end {
+			return nil, fmt.Errorf("%w: windows on %s overlap", domainErrors.ErrValidationFailed, strings.ToLower(periods[i].day.String()))
+		}
+	}
+
+	return &entities.ConsultationAvailability{
+		Timezone:       req.Timezone,
+		SlotMinutes:    req.SlotMinutes,
+		MinNoticeHours: req.MinNoticeHours,
+		MaxDaysAhead:   req.MaxDaysAhead,
+		Windows:        windows,
+		HostName:       strings.TrimSpace(req.HostName),
+		HostEmail:      hostEmail,
+	}, nil
+}
+
+// buildConsultation validates a booking request. The visitor's time zone
+// defaults to the host's.
+func buildConsultation(req *dto.BookConsultationRequest, defaultTimezone string) (*entities.Consultation, error) {
+	if req.StartAt.IsZero() {
+		return nil, fmt.Errorf("%w: start_at is required", domainErrors.ErrValidationFailed)
+	}
+	name := strings.TrimSpace(req.Name)
+	if n := utf8.RuneCountInString(name); n < 2 || n > 100 {
+		return nil, fmt.Errorf("%w: name must be between 2 and 100 characters", domainErrors.ErrValidationFailed)
+	}
+	if strings.ContainsAny(name, "\r\n") {
+		return nil, fmt.Errorf("%w: name must be a single line", domainErrors.ErrValidationFailed)
+	}
+	address, err := mail.ParseAddress(strings.TrimSpace(req.Email))
+	if err != nil {
+		return nil, fmt.Errorf("%w: email is not a valid email address", domainErrors.ErrValidationFailed)
+	}
+	topic := strings.TrimSpace(req.Topic)
+	if utf8.RuneCountInString(topic) > maxConsultationTopicLength {
+		return nil, fmt.Errorf("%w: topic must be at most %d characters", domainErrors.ErrValidationFailed, maxConsultationTopicLength)
+	}
+
+	timezone := req.Timezone
+	if timezone == "" {
+		timezone = defaultTimezone
+	}
+	loc, err := loadVisitorLocation(timezone)
+	if err != nil {
+		return nil, err
+	}
+
+	return &entities.Consultation{
+		Name:     name,
+		Email:    address.Address,
+		Topic:    topic,
+		StartAt:  req.StartAt,
+		Timezone: loc.String(),
+	}, nil
+}
+
+func loadVisitorLocation(timezone string) (*time.Location, error) {
+	if timezone == "" {
+		return time.UTC, nil
+	}
+	loc, err := time.LoadLocation(timezone)
+	if err != nil || strings.EqualFold(timezone, "local") {
+		return nil, fmt.Errorf("%w: timezone must be an IANA time zone such as Europe/Berlin", domainErrors.ErrValidationFailed)
+	}
+	return loc, nil
+}
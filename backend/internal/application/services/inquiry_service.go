@@ -0,0 +1,228 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/mail"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"go.uber.org/zap"
+
+	"super2025-backend/internal/application/dto"
+	"super2025-backend/internal/domain/entities"
+	domainErrors "super2025-backend/internal/domain/errors"
+	"super2025-backend/internal/domain/repositories"
+)
+
+const (
+	// maxInquiriesPerHour bounds the inquiries accepted from one IP address
+	maxInquiriesPerHour = 5
+	// maxInquiryLinks is the most links a genuine inquiry message is expected to contain
+	maxInquiryLinks = 3
+	// maxInquiryMessageLength bounds the inquiry message
+	maxInquiryMessageLength = 5000
+)
+
+// InquiryService implements business logic for contact form inquiries
+type InquiryService struct {
+	inquiryRepo  repositories.InquiryRepository
+	emailService EmailService
+	logger       *zap.Logger
+}
+
+// NewInquiryService creates a new inquiry service
+func NewInquiryService(inquiryRepo repositories.InquiryRepository, emailService EmailService, logger *zap.Logger) *InquiryService {
+	return &InquiryService{
+		inquiryRepo:  inquiryRepo,
+		emailService: emailService,
+		logger:       logger,
+	}
+}
+
+// CreateInquiry stores a contact form inquiry and notifies sales. Submissions that
+// look like spam are dropped without an error so bots get no signal.
+func (s *InquiryService) CreateInquiry(ctx context.Context, req *dto.CreateInquiryRequest, metadata map[string]string) error {
+	inquiry, err := buildInquiry(req)
+	if err != nil {
+		return err
+	}
+	inquiry.IPAddress = metadata["ip_address"]
+	inquiry.UserAgent = metadata["user_agent"]
+
+	if reason := spamReason(req); reason != "" {
+		s.logger.Warn("Dropped spam inquiry",
+			zap.String("reason", reason),
+			zap.String("ip_address", inquiry.IPAddress))
+		return nil
+	}
+
+	if inquiry.IPAddress != "" {
+		recent, err := s.inquiryRepo.CountByIPSince(ctx, inquiry.IPAddress, time.Now().Add(-time.Hour))
+		if err != nil {
+			s.logger.Error("Failed to count recent inquiries", zap.Error(err))
+			return domainErrors.ErrDatabaseQuery
+		}
+		if recent >= maxInquiriesPerHour {
+			return domainErrors.ErrTooManyInquiries
+		}
+	}
+
+	if err := s.inquiryRepo.Create(ctx, inquiry); err != nil {
+		s.logger.Error("Failed to create inquiry", zap.Error(err))
+		return domainErrors.ErrDatabaseQuery
+	}
+
+	// Send notification email to sales (async)
+	sent := *inquiry
+	go func() {
+		if err := s.emailService.SendInquiryNotification(&sent); err != nil {
+			s.logger.Error("Failed to send inquiry notification",
+				zap.String("inquiry_id", sent.ID),
+				zap.Error(err))
+		}
+	}()
+
+	s.logger.Info("Inquiry created",
+		zap.String("id", inquiry.ID),
+		zap.String("email", inquiry.Email))
+
+	return nil
+}
+
+// ListInquiries retrieves inquiries, newest first, optionally filtered by status
+func (s *InquiryService) ListInquiries(ctx context.Context, status entities.InquiryStatus, page, pageSize int) (*dto.ListInquiriesResponse, error) {
+	if status != "" && !status.IsValid() {
+		return nil, fmt.Errorf("%w: status must be new, contacted or closed", domainErrors.ErrValidationFailed)
+	}
+
+	filter := repositories.InquiryFilter{
+		Status:   status,
+		Page:     page,
+		PageSize: pageSize,
+	}
+	inquiries, total, err := s.inquiryRepo.List(ctx, filter)
+	if err != nil {
+		s.logger.Error("Failed to list inquiries", zap.Error(err))
+		return nil, domainErrors.ErrDatabaseQuery
+	}
+
+	response := &dto.ListInquiriesResponse{
+		Inquiries:  make([]*dto.InquiryResponse, len(inquiries)),
+		Pagination: dto.CalculatePagination(page, pageSize, total),
+	}
+	for i, inquiry := range inquiries {
+		response.Inquiries[i] = dto.ToInquiryResponse(inquiry)
+	}
+	return response, nil
+}
+
+// GetInquiry retrieves an inquiry by ID
+func (s *InquiryService) GetInquiry(ctx context.Context, id string) (*dto.InquiryResponse, error) {
+	inquiry, err := s.getInquiry(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return dto.ToInquiryResponse(inquiry), nil
+}
+
+// UpdateInquiryStatus records follow-up on an inquiry
+func (s *InquiryService) UpdateInquiryStatus(ctx context.Context, id, actor string, req *dto.UpdateInquiryStatusRequest) (*dto.InquiryResponse, error) {
+	if !req.Status.IsValid() {
+		return nil, fmt.Errorf("%w: status must be new, contacted or closed", domainErrors.ErrValidationFailed)
+	}
+
+	if err := s.inquiryRepo.UpdateStatus(ctx, id, req.Status, actor); err != nil {
+		if errors.Is(err, domainErrors.ErrInquiryNotFound) {
+			return nil, err
+		}
+		s.logger.Error("Failed to update inquiry status", zap.String("id", id), zap.Error(err))
+		return nil, domainErrors.ErrDatabaseQuery
+	}
+
+	s.logger.Info("Inquiry status updated",
+		zap.String("id", id),
+		zap.String("status", string(req.Status)),
+		zap.String("actor", actor))
+
+	return s.GetInquiry(ctx, id)
+}
+
+func (s *InquiryService) getInquiry(ctx context.Context, id string) (*entities.Inquiry, error) {
+	inquiry, err := s.inquiryRepo.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, domainErrors.ErrInquiryNotFound) {
+			return nil, err
+		}
+		s.logger.Error("Failed to get inquiry", zap.String("id", id), zap.Error(err))
+		return nil, domainErrors.ErrDatabaseQuery
+	}
+	return inquiry, nil
+}
+
+// buildInquiry validates a contact form submission and returns the inquiry to store
+func buildInquiry(req *dto.CreateInquiryRequest) (*entities.Inquiry, error) {
+	name := strings.TrimSpace(req.Name)
+	if n := utf8.RuneCountInString(name); n < 2 || n > 100 {
+		return nil, fmt.Errorf("%w: name must be between 2 and 100 characters", domainErrors.ErrValidationFailed)
+	}
+
+	address, err := mail.ParseAddress(strings.TrimSpace(req.Email))
+	if err != nil {
+		return nil, fmt.Errorf("%w: email is not a valid email address", domainErrors.ErrValidationFailed)
+	}
+
+	message := strings.TrimSpace(req.Message)
+	if n := utf8.RuneCountInString(message); n < 10 || n > maxInquiryMessageLength {
+		return nil, fmt.Errorf("%w: message must be between 10 and %d characters", domainErrors.ErrValidationFailed, maxInquiryMessageLength)
+	}
+
+	fields := []struct {
+		name  string
+		value string
+		max   int
+	}{
+		{"name", name, 100},
+		{"company", req.Company, 200},
+		{"role", req.Role, 100},
+		{"projectType", req.ProjectType, 50},
+		{"budget", req.Budget, 50},
+		{"timeline", req.Timeline, 50},
+	}
+	for _, field := range fields {
+		if utf8.RuneCountInString(field.value) > field.max {
+			return nil, fmt.Errorf("%w: %s must be at most %d characters", domainErrors.ErrValidationFailed, field.name, field.max)
+		}
+		// Single-line fields end up in email subjects and headers
+		if strings.IndexFunc(field.value, unicode.IsControl) >= 0 {
+			return nil, fmt.Errorf("%w: %s must not contain control characters", domainErrors.ErrValidationFailed, field.name)
+		}
+	}
+
+	return &entities.Inquiry{
+		Name:        name,
+		Email:       address.Address,
+		Company:     strings.TrimSpace(req.Company),
+		Role:        strings.TrimSpace(req.Role),
+		ProjectType: strings.TrimSpace(req.ProjectType),
+		Budget:      strings.TrimSpace(req.Budget),
+		Timeline:    strings.TrimSpace(req.Timeline),
+		Message:     message,
+		Status:      entities.InquiryStatusNew,
+	}, nil
+}
+
+// spamReason returns why a submission looks automated, or "" if it looks genuine
+func spamReason(req *dto.CreateInquiryRequest) string {
+	if strings.TrimSpace(req.Website) != "" {
+		return "honeypot filled"
+	}
+	message := strings.ToLower(req.Message)
+	if strings.Count(message, "http://")+strings.Count(message, "https://") > maxInquiryLinks {
+		return "too many links"
+	}
+	return ""
+}
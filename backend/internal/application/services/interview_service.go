@@ -0,0 +1,333 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/mail"
+	"net/url"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"super2025-backend/internal/application/dto"
+	"super2025-backend/internal/domain/entities"
+	domainErrors "super2025-backend/internal/domain/errors"
+	"super2025-backend/internal/domain/repositories"
+)
+
+// maxInterviewDuration bounds the length of a single interview
+const maxInterviewDuration = 8 * time.Hour
+
+// InterviewService implements business logic for interview scheduling
+type InterviewService struct {
+	interviewRepo      repositories.InterviewRepository
+	applicationRepo    repositories.ApplicationRepository
+	positionRepo       repositories.PositionRepository
+	applicationService *ApplicationService
+	emailService       EmailService
+	logger             *zap.Logger
+}
+
+// NewInterviewService creates a new interview service
+func NewInterviewService(
+	interviewRepo repositories.InterviewRepository,
+	applicationRepo repositories.ApplicationRepository,
+	positionRepo repositories.PositionRepository,
+	applicationService *ApplicationService,
+	emailService EmailService,
+	logger *zap.Logger,
+) *InterviewService {
+	return &InterviewService{
+		interviewRepo:      interviewRepo,
+		applicationRepo:    applicationRepo,
+		positionRepo:       positionRepo,
+		applicationService: applicationService,
+		emailService:       emailService,
+		logger:             logger,
+	}
+}
+
+// ScheduleInterview schedules an interview for an application and sends calendar
+// invites to the candidate and interviewers. Applications that can still move to
+// the interview stage are moved there.
+func (s *InterviewService) ScheduleInterview(ctx context.Context, applicationID, actor string, req *dto.ScheduleInterviewRequest) (*dto.InterviewResponse, error) {
+	application, err := s.getApplication(ctx, applicationID)
+	if err != nil {
+		return nil, err
+	}
+	if application.Status.IsTerminal() {
+		return nil, fmt.Errorf("%w: cannot schedule an interview for a %s application", domainErrors.ErrValidationFailed, application.Status)
+	}
+
+	interview := &entities.Interview{
+		ApplicationID: applicationID,
+		Status:        entities.InterviewScheduled,
+		CreatedBy:     actor,
+	}
+	if err := applyInterviewRequest(interview, req); err != nil {
+		return nil, err
+	}
+
+	if err := s.interviewRepo.Create(ctx, interview); err != nil {
+		if err == domainErrors.ErrInterviewerUnavailable {
+			return nil, err
+		}
+		s.logger.Error("Failed to create interview", zap.String("application_id", applicationID), zap.Error(err))
+		return nil, domainErrors.ErrDatabaseQuery
+	}
+
+	s.interviewScheduled(ctx, application, interview, actor)
+
+	return dto.ToInterviewResponse(interview), nil
+}
+
+// interviewScheduled moves the application to the interview stage when it can
+// still get there and sends the calendar invites for a newly created interview
+func (s *InterviewService) interviewScheduled(ctx context.Context, application *entities.Application, interview *entities.Interview, actor string) {
+	if application.Status.CanTransitionTo(entities.StatusInterview) {
+		change := StatusChange{
+			Status: entities.StatusInterview,
+			Actor:  actor,
+			Notes:  "Interview scheduled",
+		}
+		if err := s.applicationService.ChangeStatus(ctx, application, change); err != nil {
+			// The interview stands even if the stage could not be advanced
+			s.logger.Error("Failed to move application to interview",
+				zap.String("application_id", application.ID), zap.Error(err))
+		}
+	}
+
+	s.logger.Info("Interview scheduled",
+		zap.String("id", interview.ID),
+		zap.String("application_id", application.ID),
+		zap.Time("start_at", interview.StartAt),
+		zap.String("actor", actor))
+
+	s.sendInvitation(ctx, application, interview)
+}
+
+// ListInterviews retrieves the interviews of an application
+func (s *InterviewService) ListInterviews(ctx context.Context, applicationID string) (*dto.ListInterviewsResponse, error) {
+	if _, err := s.getApplication(ctx, applicationID); err != nil {
+		return nil, err
+	}
+
+	interviews, err := s.interviewRepo.ListByApplication(ctx, applicationID)
+	if err != nil {
+		s.logger.Error("Failed to list interviews", zap.String("application_id", applicationID), zap.Error(err))
+		return nil, domainErrors.ErrDatabaseQuery
+	}
+
+	response := &dto.ListInterviewsResponse{Interviews: make([]*dto.InterviewResponse, len(interviews))}
+	for i, interview := range interviews {
+		response.Interviews[i] = dto.ToInterviewResponse(interview)
+	}
+	return response, nil
+}
+
+// GetInterview retrieves an interview by ID
+func (s *InterviewService) GetInterview(ctx context.Context, id string) (*dto.InterviewResponse, error) {
+	interview, err := s.getInterview(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return dto.ToInterviewResponse(interview), nil
+}
+
+// UpdateInterview reschedules or edits an interview and sends an updated invite
+// that replaces the previous one in the attendees' calendars
+func (s *InterviewService) UpdateInterview(ctx context.Context, id, actor string, req *dto.UpdateInterviewRequest) (*dto.InterviewResponse, error) {
+	interview, err := s.getInterview(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if interview.IsCancelled() {
+		return nil, domainErrors.ErrInterviewCancelled
+	}
+
+	application, err := s.getApplication(ctx, interview.ApplicationID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := applyInterviewRequest(interview, req); err != nil {
+		return nil, err
+	}
+	interview.Reschedule()
+
+	if err := s.interviewRepo.Update(ctx, interview); err != nil {
+		if err == domainErrors.ErrInterviewerUnavailable {
+			return nil, err
+		}
+		s.logger.Error("Failed to update interview", zap.String("id", id), zap.Error(err))
+		return nil, domainErrors.ErrDatabaseQuery
+	}
+
+	s.logger.Info("Interview updated",
+		zap.String("id", id),
+		zap.Int("sequence", interview.Sequence),
+		zap.String("actor", actor))
+
+	s.sendInvitation(ctx, application, interview)
+
+	return dto.ToInterviewResponse(interview), nil
+}
+
+// CancelInterview cancels an interview and sends a calendar cancellation.
+// Cancelling an already cancelled interview is a no-op.
+func (s *InterviewService) CancelInterview(ctx context.Context, id, actor string) (*dto.InterviewResponse, error) {
+	interview, err := s.getInterview(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if interview.IsCancelled() {
+		return dto.ToInterviewResponse(interview), nil
+	}
+
+	application, err := s.getApplication(ctx, interview.ApplicationID)
+	if err != nil {
+		return nil, err
+	}
+
+	interview.Cancel(actor)
+	if err := s.interviewRepo.Update(ctx, interview); err != nil {
+		s.logger.Error("Failed to cancel interview", zap.String("id", id), zap.Error(err))
+		return nil, domainErrors.ErrDatabaseQuery
+	}
+
+	s.logger.Info("Interview cancelled", zap.String("id", id), zap.String("actor", actor))
+
+	position := positionTitle(ctx, s.positionRepo, application)
+	invite := *interview
+	go func() {
+		if err := s.emailService.SendInterviewCancellation(&invite, application.Email, application.Name, position); err != nil {
+			s.logger.Error("Failed to send interview cancellation",
+				zap.String("interview_id", invite.ID),
+				zap.Error(err))
+		}
+	}()
+
+	return dto.ToInterviewResponse(interview), nil
+}
+
+// sendInvitation sends the calendar invite for an interview (async)
+func (s *InterviewService) sendInvitation(ctx context.Context, application *entities.Application, interview *entities.Interview) {
+	position := positionTitle(ctx, s.positionRepo, application)
+	invite := *interview
+	go func() {
+		if err := s.emailService.SendInterviewInvitation(&invite, application.Email, application.Name, position); err != nil {
+			s.logger.Error("Failed to send interview invitation",
+				zap.String("interview_id", invite.ID),
+				zap.Error(err))
+		}
+	}()
+}
+
+// positionTitle returns the title of the application's position for emails,
+// falling back to its ID
+func positionTitle(ctx context.Context, positionRepo repositories.PositionRepository, application *entities.Application) string {
+	position, err := positionRepo.GetByID(ctx, application.PositionID)
+	if err != nil {
+		return application.PositionID
+	}
+	return position.Title
+}
+
+func (s *InterviewService) getApplication(ctx context.Context, id string) (*entities.Application, error) {
+	application, err := s.applicationRepo.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, domainErrors.ErrApplicationNotFound) {
+			return nil, domainErrors.ErrApplicationNotFound
+		}
+		s.logger.Error("Failed to get application", zap.String("id", id), zap.Error(err))
+		return nil, domainErrors.ErrDatabaseQuery
+	}
+	return application, nil
+}
+
+func (s *InterviewService) getInterview(ctx context.Context, id string) (*entities.Interview, error) {
+	interview, err := s.interviewRepo.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, domainErrors.ErrInterviewNotFound) {
+			return nil, domainErrors.ErrInterviewNotFound
+		}
+		s.logger.Error("Failed to get interview", zap.String("id", id), zap.Error(err))
+		return nil, domainErrors.ErrDatabaseQuery
+	}
+	return interview, nil
+}
+
+// applyInterviewRequest validates the request and copies it onto the interview
+func applyInterviewRequest(interview *entities.Interview, req *dto.ScheduleInterviewRequest) error {
+	if err := validateMeetingDetails(req.Timezone, req.Location, req.VideoLink); err != nil {
+		return err
+	}
+	if err := validateInterviewTime(req.StartAt, req.EndAt); err != nil {
+		return err
+	}
+	interviewers, err := normalizeInterviewers(req.Interviewers)
+	if err != nil {
+		return err
+	}
+
+	interview.Interviewers = interviewers
+	interview.StartAt = req.StartAt.UTC()
+	interview.EndAt = req.EndAt.UTC()
+	interview.Timezone = req.Timezone
+	interview.Location = strings.TrimSpace(req.Location)
+	interview.VideoLink = strings.TrimSpace(req.VideoLink)
+	interview.Notes = strings.TrimSpace(req.Notes)
+	return nil
+}
+
+// validateMeetingDetails checks the time zone and where an interview takes place
+func validateMeetingDetails(timezone, location, videoLink string) error {
+	if _, err := time.LoadLocation(timezone); err != nil || timezone == "" {
+		return fmt.Errorf("%w: timezone must be an IANA time zone such as Europe/Berlin", domainErrors.ErrValidationFailed)
+	}
+	if strings.TrimSpace(location) == "" && strings.TrimSpace(videoLink) == "" {
+		return fmt.Errorf("%w: a location or video_link is required", domainErrors.ErrValidationFailed)
+	}
+	if videoLink != "" {
+		if u, err := url.Parse(strings.TrimSpace(videoLink)); err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+			return fmt.Errorf("%w: video_link must be an http(s) URL", domainErrors.ErrValidationFailed)
+		}
+	}
+	return nil
+}
+
+// validateInterviewTime checks that an interview has a sensible start and end
+func validateInterviewTime(startAt, endAt time.Time) error {
+	switch {
+	case startAt.IsZero() || endAt.IsZero():
+		return fmt.Errorf("%w: start_at and end_at are required", domainErrors.ErrValidationFailed)
+	case !endAt.After(startAt):
+		return fmt.Errorf("%w: end_at must be after start_at", domainErrors.ErrValidationFailed)
+	case endAt.Sub(startAt) > maxInterviewDuration:
+		return fmt.Errorf("%w: an interview cannot last longer than %s", domainErrors.ErrValidationFailed, maxInterviewDuration)
+	}
+	return nil
+}
+
+// normalizeInterviewers validates interviewer emails and returns them lower-cased and deduplicated
+func normalizeInterviewers(emails []string) (entities.StringList, error) {
+	interviewers := make(entities.StringList, 0, len(emails))
+	seen := make(map[string]bool, len(emails))
+	for _, interviewer := range emails {
+		address, err := mail.ParseAddress(strings.TrimSpace(interviewer))
+		if err != nil {
+			return nil, fmt.Errorf("%w: interviewer %q is not a valid email address", domainErrors.ErrValidationFailed, interviewer)
+		}
+		email := strings.ToLower(address.Address)
+		if !seen[email] {
+			seen[email] = true
+			interviewers = append(interviewers, email)
+		}
+	}
+	if len(interviewers) == 0 {
+		return nil, fmt.Errorf("%w: at least one interviewer is required", domainErrors.ErrValidationFailed)
+	}
+	return interviewers, nil
+}
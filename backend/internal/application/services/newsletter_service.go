@@ -0,0 +1,306 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"net/mail"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"go.uber.org/zap"
+
+	"super2025-backend/internal/application/dto"
+	"super2025-backend/internal/domain/entities"
+	domainErrors "super2025-backend/internal/domain/errors"
+	"super2025-backend/internal/domain/repositories"
+)
+
+const (
+	// confirmLinkTTL is how long the link in a subscription confirmation email works
+	confirmLinkTTL = 7 * 24 * time.Hour
+	// confirmLinkPurpose scopes signed subscription confirmation links
+	confirmLinkPurpose = "newsletter-confirm"
+	// unsubscribeLinkPurpose scopes signed unsubscribe links, which never expire
+	unsubscribeLinkPurpose = "newsletter-unsubscribe"
+	// confirmationResendInterval is the minimum time between confirmation emails to one address
+	confirmationResendInterval = 10 * time.Minute
+	// maxSubscriptionsPerHour bounds the confirmation emails requested from one IP address
+	maxSubscriptionsPerHour = 5
+)
+
+// NewsletterService implements business logic for newsletter subscriptions with double opt-in
+type NewsletterService struct {
+	subscriberRepo repositories.SubscriberRepository
+	emailService   EmailService
+	siteURL        string
+	apiURL         string
+	linkSecret     []byte
+	logger         *zap.Logger
+}
+
+// NewNewsletterService creates a new newsletter service. Links in emails point to
+// pages under siteURL; one-click unsubscribe links point to the API under apiURL.
+func NewNewsletterService(
+	subscriberRepo repositories.SubscriberRepository,
+	emailService EmailService,
+	siteURL, apiURL string,
+	linkSecret []byte,
+	logger *zap.Logger,
+) *NewsletterService {
+	return &NewsletterService{
+		subscriberRepo: subscriberRepo,
+		emailService:   emailService,
+		siteURL:        strings.TrimRight(siteURL, "/"),
+		apiURL:         strings.TrimRight(apiURL, "/"),
+		linkSecret:     linkSecret,
+		logger:         logger,
+	}
+}
+
+// Subscribe starts a subscription and emails a confirmation link. The outcome is the
+// same whether or not the address is already subscribed, so the endpoint does not
+// reveal who is on the list; submissions that look like spam are dropped silently.
+func (s *NewsletterService) Subscribe(ctx context.Context, req *dto.SubscribeRequest, metadata map[string]string) error {
+	email, name, topics, err := validateSubscription(req)
+	if err != nil {
+		return err
+	}
+	ipAddress := metadata["ip_address"]
+
+	if req.Website != "" {
+		s.logger.Warn("Dropped spam subscription", zap.String("ip_address", ipAddress))
+		return nil
+	}
+
+	if ipAddress != "" {
+		recent, err := s.subscriberRepo.CountConfirmationsByIPSince(ctx, ipAddress, time.Now().Add(-time.Hour))
+		if err != nil {
+			s.logger.Error("Failed to count recent subscriptions", zap.Error(err))
+			return domainErrors.ErrDatabaseQuery
+		}
+		if recent >= maxSubscriptionsPerHour {
+			return domainErrors.ErrTooManySubscriptions
+		}
+	}
+
+	now := time.Now()
+	subscriber, err := s.subscriberRepo.GetByEmail(ctx, email)
+	switch {
+	case err == domainErrors.ErrSubscriberNotFound:
+		subscriber = &entities.Subscriber{
+			Email:              email,
+			Name:               name,
+			Topics:             topics,
+			Status:             entities.SubscriberStatusPending,
+			Source:             strings.TrimSpace(req.Source),
+			IPAddress:          ipAddress,
+			ConfirmationSentAt: now,
+		}
+		if err := s.subscriberRepo.Create(ctx, subscriber); err != nil {
+			// A concurrent request may have created the subscriber first
+			if _, getErr := s.subscriberRepo.GetByEmail(ctx, email); getErr == nil {
+				return nil
+			}
+			s.logger.Error("Failed to create subscriber", zap.Error(err))
+			return domainErrors.ErrDatabaseQuery
+		}
+
+	case err != nil:
+		s.logger.Error("Failed to get subscriber", zap.Error(err))
+		return domainErrors.ErrDatabaseQuery
+
+	case subscriber.Status == entities.SubscriberStatusConfirmed:
+		// Already subscribed; changing topics would let anyone edit someone else's subscription
+		return nil
+
+	case subscriber.Status == entities.SubscriberStatusPending && now.Sub(subscriber.ConfirmationSentAt) < confirmationResendInterval:
+		return nil
+
+	default:
+		subscriber.Name = name
+		subscriber.Topics = topics
+		subscriber.Status = entities.SubscriberStatusPending
+		subscriber.IPAddress = ipAddress
+		subscriber.ConfirmationSentAt = now
+		if err := s.subscriberRepo.Update(ctx, subscriber); err != nil {
+			s.logger.Error("Failed to update subscriber", zap.String("id", subscriber.ID), zap.Error(err))
+			return domainErrors.ErrDatabaseQuery
+		}
+	}
+
+	// Send confirmation email (async)
+	confirmLink := s.siteURL + "/confirm/" + signExpiringLink(s.linkSecret, confirmLinkPurpose, subscriber.ID, now.Add(confirmLinkTTL))
+	sent := *subscriber
+	go func() {
+		if err := s.emailService.SendSubscriptionConfirmation(sent.Email, sent.Name, confirmLink); err != nil {
+			s.logger.Error("Failed to send subscription confirmation",
+				zap.String("subscriber_id", sent.ID),
+				zap.Error(err))
+		}
+	}()
+
+	s.logger.Info("Subscription requested", zap.String("id", subscriber.ID))
+	return nil
+}
+
+// Confirm completes the double opt-in for the subscriber a confirmation link was sent to
+func (s *NewsletterService) Confirm(ctx context.Context, token string) (*dto.SubscriptionResponse, error) {
+	id, err := verifyExpiringLink(s.linkSecret, confirmLinkPurpose, token, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	subscriber, err := s.getSubscriber(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	switch subscriber.Status {
+	case entities.SubscriberStatusConfirmed:
+		return dto.ToSubscriptionResponse(subscriber), nil
+	case entities.SubscriberStatusUnsubscribed:
+		// The link predates an unsubscribe; subscribing again needs a new confirmation
+		return nil, domainErrors.ErrInvalidLink
+	}
+
+	now := time.Now()
+	subscriber.Status = entities.SubscriberStatusConfirmed
+	subscriber.ConfirmedAt = &now
+	subscriber.UnsubscribedAt = nil
+	if err := s.subscriberRepo.Update(ctx, subscriber); err != nil {
+		s.logger.Error("Failed to confirm subscriber", zap.String("id", id), zap.Error(err))
+		return nil, domainErrors.ErrDatabaseQuery
+	}
+
+	// Send welcome email (async)
+	pageLink, oneClickURL := s.unsubscribeLinks(subscriber.ID)
+	sent := *subscriber
+	go func() {
+		if err := s.emailService.SendSubscriptionWelcome(sent.Email, sent.Name, pageLink, oneClickURL); err != nil {
+			s.logger.Error("Failed to send subscription welcome",
+				zap.String("subscriber_id", sent.ID),
+				zap.Error(err))
+		}
+	}()
+
+	s.logger.Info("Subscription confirmed", zap.String("id", id))
+	return dto.ToSubscriptionResponse(subscriber), nil
+}
+
+// GetSubscription shows the subscription an unsubscribe link belongs to without changing it
+func (s *NewsletterService) GetSubscription(ctx context.Context, token string) (*dto.SubscriptionResponse, error) {
+	subscriber, err := s.openUnsubscribeLink(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	return dto.ToSubscriptionResponse(subscriber), nil
+}
+
+// Unsubscribe ends the subscription an unsubscribe link belongs to. Repeating it is harmless.
+func (s *NewsletterService) Unsubscribe(ctx context.Context, token string) (*dto.SubscriptionResponse, error) {
+	subscriber, err := s.openUnsubscribeLink(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	if subscriber.Status == entities.SubscriberStatusUnsubscribed {
+		return dto.ToSubscriptionResponse(subscriber), nil
+	}
+
+	now := time.Now()
+	subscriber.Status = entities.SubscriberStatusUnsubscribed
+	subscriber.UnsubscribedAt = &now
+	if err := s.subscriberRepo.Update(ctx, subscriber); err != nil {
+		s.logger.Error("Failed to unsubscribe subscriber", zap.String("id", subscriber.ID), zap.Error(err))
+		return nil, domainErrors.ErrDatabaseQuery
+	}
+
+	s.logger.Info("Unsubscribed", zap.String("id", subscriber.ID))
+	return dto.ToSubscriptionResponse(subscriber), nil
+}
+
+// ExportConfirmed lists confirmed subscribers, optionally only those who chose topic,
+// with the one-click unsubscribe URL to put in each newsletter's List-Unsubscribe header
+func (s *NewsletterService) ExportConfirmed(ctx context.Context, topic string) ([]*dto.SubscriberExportRow, error) {
+	if topic != "" && !entities.StringList(entities.NewsletterTopics).Contains(topic) {
+		return nil, fmt.Errorf("%w: topic must be one of %s", domainErrors.ErrValidationFailed, strings.Join(entities.NewsletterTopics, ", "))
+	}
+
+	subscribers, err := s.subscriberRepo.ListConfirmed(ctx, topic)
+	if err != nil {
+		s.logger.Error("Failed to list confirmed subscribers", zap.Error(err))
+		return nil, domainErrors.ErrDatabaseQuery
+	}
+
+	rows := make([]*dto.SubscriberExportRow, len(subscribers))
+	for i, subscriber := range subscribers {
+		_, oneClickURL := s.unsubscribeLinks(subscriber.ID)
+		rows[i] = &dto.SubscriberExportRow{
+			Email:          subscriber.Email,
+			Name:           subscriber.Name,
+			Topics:         subscriber.Topics,
+			UnsubscribeURL: oneClickURL,
+		}
+		if subscriber.ConfirmedAt != nil {
+			rows[i].ConfirmedAt = *subscriber.ConfirmedAt
+		}
+	}
+	return rows, nil
+}
+
+// unsubscribeLinks returns the unsubscribe page for people and the one-click URL for mail clients
+func (s *NewsletterService) unsubscribeLinks(subscriberID string) (pageLink, oneClickURL string) {
+	token := signLink(s.linkSecret, unsubscribeLinkPurpose, subscriberID)
+	return s.siteURL + "/unsubscribe/" + token, s.apiURL + "/unsubscribe/" + token
+}
+
+func (s *NewsletterService) openUnsubscribeLink(ctx context.Context, token string) (*entities.Subscriber, error) {
+	id, ok := verifyLink(s.linkSecret, unsubscribeLinkPurpose, token)
+	if !ok {
+		return nil, domainErrors.ErrInvalidLink
+	}
+	return s.getSubscriber(ctx, id)
+}
+
+func (s *NewsletterService) getSubscriber(ctx context.Context, id string) (*entities.Subscriber, error) {
+	subscriber, err := s.subscriberRepo.GetByID(ctx, id)
+	if err != nil {
+		if err == domainErrors.ErrSubscriberNotFound {
+			return nil, domainErrors.ErrInvalidLink
+		}
+		s.logger.Error("Failed to get subscriber", zap.String("id", id), zap.Error(err))
+		return nil, domainErrors.ErrDatabaseQuery
+	}
+	return subscriber, nil
+}
+
+// validateSubscription normalizes a subscribe request. Without topics, all topics are chosen.
+func validateSubscription(req *dto.SubscribeRequest) (email, name string, topics entities.StringList, err error) {
+	address, parseErr := mail.ParseAddress(strings.TrimSpace(req.Email))
+	if parseErr != nil || len(address.Address) > 255 {
+		return "", "", nil, domainErrors.ErrInvalidEmail
+	}
+	email = strings.ToLower(address.Address)
+
+	name = strings.TrimSpace(req.Name)
+	if utf8.RuneCountInString(name) > 100 {
+		return "", "", nil, fmt.Errorf("%w: name must be at most 100 characters", domainErrors.ErrValidationFailed)
+	}
+	if utf8.RuneCountInString(req.Source) > 50 {
+		return "", "", nil, fmt.Errorf("%w: source must be at most 50 characters", domainErrors.ErrValidationFailed)
+	}
+
+	topics = entities.StringList{}
+	for _, topic := range req.Topics {
+		topic = strings.ToLower(strings.TrimSpace(topic))
+		if !entities.StringList(entities.NewsletterTopics).Contains(topic) {
+			return "", "", nil, fmt.Errorf("%w: topics must be among %s", domainErrors.ErrValidationFailed, strings.Join(entities.NewsletterTopics, ", "))
+		}
+		if !topics.Contains(topic) {
+			topics = append(topics, topic)
+		}
+	}
+	if len(topics) == 0 {
+		topics = append(topics, entities.NewsletterTopics...)
+	}
+	return email, name, topics, nil
+}
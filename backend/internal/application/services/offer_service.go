@@ -0,0 +1,429 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"super2025-backend/internal/application/dto"
+	"super2025-backend/internal/domain/entities"
+	domainErrors "super2025-backend/internal/domain/errors"
+	"super2025-backend/internal/domain/repositories"
+)
+
+const (
+	// defaultOfferTTL is how long a candidate has to answer unless an expiry is set
+	defaultOfferTTL = 7 * 24 * time.Hour
+	// offerLinkPurpose scopes signed offer links
+	offerLinkPurpose = "offer"
+	// maxOfferTermsLength bounds the terms text of an offer
+	maxOfferTermsLength = 20000
+)
+
+// OfferService implements business logic for job offers
+type OfferService struct {
+	offerRepo          repositories.OfferRepository
+	applicationRepo    repositories.ApplicationRepository
+	positionRepo       repositories.PositionRepository
+	applicationService *ApplicationService
+	emailService       EmailService
+	linkBaseURL        string
+	linkSecret         []byte
+	logger             *zap.Logger
+}
+
+// NewOfferService creates a new offer service. Links sent to candidates are
+// linkBaseURL followed by a token signed with linkSecret.
+func NewOfferService(
+	offerRepo repositories.OfferRepository,
+	applicationRepo repositories.ApplicationRepository,
+	positionRepo repositories.PositionRepository,
+	applicationService *ApplicationService,
+	emailService EmailService,
+	linkBaseURL string,
+	linkSecret []byte,
+	logger *zap.Logger,
+) *OfferService {
+	return &OfferService{
+		offerRepo:          offerRepo,
+		applicationRepo:    applicationRepo,
+		positionRepo:       positionRepo,
+		applicationService: applicationService,
+		emailService:       emailService,
+		linkBaseURL:        strings.TrimRight(linkBaseURL, "/") + "/",
+		linkSecret:         linkSecret,
+		logger:             logger,
+	}
+}
+
+// CreateOffer makes an offer for an application, moves it to offered and emails
+// the candidate a signed link to accept or decline
+func (s *OfferService) CreateOffer(ctx context.Context, applicationID, actor string, req *dto.CreateOfferRequest) (*dto.OfferResponse, error) {
+	application, err := s.getApplication(ctx, applicationID)
+	if err != nil {
+		return nil, err
+	}
+	if application.Status != entities.StatusOffered && !application.Status.CanTransitionTo(entities.StatusOffered) {
+		return nil, fmt.Errorf("%w: offers can only be made to applications in the interview stage", domainErrors.ErrValidationFailed)
+	}
+
+	now := time.Now()
+	offer, err := buildOffer(req, now)
+	if err != nil {
+		return nil, err
+	}
+
+	offers, err := s.offerRepo.ListByApplication(ctx, applicationID)
+	if err != nil {
+		s.logger.Error("Failed to list offers", zap.String("application_id", applicationID), zap.Error(err))
+		return nil, domainErrors.ErrDatabaseQuery
+	}
+	for _, existing := range offers {
+		if !existing.IsPending() {
+			continue
+		}
+		if !existing.IsExpired(now) {
+			return nil, domainErrors.ErrOfferAlreadyPending
+		}
+		// The expiry job has not caught up yet
+		s.expire(ctx, existing, application, now)
+	}
+
+	offer.ApplicationID = applicationID
+	offer.Status = entities.OfferPending
+	offer.CreatedBy = actor
+
+	// The offer and the move to offered are saved together
+	var move *repositories.StatusMove
+	if application.Status != entities.StatusOffered {
+		change := StatusChange{
+			Status: entities.StatusOffered,
+			Actor:  actor,
+			Notes:  "Offer sent",
+		}
+		event, err := s.applicationService.applyStatusChange(application, change)
+		if err != nil {
+			return nil, err
+		}
+		move = &repositories.StatusMove{Application: application, Event: event}
+	}
+
+	created, err := s.offerRepo.Create(ctx, offer, move)
+	if err != nil {
+		s.logger.Error("Failed to create offer", zap.String("application_id", applicationID), zap.Error(err))
+		return nil, domainErrors.ErrDatabaseQuery
+	}
+	if !created {
+		// The application was moved on since it was loaded
+		return nil, fmt.Errorf("%w: offers can only be made to applications in the interview stage", domainErrors.ErrValidationFailed)
+	}
+	if move != nil {
+		s.applicationService.statusChanged(ctx, application, move.Event)
+	}
+
+	link := s.linkBaseURL + signLink(s.linkSecret, offerLinkPurpose, offer.ID)
+	position := positionTitle(ctx, s.positionRepo, application)
+
+	// Send offer letter to candidate (async)
+	sent := *offer
+	go func() {
+		if err := s.emailService.SendOfferLetter(&sent, application.Email, application.Name, position, link); err != nil {
+			s.logger.Error("Failed to send offer letter",
+				zap.String("offer_id", sent.ID),
+				zap.Error(err))
+		}
+	}()
+
+	s.logger.Info("Offer created",
+		zap.String("id", offer.ID),
+		zap.String("application_id", applicationID),
+		zap.Time("expires_at", offer.ExpiresAt),
+		zap.String("actor", actor))
+
+	response := dto.ToOfferResponse(offer)
+	response.Link = link
+	return response, nil
+}
+
+// ListOffers retrieves the offers of an application
+func (s *OfferService) ListOffers(ctx context.Context, applicationID string) (*dto.ListOffersResponse, error) {
+	if _, err := s.getApplication(ctx, applicationID); err != nil {
+		return nil, err
+	}
+
+	offers, err := s.offerRepo.ListByApplication(ctx, applicationID)
+	if err != nil {
+		s.logger.Error("Failed to list offers", zap.String("application_id", applicationID), zap.Error(err))
+		return nil, domainErrors.ErrDatabaseQuery
+	}
+
+	response := &dto.ListOffersResponse{Offers: make([]*dto.OfferResponse, len(offers))}
+	for i, offer := range offers {
+		response.Offers[i] = dto.ToOfferResponse(offer)
+	}
+	return response, nil
+}
+
+// GetOffer retrieves an offer by ID
+func (s *OfferService) GetOffer(ctx context.Context, id string) (*dto.OfferResponse, error) {
+	offer, err := s.getOffer(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return dto.ToOfferResponse(offer), nil
+}
+
+// RescindOffer withdraws a pending offer. Its link stops working immediately.
+func (s *OfferService) RescindOffer(ctx context.Context, id, actor string) (*dto.OfferResponse, error) {
+	offer, err := s.getOffer(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !offer.IsPending() {
+		return nil, domainErrors.ErrOfferNotPending
+	}
+
+	offer.Resolve(entities.OfferRescinded, time.Now())
+	offer.RescindedBy = actor
+	if err := s.resolve(ctx, offer, nil); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("Offer rescinded", zap.String("id", id), zap.String("actor", actor))
+	return dto.ToOfferResponse(offer), nil
+}
+
+// GetOfferLetter returns the offer behind a signed link for the candidate
+func (s *OfferService) GetOfferLetter(ctx context.Context, token string) (*dto.OfferLetterResponse, error) {
+	offer, application, err := s.openOffer(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	letter := dto.ToOfferLetterResponse(offer, application.Name, positionTitle(ctx, s.positionRepo, application))
+	if offer.IsPending() && offer.IsExpired(time.Now()) {
+		letter.Status = entities.OfferExpired
+	}
+	return letter, nil
+}
+
+// RespondToOffer records the candidate's answer to an offer. Accepting moves the
+// application to hired and declining to withdrawn, through the usual status logic.
+func (s *OfferService) RespondToOffer(ctx context.Context, token string, req *dto.RespondToOfferRequest) (*dto.OfferLetterResponse, error) {
+	offer, application, err := s.openOffer(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	var status entities.OfferStatus
+	change := StatusChange{Actor: candidateActor}
+	switch req.Decision {
+	case dto.OfferDecisionAccept:
+		status = entities.OfferAccepted
+		change.Status = entities.StatusHired
+		change.Notes = "Offer accepted"
+	case dto.OfferDecisionDecline:
+		status = entities.OfferDeclined
+		change.Status = entities.StatusWithdrawn
+		change.Notes = "Offer declined"
+		if reason := strings.TrimSpace(req.Reason); reason != "" {
+			change.Notes += ": " + reason
+		}
+	default:
+		return nil, fmt.Errorf("%w: decision must be accept or decline", domainErrors.ErrValidationFailed)
+	}
+
+	now := time.Now()
+	if !offer.IsPending() {
+		return nil, domainErrors.ErrOfferNotPending
+	}
+	if offer.IsExpired(now) {
+		s.expire(ctx, offer, application, now)
+		return nil, domainErrors.ErrOfferExpired
+	}
+	// The application may have been moved on (e.g. rejected) since the offer was sent
+	if !application.Status.CanTransitionTo(change.Status) {
+		return nil, domainErrors.ErrOfferNotPending
+	}
+
+	offer.Resolve(status, now)
+	if status == entities.OfferDeclined {
+		offer.DeclineReason = strings.TrimSpace(req.Reason)
+	}
+	event, err := s.applicationService.applyStatusChange(application, change)
+	if err != nil {
+		return nil, err
+	}
+	// The answer and the application's new status are saved together or not at all
+	move := &repositories.StatusMove{Application: application, Event: event}
+	if err := s.resolve(ctx, offer, move); err != nil {
+		return nil, err
+	}
+	s.applicationService.statusChanged(ctx, application, event)
+
+	s.logger.Info("Offer answered",
+		zap.String("id", offer.ID),
+		zap.String("application_id", application.ID),
+		zap.String("status", string(offer.Status)))
+
+	position := positionTitle(ctx, s.positionRepo, application)
+	s.notifyHR(offer, application, position)
+
+	return dto.ToOfferLetterResponse(offer, application.Name, position), nil
+}
+
+// ExpireOffers expires pending offers past their deadline. It is run periodically
+// by the scheduler.
+func (s *OfferService) ExpireOffers(ctx context.Context) error {
+	now := time.Now()
+	offers, err := s.offerRepo.ListExpired(ctx, now)
+	if err != nil {
+		return err
+	}
+
+	for _, offer := range offers {
+		application, err := s.applicationRepo.GetByID(ctx, offer.ApplicationID)
+		if err != nil {
+			s.logger.Error("Failed to get application for expired offer",
+				zap.String("offer_id", offer.ID), zap.Error(err))
+			continue
+		}
+		s.expire(ctx, offer, application, now)
+	}
+	return nil
+}
+
+// expire marks a pending offer as expired and tells HR. The application is left
+// in offered so a new offer can be made.
+func (s *OfferService) expire(ctx context.Context, offer *entities.Offer, application *entities.Application, now time.Time) {
+	offer.Resolve(entities.OfferExpired, now)
+	ok, err := s.offerRepo.Resolve(ctx, offer, nil)
+	if err != nil {
+		s.logger.Error("Failed to expire offer", zap.String("id", offer.ID), zap.Error(err))
+		return
+	}
+	if !ok {
+		return
+	}
+
+	s.logger.Info("Offer expired", zap.String("id", offer.ID), zap.String("application_id", offer.ApplicationID))
+	s.notifyHR(offer, application, positionTitle(ctx, s.positionRepo, application))
+}
+
+// resolve saves a resolved offer with the application's status move, if any, failing if
+// the offer was resolved or the application moved on concurrently
+func (s *OfferService) resolve(ctx context.Context, offer *entities.Offer, move *repositories.StatusMove) error {
+	ok, err := s.offerRepo.Resolve(ctx, offer, move)
+	if err != nil {
+		s.logger.Error("Failed to resolve offer", zap.String("id", offer.ID), zap.Error(err))
+		return domainErrors.ErrDatabaseQuery
+	}
+	if !ok {
+		return domainErrors.ErrOfferNotPending
+	}
+	return nil
+}
+
+// notifyHR tells HR how an offer was resolved (async)
+func (s *OfferService) notifyHR(offer *entities.Offer, application *entities.Application, position string) {
+	resolved := *offer
+	go func() {
+		if err := s.emailService.SendOfferResponseNotification(&resolved, application.Name, position); err != nil {
+			s.logger.Error("Failed to send offer response notification",
+				zap.String("offer_id", resolved.ID),
+				zap.Error(err))
+		}
+	}()
+}
+
+// openOffer resolves a signed link to its offer and application
+func (s *OfferService) openOffer(ctx context.Context, token string) (*entities.Offer, *entities.Application, error) {
+	id, ok := verifyLink(s.linkSecret, offerLinkPurpose, token)
+	if !ok {
+		return nil, nil, domainErrors.ErrOfferNotFound
+	}
+
+	offer, err := s.getOffer(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	application, err := s.applicationRepo.GetByID(ctx, offer.ApplicationID)
+	if err != nil {
+		if errors.Is(err, domainErrors.ErrApplicationNotFound) {
+			return nil, nil, domainErrors.ErrOfferNotFound
+		}
+		s.logger.Error("Failed to get application for offer", zap.String("offer_id", id), zap.Error(err))
+		return nil, nil, domainErrors.ErrDatabaseQuery
+	}
+	return offer, application, nil
+}
+
+func (s *OfferService) getApplication(ctx context.Context, id string) (*entities.Application, error) {
+	application, err := s.applicationRepo.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, domainErrors.ErrApplicationNotFound) {
+			return nil, domainErrors.ErrApplicationNotFound
+		}
+		s.logger.Error("Failed to get application", zap.String("id", id), zap.Error(err))
+		return nil, domainErrors.ErrDatabaseQuery
+	}
+	return application, nil
+}
+
+func (s *OfferService) getOffer(ctx context.Context, id string) (*entities.Offer, error) {
+	offer, err := s.offerRepo.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, domainErrors.ErrOfferNotFound) {
+			return nil, domainErrors.ErrOfferNotFound
+		}
+		s.logger.Error("Failed to get offer", zap.String("id", id), zap.Error(err))
+		return nil, domainErrors.ErrDatabaseQuery
+	}
+	return offer, nil
+}
+
+// buildOffer validates an offer request and applies defaults
+func buildOffer(req *dto.CreateOfferRequest, now time.Time) (*entities.Offer, error) {
+	currency := strings.ToUpper(strings.TrimSpace(req.Currency))
+	if len(currency) != 3 || strings.Trim(currency, "ABCDEFGHIJKLMNOPQRSTUVWXYZ") != "" {
+		return nil, fmt.Errorf("%w: currency must be a 3-letter ISO 4217 code such as EUR", domainErrors.ErrValidationFailed)
+	}
+	if req.Salary <= 0 {
+		return nil, fmt.Errorf("%w: salary must be greater than zero", domainErrors.ErrValidationFailed)
+	}
+	if len(req.Terms) > maxOfferTermsLength {
+		return nil, fmt.Errorf("%w: terms must be at most %d characters", domainErrors.ErrValidationFailed, maxOfferTermsLength)
+	}
+
+	startDate, err := dto.ParseOfferDate(req.StartDate)
+	if err != nil {
+		return nil, fmt.Errorf("%w: start_date must be a date such as 2023-03-01", domainErrors.ErrValidationFailed)
+	}
+	if !startDate.After(now) {
+		return nil, fmt.Errorf("%w: start_date must be in the future", domainErrors.ErrValidationFailed)
+	}
+
+	expiresAt := now.Add(defaultOfferTTL)
+	if req.ExpiresAt != nil {
+		expiresAt = *req.ExpiresAt
+	}
+	if !expiresAt.After(now) {
+		return nil, fmt.Errorf("%w: expires_at must be in the future", domainErrors.ErrValidationFailed)
+	}
+	if expiresAt.After(startDate) {
+		return nil, fmt.Errorf("%w: expires_at must be before start_date", domainErrors.ErrValidationFailed)
+	}
+
+	return &entities.Offer{
+		Salary:    req.Salary,
+		Currency:  currency,
+		StartDate: startDate,
+		ExpiresAt: expiresAt.UTC(),
+		Terms:     strings.TrimSpace(req.Terms),
+	}, nil
+}
@@ -0,0 +1,187 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"net/mail"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"super2025-backend/internal/application/dto"
+	"super2025-backend/internal/domain/entities"
+	domainErrors "super2025-backend/internal/domain/errors"
+	"super2025-backend/internal/domain/repositories"
+)
+
+const (
+	// portalLoginTTL is how long an emailed portal login link can be redeemed
+	portalLoginTTL = 15 * time.Minute
+	// portalSessionTTL is how long a portal session lasts after login
+	portalSessionTTL = 24 * time.Hour
+	// maxPortalLoginsPerHour bounds the login emails sent to one candidate
+	maxPortalLoginsPerHour = 5
+)
+
+// PortalService implements the read-only candidate status portal with magic-link login
+type PortalService struct {
+	portalRepo      repositories.PortalRepository
+	candidateRepo   repositories.CandidateRepository
+	applicationRepo repositories.ApplicationRepository
+	positionRepo    repositories.PositionRepository
+	emailService    EmailService
+	linkBaseURL     string
+	logger          *zap.Logger
+}
+
+// NewPortalService creates a new portal service. Login links sent to candidates
+// are linkBaseURL followed by the token.
+func NewPortalService(
+	portalRepo repositories.PortalRepository,
+	candidateRepo repositories.CandidateRepository,
+	applicationRepo repositories.ApplicationRepository,
+	positionRepo repositories.PositionRepository,
+	emailService EmailService,
+	linkBaseURL string,
+	logger *zap.Logger,
+) *PortalService {
+	return &PortalService{
+		portalRepo:      portalRepo,
+		candidateRepo:   candidateRepo,
+		applicationRepo: applicationRepo,
+		positionRepo:    positionRepo,
+		emailService:    emailService,
+		linkBaseURL:     strings.TrimRight(linkBaseURL, "/") + "/",
+		logger:          logger,
+	}
+}
+
+// RequestLogin emails a one-time login link to the candidate with this email.
+// Unknown emails and rate-limited requests succeed silently, so the response
+// does not reveal whether someone has applied.
+func (s *PortalService) RequestLogin(ctx context.Context, email string) error {
+	address, err := mail.ParseAddress(strings.TrimSpace(email))
+	if err != nil {
+		return domainErrors.ErrInvalidEmail
+	}
+
+	candidate, err := s.candidateRepo.GetByEmail(ctx, address.Address)
+	if err != nil {
+		if errors.Is(err, domainErrors.ErrCandidateNotFound) {
+			s.logger.Info("Portal login requested for unknown email")
+			return nil
+		}
+		s.logger.Error("Failed to get candidate for portal login", zap.Error(err))
+		return domainErrors.ErrDatabaseQuery
+	}
+
+	now := time.Now()
+	recent, err := s.portalRepo.CountLoginsSince(ctx, candidate.ID, now.Add(-time.Hour))
+	if err != nil {
+		s.logger.Error("Failed to count portal logins", zap.String("candidate_id", candidate.ID), zap.Error(err))
+		return domainErrors.ErrDatabaseQuery
+	}
+	if recent >= maxPortalLoginsPerHour {
+		s.logger.Warn("Portal login rate limit reached", zap.String("candidate_id", candidate.ID))
+		return nil
+	}
+
+	token, tokenHash, err := generateToken()
+	if err != nil {
+		s.logger.Error("Failed to generate portal login token", zap.Error(err))
+		return domainErrors.ErrInternalServer
+	}
+
+	session := &entities.PortalSession{
+		CandidateID:    candidate.ID,
+		LoginTokenHash: tokenHash,
+		LoginExpiresAt: now.Add(portalLoginTTL).UTC(),
+	}
+	if err := s.portalRepo.Create(ctx, session); err != nil {
+		s.logger.Error("Failed to create portal login", zap.String("candidate_id", candidate.ID), zap.Error(err))
+		return domainErrors.ErrDatabaseQuery
+	}
+
+	// Send login link to candidate (async)
+	link := s.linkBaseURL + token
+	go func() {
+		if err := s.emailService.SendPortalLoginLink(candidate.Email, candidate.Name, link, session.LoginExpiresAt); err != nil {
+			s.logger.Error("Failed to send portal login link",
+				zap.String("candidate_id", candidate.ID),
+				zap.Error(err))
+		}
+	}()
+
+	s.logger.Info("Portal login link issued", zap.String("candidate_id", candidate.ID))
+	return nil
+}
+
+// Redeem exchanges a login link token for a portal session. Each link works once.
+func (s *PortalService) Redeem(ctx context.Context, token string) (*dto.PortalSessionResponse, error) {
+	sessionToken, sessionTokenHash, err := generateToken()
+	if err != nil {
+		s.logger.Error("Failed to generate portal session token", zap.Error(err))
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	expiresAt := time.Now().Add(portalSessionTTL).UTC()
+	session, err := s.portalRepo.Redeem(ctx, hashToken(token), sessionTokenHash, expiresAt)
+	if err != nil {
+		if errors.Is(err, domainErrors.ErrPortalLoginInvalid) {
+			return nil, err
+		}
+		s.logger.Error("Failed to redeem portal login", zap.Error(err))
+		return nil, domainErrors.ErrDatabaseQuery
+	}
+
+	s.logger.Info("Portal session started", zap.String("candidate_id", session.CandidateID))
+
+	return &dto.PortalSessionResponse{
+		SessionToken: sessionToken,
+		ExpiresAt:    expiresAt,
+	}, nil
+}
+
+// ListApplications returns the applications of the candidate behind a portal session
+func (s *PortalService) ListApplications(ctx context.Context, sessionToken string) (*dto.PortalApplicationsResponse, error) {
+	session, err := s.portalRepo.GetActiveSession(ctx, hashToken(sessionToken))
+	if err != nil {
+		if errors.Is(err, domainErrors.ErrPortalSessionInvalid) {
+			return nil, err
+		}
+		s.logger.Error("Failed to get portal session", zap.Error(err))
+		return nil, domainErrors.ErrDatabaseQuery
+	}
+
+	candidate, err := s.candidateRepo.GetByID(ctx, session.CandidateID)
+	if err != nil {
+		if errors.Is(err, domainErrors.ErrCandidateNotFound) {
+			return nil, domainErrors.ErrPortalSessionInvalid
+		}
+		s.logger.Error("Failed to get candidate", zap.String("id", session.CandidateID), zap.Error(err))
+		return nil, domainErrors.ErrDatabaseQuery
+	}
+
+	applications, err := s.applicationRepo.ListByCandidate(ctx, candidate.ID)
+	if err != nil {
+		s.logger.Error("Failed to list candidate applications", zap.String("candidate_id", candidate.ID), zap.Error(err))
+		return nil, domainErrors.ErrDatabaseQuery
+	}
+
+	response := &dto.PortalApplicationsResponse{
+		Name:         candidate.Name,
+		Email:        candidate.Email,
+		Applications: make([]*dto.PortalApplicationResponse, len(applications)),
+	}
+	for i, application := range applications {
+		response.Applications[i] = &dto.PortalApplicationResponse{
+			ID:        application.ID,
+			Position:  positionTitle(ctx, s.positionRepo, application),
+			Status:    application.Status,
+			AppliedAt: application.CreatedAt,
+			UpdatedAt: application.UpdatedAt,
+		}
+	}
+	return response, nil
+}
@@ -0,0 +1,568 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"go.uber.org/zap"
+
+	"super2025-backend/internal/application/dto"
+	"super2025-backend/internal/domain/entities"
+	domainErrors "super2025-backend/internal/domain/errors"
+	"super2025-backend/internal/domain/repositories"
+)
+
+// PositionService implements business logic for job positions
+type PositionService struct {
+	positionRepo       repositories.PositionRepository
+	applicationRepo    repositories.ApplicationRepository
+	applicationService *ApplicationService
+	emailService       EmailService
+	logger             *zap.Logger
+}
+
+// NewPositionService creates a new position service
+func NewPositionService(
+	positionRepo repositories.PositionRepository,
+	applicationRepo repositories.ApplicationRepository,
+	applicationService *ApplicationService,
+	emailService EmailService,
+	logger *zap.Logger,
+) *PositionService {
+	return &PositionService{
+		positionRepo:       positionRepo,
+		applicationRepo:    applicationRepo,
+		applicationService: applicationService,
+		emailService:       emailService,
+		logger:             logger,
+	}
+}
+
+// CreatePosition creates a new job position
+func (s *PositionService) CreatePosition(ctx context.Context, req *dto.CreatePositionRequest) (*dto.PositionResponse, error) {
+	position := &entities.Position{
+		ID:             req.ID,
+		Title:          req.Title,
+		Department:     req.Department,
+		Location:       req.Location,
+		EmploymentType: req.EmploymentType,
+		Description:    req.Description,
+		Requirements:   entities.StringList(req.Requirements),
+		Benefits:       entities.StringList(req.Benefits),
+		Status:         req.Status,
+		OpenAt:         req.OpenAt,
+		CloseAt:        req.CloseAt,
+		OnCloseStatus:  req.OnCloseStatus,
+		NotifyOnClose:  req.NotifyOnClose,
+	}
+	if position.ID == "" {
+		position.ID = entities.Slugify(position.Title)
+	}
+	if err := validatePosition(position); err != nil {
+		return nil, err
+	}
+
+	// Position IDs are slugs chosen by the caller, so check for clashes explicitly
+	existing, err := s.positionRepo.GetByID(ctx, position.ID)
+	if err != nil && err != domainErrors.ErrPositionNotFound {
+		s.logger.Error("Failed to check existing position", zap.Error(err))
+		return nil, domainErrors.ErrDatabaseQuery
+	}
+	if existing != nil {
+		return nil, domainErrors.ErrPositionAlreadyExists
+	}
+
+	if err := s.positionRepo.Create(ctx, position); err != nil {
+		s.logger.Error("Failed to create position", zap.Error(err))
+		return nil, domainErrors.ErrDatabaseQuery
+	}
+
+	s.logger.Info("Position created successfully",
+		zap.String("id", position.ID),
+		zap.String("status", string(position.Status)))
+
+	return dto.ToPositionResponse(position), nil
+}
+
+// GetPosition retrieves a position by ID. Drafts are only visible when includeDrafts is set.
+func (s *PositionService) GetPosition(ctx context.Context, id string, includeDrafts bool) (*dto.PositionResponse, error) {
+	position, err := s.getPosition(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !includeDrafts && position.Status == entities.PositionStatusDraft {
+		return nil, domainErrors.ErrPositionNotFound
+	}
+
+	return dto.ToPositionResponse(position), nil
+}
+
+// ListPositions retrieves positions with filters and pagination
+func (s *PositionService) ListPositions(ctx context.Context, req *dto.ListPositionsRequest) (*dto.ListPositionsResponse, error) {
+	filter := repositories.PositionFilter{
+		Status:         req.Status,
+		Department:     req.Department,
+		EmploymentType: req.EmploymentType,
+		Page:           req.Page,
+		PageSize:       req.PageSize,
+	}
+
+	// Set defaults
+	if filter.Page == 0 {
+		filter.Page = 1
+	}
+	if filter.PageSize == 0 {
+		filter.PageSize = 20
+	}
+
+	positions, total, err := s.positionRepo.List(ctx, filter)
+	if err != nil {
+		s.logger.Error("Failed to list positions", zap.Error(err))
+		return nil, domainErrors.ErrDatabaseQuery
+	}
+
+	return &dto.ListPositionsResponse{
+		Positions:  dto.ToPositionResponseList(positions),
+		Pagination: dto.CalculatePagination(filter.Page, filter.PageSize, total),
+	}, nil
+}
+
+// UpdatePosition updates an existing position
+func (s *PositionService) UpdatePosition(ctx context.Context, id string, req *dto.UpdatePositionRequest) (*dto.PositionResponse, error) {
+	position, err := s.getPosition(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	position.Title = req.Title
+	position.Department = req.Department
+	position.Location = req.Location
+	position.EmploymentType = req.EmploymentType
+	position.Description = req.Description
+	position.Requirements = entities.StringList(req.Requirements)
+	position.Benefits = entities.StringList(req.Benefits)
+	position.OpenAt = req.OpenAt
+	position.CloseAt = req.CloseAt
+	position.OnCloseStatus = req.OnCloseStatus
+	position.NotifyOnClose = req.NotifyOnClose
+
+	// Closing has side effects on pending applications, so it goes through ClosePosition
+	closing := req.Status == entities.PositionStatusClosed && position.Status != entities.PositionStatusClosed
+	if req.Status != "" && !closing {
+		reopening := position.Status == entities.PositionStatusClosed && req.Status == entities.PositionStatusOpen
+		position.Status = req.Status
+		if position.Status != entities.PositionStatusClosed {
+			position.ClosedAt = nil
+		}
+		// As in openPosition, a close date that has passed would close the position again on the next tick
+		if reopening && position.CloseAt != nil && !time.Now().Before(*position.CloseAt) {
+			position.CloseAt = nil
+		}
+	}
+	if err := validatePosition(position); err != nil {
+		return nil, err
+	}
+
+	if err := s.positionRepo.Update(ctx, position); err != nil {
+		s.logger.Error("Failed to update position", zap.String("id", id), zap.Error(err))
+		return nil, domainErrors.ErrDatabaseQuery
+	}
+
+	if closing {
+		if _, err := s.closePosition(ctx, position, "admin", entities.CloseTriggerManual); err != nil {
+			return nil, err
+		}
+	}
+
+	s.logger.Info("Position updated successfully",
+		zap.String("id", id),
+		zap.String("status", string(position.Status)))
+
+	return dto.ToPositionResponse(position), nil
+}
+
+// DeletePosition deletes a position
+func (s *PositionService) DeletePosition(ctx context.Context, id string) error {
+	if err := s.positionRepo.Delete(ctx, id); err != nil {
+		if err == domainErrors.ErrPositionNotFound {
+			return err
+		}
+		s.logger.Error("Failed to delete position", zap.String("id", id), zap.Error(err))
+		return domainErrors.ErrDatabaseQuery
+	}
+
+	s.logger.Info("Position deleted successfully", zap.String("id", id))
+	return nil
+}
+
+// OpenPosition publishes a draft or reopens a closed position
+func (s *PositionService) OpenPosition(ctx context.Context, id string) (*dto.PositionResponse, error) {
+	position, err := s.getPosition(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.openPosition(ctx, position); err != nil {
+		return nil, err
+	}
+
+	return dto.ToPositionResponse(position), nil
+}
+
+// ClosePosition closes a position and handles its pending applications.
+// Closing an already closed position is a no-op and reports AlreadyClosed.
+func (s *PositionService) ClosePosition(ctx context.Context, id string, req *dto.ClosePositionRequest) (*dto.PositionClosureResponse, error) {
+	position, err := s.getPosition(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	closedBy := req.ClosedBy
+	if closedBy == "" {
+		closedBy = "admin"
+	}
+
+	closure, err := s.closePosition(ctx, position, closedBy, entities.CloseTriggerManual)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.PositionClosureResponse{
+		Position:      dto.ToPositionResponse(position),
+		AlreadyClosed: closure == nil,
+		Closure:       closure,
+	}, nil
+}
+
+// ListPositionClosures retrieves the closure history of a position
+func (s *PositionService) ListPositionClosures(ctx context.Context, id string) ([]*entities.PositionClosure, error) {
+	if _, err := s.getPosition(ctx, id); err != nil {
+		return nil, err
+	}
+
+	closures, err := s.positionRepo.ListClosures(ctx, id)
+	if err != nil {
+		s.logger.Error("Failed to list position closures", zap.String("id", id), zap.Error(err))
+		return nil, domainErrors.ErrDatabaseQuery
+	}
+	return closures, nil
+}
+
+// GetQuestions returns the custom questions of a position's application form.
+// Questions of drafts are only visible when includeDrafts is set.
+func (s *PositionService) GetQuestions(ctx context.Context, id string, includeDrafts bool) (*dto.PositionQuestionsResponse, error) {
+	position, err := s.getPosition(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !includeDrafts && position.Status == entities.PositionStatusDraft {
+		return nil, domainErrors.ErrPositionNotFound
+	}
+
+	questions, err := s.positionRepo.ListQuestions(ctx, id)
+	if err != nil {
+		s.logger.Error("Failed to list position questions", zap.String("id", id), zap.Error(err))
+		return nil, domainErrors.ErrDatabaseQuery
+	}
+	return dto.ToPositionQuestionsResponse(id, questions), nil
+}
+
+// ReplaceQuestions sets the custom questions of a position. Questions left out are
+// removed; answers already given to them stay on their applications. Screening rules
+// that test a removed question, or a value the changed question no longer allows, are
+// deactivated, since they could no longer hold as written.
+func (s *PositionService) ReplaceQuestions(ctx context.Context, id string, req *dto.ReplaceQuestionsRequest) (*dto.PositionQuestionsResponse, error) {
+	if _, err := s.getPosition(ctx, id); err != nil {
+		return nil, err
+	}
+
+	existing, err := s.positionRepo.ListQuestions(ctx, id)
+	if err != nil {
+		s.logger.Error("Failed to list position questions", zap.String("id", id), zap.Error(err))
+		return nil, domainErrors.ErrDatabaseQuery
+	}
+
+	questions, err := buildQuestions(existing, req.Questions)
+	if err != nil {
+		return nil, err
+	}
+
+	deactivated, err := s.positionRepo.ReplaceQuestions(ctx, id, questions)
+	if err != nil {
+		s.logger.Error("Failed to save position questions", zap.String("id", id), zap.Error(err))
+		return nil, domainErrors.ErrDatabaseQuery
+	}
+
+	s.logger.Info("Position questions updated",
+		zap.String("id", id),
+		zap.Int("questions", len(questions)),
+		zap.Int("screening_rules_deactivated", deactivated))
+	return dto.ToPositionQuestionsResponse(id, questions), nil
+}
+
+// ProcessSchedule opens and closes positions whose open_at / close_at has passed.
+// It is run periodically by the background scheduler.
+func (s *PositionService) ProcessSchedule(ctx context.Context) error {
+	now := time.Now()
+	positions, err := s.positionRepo.ListDueForSchedule(ctx, now)
+	if err != nil {
+		return err
+	}
+
+	for _, position := range positions {
+		switch {
+		case position.IsDueToOpen(now):
+			err = s.openPosition(ctx, position)
+		case position.IsDueToClose(now):
+			_, err = s.closePosition(ctx, position, "scheduler", entities.CloseTriggerSchedule)
+		}
+		if err != nil {
+			s.logger.Error("Failed to apply position schedule", zap.String("id", position.ID), zap.Error(err))
+		}
+	}
+
+	return nil
+}
+
+// openPosition marks the position open
+func (s *PositionService) openPosition(ctx context.Context, position *entities.Position) error {
+	if position.Status == entities.PositionStatusOpen {
+		return nil
+	}
+
+	// Reopening a position whose close date has passed would close it again on the next tick
+	if position.CloseAt != nil && !time.Now().Before(*position.CloseAt) {
+		position.CloseAt = nil
+	}
+	position.Status = entities.PositionStatusOpen
+	position.ClosedAt = nil
+
+	if err := s.positionRepo.Update(ctx, position); err != nil {
+		s.logger.Error("Failed to open position", zap.String("id", position.ID), zap.Error(err))
+		return domainErrors.ErrDatabaseQuery
+	}
+
+	s.logger.Info("Position opened", zap.String("id", position.ID))
+	return nil
+}
+
+// closePosition closes the position, moves its pending applications to the
+// configured status and records the closure, all in one transaction. It returns
+// nil without error if the position was already closed.
+func (s *PositionService) closePosition(
+	ctx context.Context,
+	position *entities.Position,
+	closedBy string,
+	trigger entities.PositionCloseTrigger,
+) (*entities.PositionClosure, error) {
+	now := time.Now()
+	closure := &entities.PositionClosure{
+		PositionID:         position.ID,
+		ClosedBy:           closedBy,
+		Trigger:            trigger,
+		ApplicationStatus:  position.OnCloseStatus,
+		CandidatesNotified: position.NotifyOnClose && position.OnCloseStatus != "",
+		CreatedAt:          now,
+	}
+
+	var moves []repositories.StatusMove
+	if position.OnCloseStatus != "" {
+		var err error
+		if moves, err = s.pendingApplicationMoves(ctx, position, closedBy); err != nil {
+			return nil, err
+		}
+	}
+
+	moved, closed, err := s.positionRepo.Close(ctx, closure, moves)
+	if err != nil {
+		s.logger.Error("Failed to close position", zap.String("id", position.ID), zap.Error(err))
+		return nil, domainErrors.ErrDatabaseQuery
+	}
+	if !closed {
+		return nil, nil
+	}
+	position.Status = entities.PositionStatusClosed
+	position.ClosedAt = &now
+
+	applications := make([]*entities.Application, len(moved))
+	for i, move := range moved {
+		s.applicationService.statusChanged(ctx, move.Application, move.Event)
+		applications[i] = move.Application
+	}
+	if closure.CandidatesNotified {
+		s.notifyCandidatesOfClosure(position, applications)
+	}
+
+	s.logger.Info("Position closed",
+		zap.String("id", position.ID),
+		zap.String("trigger", string(trigger)),
+		zap.Int("applications_moved", closure.ApplicationsMoved))
+
+	return closure, nil
+}
+
+// pendingApplicationMoves prepares moving the position's pending applications to its OnCloseStatus
+func (s *PositionService) pendingApplicationMoves(ctx context.Context, position *entities.Position, closedBy string) ([]repositories.StatusMove, error) {
+	pending, err := s.applicationRepo.ListByPositionAndStatus(ctx, position.ID, entities.StatusPending)
+	if err != nil {
+		s.logger.Error("Failed to list pending applications", zap.String("position_id", position.ID), zap.Error(err))
+		return nil, domainErrors.ErrDatabaseQuery
+	}
+
+	moves := make([]repositories.StatusMove, 0, len(pending))
+	for _, application := range pending {
+		change := StatusChange{
+			Status: position.OnCloseStatus,
+			Actor:  closedBy,
+			Notes:  "Position closed",
+		}
+		event, err := s.applicationService.applyStatusChange(application, change)
+		if err != nil {
+			continue
+		}
+		moves = append(moves, repositories.StatusMove{Application: application, Event: event})
+	}
+
+	return moves, nil
+}
+
+// notifyCandidatesOfClosure emails the candidates whose applications were moved (async)
+func (s *PositionService) notifyCandidatesOfClosure(position *entities.Position, applications []*entities.Application) {
+	go func() {
+		for _, application := range applications {
+			if err := s.emailService.SendPositionClosedNotification(application.Email, application.Name, position.Title); err != nil {
+				s.logger.Error("Failed to send position closed email",
+					zap.String("email", application.Email),
+					zap.Error(err))
+			}
+		}
+	}()
+}
+
+// getPosition loads a position and normalizes repository errors
+func (s *PositionService) getPosition(ctx context.Context, id string) (*entities.Position, error) {
+	position, err := s.positionRepo.GetByID(ctx, id)
+	if err != nil {
+		if err == domainErrors.ErrPositionNotFound {
+			return nil, err
+		}
+		s.logger.Error("Failed to get position", zap.String("id", id), zap.Error(err))
+		return nil, domainErrors.ErrDatabaseQuery
+	}
+	return position, nil
+}
+
+// validatePosition applies defaults and checks the position fields
+func validatePosition(position *entities.Position) error {
+	if position.EmploymentType == "" {
+		position.EmploymentType = entities.EmploymentFullTime
+	}
+	if position.Status == "" {
+		position.Status = entities.PositionStatusDraft
+	}
+
+	switch {
+	case len(position.Title) < 2 || len(position.Title) > 200:
+		return fmt.Errorf("%w: title must be between 2 and 200 characters", domainErrors.ErrValidationFailed)
+	case position.ID == "" || position.ID != entities.Slugify(position.ID):
+		return fmt.Errorf("%w: id must be a lowercase slug such as senior-ai-engineer", domainErrors.ErrValidationFailed)
+	case !position.EmploymentType.IsValid():
+		return fmt.Errorf("%w: employment_type must be one of full_time, part_time, contract, internship", domainErrors.ErrValidationFailed)
+	case !position.Status.IsValid():
+		return fmt.Errorf("%w: status must be one of draft, open, closed", domainErrors.ErrValidationFailed)
+	case position.OnCloseStatus != "" && !entities.StatusPending.CanTransitionTo(position.OnCloseStatus):
+		return fmt.Errorf("%w: on_close_status must be a status pending applications can move to", domainErrors.ErrValidationFailed)
+	case position.OpenAt != nil && position.CloseAt != nil && !position.CloseAt.After(*position.OpenAt):
+		return fmt.Errorf("%w: close_at must be after open_at", domainErrors.ErrValidationFailed)
+	}
+	return nil
+}
+
+const (
+	// maxPositionQuestions limits the custom questions of one position
+	maxPositionQuestions = 50
+	// maxQuestionOptions limits the options of a choice question
+	maxQuestionOptions = 50
+)
+
+// buildQuestions validates a question set against the position's existing questions.
+// The order of the request becomes the display order.
+func buildQuestions(existing []*entities.PositionQuestion, requested []dto.QuestionRequest) ([]*entities.PositionQuestion, error) {
+	if len(requested) > maxPositionQuestions {
+		return nil, fmt.Errorf("%w: a position can have at most %d questions", domainErrors.ErrValidationFailed, maxPositionQuestions)
+	}
+
+	byID := make(map[string]*entities.PositionQuestion, len(existing))
+	for _, question := range existing {
+		byID[question.ID] = question
+	}
+
+	questions := make([]*entities.PositionQuestion, len(requested))
+	seen := make(map[string]bool, len(requested))
+	for i, req := range requested {
+		field := fmt.Sprintf("questions[%d]", i)
+
+		question := &entities.PositionQuestion{}
+		if req.ID != "" {
+			current, ok := byID[req.ID]
+			if !ok || seen[req.ID] {
+				return nil, fmt.Errorf("%w: %s.id is not a question of this position", domainErrors.ErrValidationFailed, field)
+			}
+			seen[req.ID] = true
+			question = current
+		}
+
+		question.Label = strings.TrimSpace(req.Label)
+		question.HelpText = strings.TrimSpace(req.HelpText)
+		question.Type = req.Type
+		question.Required = req.Required
+		question.SortOrder = i
+
+		switch {
+		case question.Label == "" || utf8.RuneCountInString(question.Label) > 500:
+			return nil, fmt.Errorf("%w: %s.label must be between 1 and 500 characters", domainErrors.ErrValidationFailed, field)
+		case utf8.RuneCountInString(question.HelpText) > 1000:
+			return nil, fmt.Errorf("%w: %s.help_text must be at most 1000 characters", domainErrors.ErrValidationFailed, field)
+		case !question.Type.IsValid():
+			return nil, fmt.Errorf("%w: %s.type must be one of text, single_choice, multi_choice, yes_no, number, url", domainErrors.ErrValidationFailed, field)
+		}
+
+		options, err := questionOptions(field, question.Type, req.Options)
+		if err != nil {
+			return nil, err
+		}
+		question.Options = options
+
+		questions[i] = question
+	}
+	return questions, nil
+}
+
+// questionOptions validates the options of a question. Only choice questions have options.
+func questionOptions(field string, questionType entities.QuestionType, requested []string) (entities.StringList, error) {
+	if !questionType.HasOptions() {
+		if len(requested) > 0 {
+			return nil, fmt.Errorf("%w: %s.options are only allowed for choice questions", domainErrors.ErrValidationFailed, field)
+		}
+		return entities.StringList{}, nil
+	}
+
+	if len(requested) < 2 || len(requested) > maxQuestionOptions {
+		return nil, fmt.Errorf("%w: %s.options must list between 2 and %d choices", domainErrors.ErrValidationFailed, field, maxQuestionOptions)
+	}
+	options := make(entities.StringList, 0, len(requested))
+	for _, option := range requested {
+		option = strings.TrimSpace(option)
+		if option == "" || utf8.RuneCountInString(option) > 200 {
+			return nil, fmt.Errorf("%w: %s.options must be between 1 and 200 characters", domainErrors.ErrValidationFailed, field)
+		}
+		if options.Contains(option) {
+			return nil, fmt.Errorf("%w: %s.options must be unique", domainErrors.ErrValidationFailed, field)
+		}
+		options = append(options, option)
+	}
+	return options, nil
+}
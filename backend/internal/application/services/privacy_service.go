@@ -0,0 +1,343 @@
+package services
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/mail"
+	"path"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"go.uber.org/zap"
+
+	"super2025-backend/internal/application/dto"
+	"super2025-backend/internal/domain/entities"
+	domainErrors "super2025-backend/internal/domain/errors"
+	"super2025-backend/internal/domain/repositories"
+)
+
+// PrivacyService implements data subject requests about candidates
+type PrivacyService struct {
+	candidateRepo   repositories.CandidateRepository
+	applicationRepo repositories.ApplicationRepository
+	commentRepo     repositories.CommentRepository
+	emailLogRepo    repositories.EmailLogRepository
+	privacyRepo     repositories.PrivacyRepository
+	fileStorage     FileStorageService
+	subjectSecret   []byte
+	logger          *zap.Logger
+}
+
+// maxErasureReasonLength limits the reason recorded on an erasure receipt, in characters
+const maxErasureReasonLength = 500
+
+// NewPrivacyService creates a new privacy service. Erasure receipts identify a person
+// by their email address hashed with subjectSecret; without one, erasure is refused.
+func NewPrivacyService(
+	candidateRepo repositories.CandidateRepository,
+	applicationRepo repositories.ApplicationRepository,
+	commentRepo repositories.CommentRepository,
+	emailLogRepo repositories.EmailLogRepository,
+	privacyRepo repositories.PrivacyRepository,
+	fileStorage FileStorageService,
+	subjectSecret []byte,
+	logger *zap.Logger,
+) *PrivacyService {
+	return &PrivacyService{
+		candidateRepo:   candidateRepo,
+		applicationRepo: applicationRepo,
+		commentRepo:     commentRepo,
+		emailLogRepo:    emailLogRepo,
+		privacyRepo:     privacyRepo,
+		fileStorage:     fileStorage,
+		subjectSecret:   subjectSecret,
+		logger:          logger,
+	}
+}
+
+// normalizeSubjectEmail validates an email address and returns its normalized form
+func normalizeSubjectEmail(email string) (string, error) {
+	address, err := mail.ParseAddress(strings.TrimSpace(email))
+	if err != nil {
+		return "", domainErrors.ErrInvalidEmail
+	}
+	return entities.NormalizeEmail(address.Address), nil
+}
+
+// ExportSubjectData collects the personal data held about an email address into a
+// ZIP archive: data.json with the candidate profile, applications, status history,
+// reviewer notes and email log, and the resume files under resumes/.
+// Returns ErrCandidateNotFound when nothing is held about the address.
+func (s *PrivacyService) ExportSubjectData(ctx context.Context, email, actor string) ([]byte, error) {
+	email, err := normalizeSubjectEmail(email)
+	if err != nil {
+		return nil, err
+	}
+
+	export, resumes, err := s.collectSubjectData(ctx, email)
+	if err != nil {
+		return nil, err
+	}
+	export.GeneratedBy = actor
+
+	archive, err := writeSubjectArchive(export, resumes)
+	if err != nil {
+		s.logger.Error("Failed to write subject access archive", zap.Error(err))
+		return nil, fmt.Errorf("failed to write subject access archive: %w", err)
+	}
+
+	s.logger.Info("Subject access export generated",
+		zap.String("email", email),
+		zap.Int("applications", len(export.Applications)),
+		zap.Int("resumes", len(resumes)),
+		zap.String("generated_by", actor))
+	return archive, nil
+}
+
+// collectSubjectData loads everything held about an email address. Resumes are
+// returned keyed by their path within the archive.
+func (s *PrivacyService) collectSubjectData(ctx context.Context, email string) (*dto.SubjectAccessExport, map[string][]byte, error) {
+	candidate, err := s.candidateRepo.GetByEmail(ctx, email)
+	if err != nil && err != domainErrors.ErrCandidateNotFound {
+		s.logger.Error("Failed to get candidate", zap.Error(err))
+		return nil, nil, domainErrors.ErrDatabaseQuery
+	}
+
+	applications, err := s.applicationRepo.ListByEmail(ctx, email)
+	if err != nil {
+		s.logger.Error("Failed to list applications by email", zap.Error(err))
+		return nil, nil, domainErrors.ErrDatabaseQuery
+	}
+
+	emailLog, err := s.emailLogRepo.ListByRecipient(ctx, email)
+	if err != nil {
+		s.logger.Error("Failed to list email log", zap.Error(err))
+		return nil, nil, domainErrors.ErrDatabaseQuery
+	}
+
+	if candidate == nil && len(applications) == 0 && len(emailLog) == 0 {
+		return nil, nil, domainErrors.ErrCandidateNotFound
+	}
+
+	export := &dto.SubjectAccessExport{
+		Email:        email,
+		GeneratedAt:  time.Now(),
+		Applications: make([]*dto.ApplicationExport, len(applications)),
+		EmailLog:     dto.ToEmailLogResponseList(emailLog),
+	}
+	if candidate != nil {
+		export.Candidate = dto.ToCandidateProfileResponse(candidate)
+	}
+
+	resumes := make(map[string][]byte)
+	for i, application := range applications {
+		events, err := s.applicationRepo.ListStatusEvents(ctx, application.ID)
+		if err != nil {
+			s.logger.Error("Failed to list status events", zap.String("application_id", application.ID), zap.Error(err))
+			return nil, nil, domainErrors.ErrDatabaseQuery
+		}
+		comments, err := s.commentRepo.ListByApplication(ctx, application.ID)
+		if err != nil {
+			s.logger.Error("Failed to list comments", zap.String("application_id", application.ID), zap.Error(err))
+			return nil, nil, domainErrors.ErrDatabaseQuery
+		}
+
+		entry := &dto.ApplicationExport{
+			ApplicationResponse: dto.ToApplicationResponse(application),
+			StatusHistory:       dto.ToStatusEventResponseList(events),
+			Comments:            dto.ToCommentThreads(comments),
+		}
+
+		// A missing resume file does not stop the export; the application still lists its URL
+		if application.ResumeURL != "" {
+			content, err := s.fileStorage.Download(ctx, application.ResumeURL)
+			if err != nil {
+				s.logger.Warn("Failed to read resume for export",
+					zap.String("application_id", application.ID),
+					zap.Error(err))
+			} else {
+				entry.ResumeFile = "resumes/" + application.ID + "-" + path.Base(application.ResumeURL)
+				resumes[entry.ResumeFile] = content
+			}
+		}
+		export.Applications[i] = entry
+	}
+
+	return export, resumes, nil
+}
+
+// EraseSubjectData permanently erases the personal data held about an email address.
+// Resume files are deleted first, then the applications are anonymized and the
+// candidate and the rest of their data deleted in one transaction that also appends
+// an erasure receipt. Returns ErrCandidateNotFound when nothing is held about the address.
+func (s *PrivacyService) EraseSubjectData(ctx context.Context, email, reason, actor string) (*dto.ErasureReceiptResponse, error) {
+	if len(s.subjectSecret) == 0 {
+		return nil, domainErrors.ErrErasureNotConfigured
+	}
+	email, err := normalizeSubjectEmail(email)
+	if err != nil {
+		return nil, err
+	}
+	reason = strings.TrimSpace(reason)
+	if utf8.RuneCountInString(reason) > maxErasureReasonLength {
+		return nil, fmt.Errorf("%w: reason must be at most %d characters", domainErrors.ErrValidationFailed, maxErasureReasonLength)
+	}
+	// The reason is kept for good on the receipt, so it must not identify the person
+	if strings.ContainsRune(reason, '@') {
+		return nil, fmt.Errorf("%w: reason must not contain email addresses", domainErrors.ErrValidationFailed)
+	}
+
+	candidate, err := s.candidateRepo.GetByEmail(ctx, email)
+	if err != nil && err != domainErrors.ErrCandidateNotFound {
+		s.logger.Error("Failed to get candidate", zap.Error(err))
+		return nil, domainErrors.ErrDatabaseQuery
+	}
+
+	applications, err := s.applicationRepo.ListByEmail(ctx, email)
+	if err != nil {
+		s.logger.Error("Failed to list applications by email", zap.Error(err))
+		return nil, domainErrors.ErrDatabaseQuery
+	}
+
+	emailLog, err := s.emailLogRepo.ListByRecipient(ctx, email)
+	if err != nil {
+		s.logger.Error("Failed to list email log", zap.Error(err))
+		return nil, domainErrors.ErrDatabaseQuery
+	}
+
+	if candidate == nil && len(applications) == 0 && len(emailLog) == 0 {
+		return nil, domainErrors.ErrCandidateNotFound
+	}
+
+	receipt := &entities.ErasureReceipt{
+		SubjectHash:    entities.HashSubject(s.subjectSecret, email),
+		ApplicationIDs: make(entities.StringList, len(applications)),
+		Reason:         reason,
+		ErasedBy:       actor,
+		ErasedAt:       time.Now().UTC().Truncate(time.Microsecond),
+	}
+
+	// The files go first: if one cannot be deleted nothing is erased and the request can be retried
+	for i, application := range applications {
+		receipt.ApplicationIDs[i] = application.ID
+		if application.ResumeURL == "" {
+			continue
+		}
+		if err := s.fileStorage.Delete(ctx, application.ResumeURL); err != nil {
+			s.logger.Error("Failed to delete resume for erasure",
+				zap.String("application_id", application.ID),
+				zap.Error(err))
+			return nil, fmt.Errorf("failed to delete resume of application %s: %w", application.ID, err)
+		}
+		receipt.ResumesDeleted++
+	}
+
+	var candidateID *string
+	if candidate != nil {
+		candidateID = &candidate.ID
+	}
+	if err := s.privacyRepo.Erase(ctx, email, candidateID, receipt.ApplicationIDs, receipt); err != nil {
+		s.logger.Error("Failed to erase subject data", zap.Error(err))
+		return nil, domainErrors.ErrDatabaseQuery
+	}
+
+	s.logger.Info("Subject data erased",
+		zap.String("receipt_id", receipt.ID),
+		zap.Int64("sequence", receipt.Sequence),
+		zap.Int("applications", receipt.ApplicationsAnonymized),
+		zap.String("erased_by", actor))
+	return dto.ToErasureReceiptResponse(receipt), nil
+}
+
+// ListErasureReceipts lists erasure receipts, all of them or only those for an email address
+func (s *PrivacyService) ListErasureReceipts(ctx context.Context, email string) (*dto.ListErasureReceiptsResponse, error) {
+	subjectHash := ""
+	if strings.TrimSpace(email) != "" {
+		normalized, err := normalizeSubjectEmail(email)
+		if err != nil {
+			return nil, err
+		}
+		if len(s.subjectSecret) == 0 {
+			return nil, domainErrors.ErrErasureNotConfigured
+		}
+		subjectHash = entities.HashSubject(s.subjectSecret, normalized)
+	}
+
+	receipts, err := s.privacyRepo.ListReceipts(ctx, subjectHash)
+	if err != nil {
+		s.logger.Error("Failed to list erasure receipts", zap.Error(err))
+		return nil, domainErrors.ErrDatabaseQuery
+	}
+
+	return &dto.ListErasureReceiptsResponse{
+		Receipts: dto.ToErasureReceiptResponseList(receipts),
+		Total:    len(receipts),
+	}, nil
+}
+
+// VerifyErasureReceipts checks that no erasure receipt was altered, removed or inserted
+func (s *PrivacyService) VerifyErasureReceipts(ctx context.Context) (*dto.ErasureChainResponse, error) {
+	receipts, err := s.privacyRepo.ListReceipts(ctx, "")
+	if err != nil {
+		s.logger.Error("Failed to list erasure receipts", zap.Error(err))
+		return nil, domainErrors.ErrDatabaseQuery
+	}
+
+	response := &dto.ErasureChainResponse{
+		Receipts: len(receipts),
+		BrokenAt: entities.VerifyErasureChain(receipts),
+	}
+	response.Valid = response.BrokenAt == 0
+	if len(receipts) > 0 {
+		response.HeadHash = receipts[len(receipts)-1].Hash
+	}
+	if !response.Valid {
+		s.logger.Warn("Erasure receipt chain is broken", zap.Int64("sequence", response.BrokenAt))
+	}
+	return response, nil
+}
+
+// writeSubjectArchive writes the export as data.json next to the resume files
+func writeSubjectArchive(export *dto.SubjectAccessExport, resumes map[string][]byte) ([]byte, error) {
+	data, err := json.MarshalIndent(export, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	archive := zip.NewWriter(&buf)
+	if err := writeArchiveFile(archive, "data.json", data, export.GeneratedAt); err != nil {
+		return nil, err
+	}
+	for _, application := range export.Applications {
+		if application.ResumeFile == "" {
+			continue
+		}
+		if err := writeArchiveFile(archive, application.ResumeFile, resumes[application.ResumeFile], export.GeneratedAt); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := archive.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// writeArchiveFile adds a compressed file to a ZIP archive
+func writeArchiveFile(archive *zip.Writer, name string, content []byte, modified time.Time) error {
+	w, err := archive.CreateHeader(&zip.FileHeader{
+		Name:     name,
+		Method:   zip.Deflate,
+		Modified: modified,
+	})
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(content)
+	return err
+}
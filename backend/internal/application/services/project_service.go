@@ -0,0 +1,289 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"go.uber.org/zap"
+
+	"super2025-backend/internal/application/dto"
+	"super2025-backend/internal/domain/entities"
+	domainErrors "super2025-backend/internal/domain/errors"
+	"super2025-backend/internal/domain/repositories"
+)
+
+// maxProjectMetrics bounds the headline metrics shown with a project
+const maxProjectMetrics = 6
+
+// ProjectService implements business logic for the project showcase
+type ProjectService struct {
+	projectRepo  repositories.ProjectRepository
+	imageStorage FileStorageService
+	logger       *zap.Logger
+}
+
+// NewProjectService creates a new project service
+func NewProjectService(projectRepo repositories.ProjectRepository, imageStorage FileStorageService, logger *zap.Logger) *ProjectService {
+	return &ProjectService{
+		projectRepo:  projectRepo,
+		imageStorage: imageStorage,
+		logger:       logger,
+	}
+}
+
+// CreateProject adds a showcase project. Without a sort order the project is placed last.
+func (s *ProjectService) CreateProject(ctx context.Context, req *dto.CreateProjectRequest) (*dto.ProjectResponse, error) {
+	project := &entities.Project{}
+	if err := applyProjectRequest(project, req); err != nil {
+		return nil, err
+	}
+	if err := s.assignSlug(ctx, project, req.Slug); err != nil {
+		return nil, err
+	}
+
+	if project.SortOrder == 0 {
+		projects, err := s.projectRepo.List(ctx, repositories.ProjectFilter{})
+		if err != nil {
+			s.logger.Error("Failed to list projects", zap.Error(err))
+			return nil, domainErrors.ErrDatabaseQuery
+		}
+		for _, p := range projects {
+			if p.SortOrder >= project.SortOrder {
+				project.SortOrder = p.SortOrder + 1
+			}
+		}
+	}
+
+	if err := s.projectRepo.Create(ctx, project); err != nil {
+		s.logger.Error("Failed to create project", zap.Error(err))
+		return nil, domainErrors.ErrDatabaseQuery
+	}
+
+	s.logger.Info("Project created", zap.String("id", project.ID), zap.String("slug", project.Slug))
+	return dto.ToProjectResponse(project), nil
+}
+
+// GetProject retrieves a project by slug. Unpublished projects are only visible when includeUnpublished is set.
+func (s *ProjectService) GetProject(ctx context.Context, slug string, includeUnpublished bool) (*dto.ProjectResponse, error) {
+	project, err := s.projectRepo.GetBySlug(ctx, slug)
+	if err != nil {
+		if err == domainErrors.ErrProjectNotFound {
+			return nil, err
+		}
+		s.logger.Error("Failed to get project", zap.String("slug", slug), zap.Error(err))
+		return nil, domainErrors.ErrDatabaseQuery
+	}
+	if !includeUnpublished && !project.Published {
+		return nil, domainErrors.ErrProjectNotFound
+	}
+	return dto.ToProjectResponse(project), nil
+}
+
+// ListProjects retrieves the showcase in display order, optionally limited to one category
+func (s *ProjectService) ListProjects(ctx context.Context, category string, includeUnpublished bool) (*dto.ListProjectsResponse, error) {
+	filter := repositories.ProjectFilter{Category: strings.TrimSpace(category), PublishedOnly: !includeUnpublished}
+	projects, err := s.projectRepo.List(ctx, filter)
+	if err != nil {
+		s.logger.Error("Failed to list projects", zap.Error(err))
+		return nil, domainErrors.ErrDatabaseQuery
+	}
+
+	response := &dto.ListProjectsResponse{Projects: make([]*dto.ProjectResponse, len(projects))}
+	for i, project := range projects {
+		response.Projects[i] = dto.ToProjectResponse(project)
+	}
+	return response, nil
+}
+
+// UpdateProject replaces the details of a project
+func (s *ProjectService) UpdateProject(ctx context.Context, id string, req *dto.UpdateProjectRequest) (*dto.ProjectResponse, error) {
+	project, err := s.getProject(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	sortOrder := project.SortOrder
+	if err := applyProjectRequest(project, req); err != nil {
+		return nil, err
+	}
+	if project.SortOrder == 0 {
+		project.SortOrder = sortOrder
+	}
+	if req.Slug != "" && req.Slug != project.Slug {
+		if err := s.assignSlug(ctx, project, req.Slug); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.projectRepo.Update(ctx, project); err != nil {
+		s.logger.Error("Failed to update project", zap.String("id", id), zap.Error(err))
+		return nil, domainErrors.ErrDatabaseQuery
+	}
+
+	s.logger.Info("Project updated", zap.String("id", id))
+	return dto.ToProjectResponse(project), nil
+}
+
+// ReorderProjects sets the display order to the order of the given IDs. Projects not
+// listed keep their sort order.
+func (s *ProjectService) ReorderProjects(ctx context.Context, req *dto.ReorderRequest) (*dto.ListProjectsResponse, error) {
+	if err := checkReorder(req.IDs); err != nil {
+		return nil, err
+	}
+
+	if err := s.projectRepo.Reorder(ctx, req.IDs); err != nil {
+		if err == domainErrors.ErrProjectNotFound {
+			return nil, err
+		}
+		s.logger.Error("Failed to reorder projects", zap.Error(err))
+		return nil, domainErrors.ErrDatabaseQuery
+	}
+
+	s.logger.Info("Projects reordered", zap.Int("count", len(req.IDs)))
+	return s.ListProjects(ctx, "", true)
+}
+
+// UploadImage stores a project image in the standard widths and replaces the previous image
+func (s *ProjectService) UploadImage(ctx context.Context, id string, content []byte) (*dto.ProjectResponse, error) {
+	project, err := s.getProject(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	image, err := storeImage(ctx, s.imageStorage, "project_"+project.Slug, content, s.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	previous := project.Image
+	project.Image = image
+	if err := s.projectRepo.Update(ctx, project); err != nil {
+		s.logger.Error("Failed to update project image", zap.String("id", id), zap.Error(err))
+		deleteImage(ctx, s.imageStorage, image, s.logger)
+		return nil, domainErrors.ErrDatabaseQuery
+	}
+	deleteImage(ctx, s.imageStorage, previous, s.logger)
+
+	s.logger.Info("Project image uploaded", zap.String("id", id), zap.Int("variants", len(image)))
+	return dto.ToProjectResponse(project), nil
+}
+
+// DeleteImage removes a project's image
+func (s *ProjectService) DeleteImage(ctx context.Context, id string) (*dto.ProjectResponse, error) {
+	project, err := s.getProject(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	previous := project.Image
+	project.Image = entities.ImageVariants{}
+	if err := s.projectRepo.Update(ctx, project); err != nil {
+		s.logger.Error("Failed to remove project image", zap.String("id", id), zap.Error(err))
+		return nil, domainErrors.ErrDatabaseQuery
+	}
+	deleteImage(ctx, s.imageStorage, previous, s.logger)
+
+	return dto.ToProjectResponse(project), nil
+}
+
+// DeleteProject deletes a project and its image
+func (s *ProjectService) DeleteProject(ctx context.Context, id string) error {
+	project, err := s.getProject(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := s.projectRepo.Delete(ctx, id); err != nil {
+		if err == domainErrors.ErrProjectNotFound {
+			return err
+		}
+		s.logger.Error("Failed to delete project", zap.String("id", id), zap.Error(err))
+		return domainErrors.ErrDatabaseQuery
+	}
+	deleteImage(ctx, s.imageStorage, project.Image, s.logger)
+
+	s.logger.Info("Project deleted", zap.String("id", id))
+	return nil
+}
+
+func (s *ProjectService) getProject(ctx context.Context, id string) (*entities.Project, error) {
+	project, err := s.projectRepo.GetByID(ctx, id)
+	if err != nil {
+		if err == domainErrors.ErrProjectNotFound {
+			return nil, err
+		}
+		s.logger.Error("Failed to get project", zap.String("id", id), zap.Error(err))
+		return nil, domainErrors.ErrDatabaseQuery
+	}
+	return project, nil
+}
+
+// assignSlug sets the project's slug from requested, or from the title, if no other project uses it
+func (s *ProjectService) assignSlug(ctx context.Context, project *entities.Project, requested string) error {
+	slug, err := showcaseSlug(requested, project.Title)
+	if err != nil {
+		return err
+	}
+
+	taken, err := s.projectRepo.SlugExists(ctx, slug, project.ID)
+	if err != nil {
+		s.logger.Error("Failed to check project slug", zap.Error(err))
+		return domainErrors.ErrDatabaseQuery
+	}
+	if taken {
+		return domainErrors.ErrProjectSlugTaken
+	}
+
+	project.Slug = slug
+	return nil
+}
+
+// applyProjectRequest validates a create or update request and copies it onto project
+func applyProjectRequest(project *entities.Project, req *dto.CreateProjectRequest) error {
+	title := strings.TrimSpace(req.Title)
+
+	switch {
+	case utf8.RuneCountInString(title) < 2 || utf8.RuneCountInString(title) > 200:
+		return fmt.Errorf("%w: title must be between 2 and 200 characters", domainErrors.ErrValidationFailed)
+	case utf8.RuneCountInString(req.Category) > 100:
+		return fmt.Errorf("%w: category must be at most 100 characters", domainErrors.ErrValidationFailed)
+	case utf8.RuneCountInString(req.Description) > 5000:
+		return fmt.Errorf("%w: description must be at most 5000 characters", domainErrors.ErrValidationFailed)
+	case utf8.RuneCountInString(req.Status) > 50 || utf8.RuneCountInString(req.Timeline) > 50:
+		return fmt.Errorf("%w: status and timeline must be at most 50 characters", domainErrors.ErrValidationFailed)
+	case len(req.Metrics) > maxProjectMetrics:
+		return fmt.Errorf("%w: at most %d metrics are allowed", domainErrors.ErrValidationFailed, maxProjectMetrics)
+	case req.SortOrder < 0:
+		return fmt.Errorf("%w: sort_order must not be negative", domainErrors.ErrValidationFailed)
+	}
+	if err := checkWebLink("url", strings.TrimSpace(req.URL)); err != nil {
+		return err
+	}
+	technologies, err := cleanList("technologies", req.Technologies)
+	if err != nil {
+		return err
+	}
+
+	metrics := entities.ProjectMetrics{}
+	for _, metric := range req.Metrics {
+		label, value := strings.TrimSpace(metric.Label), strings.TrimSpace(metric.Value)
+		if label == "" || value == "" || utf8.RuneCountInString(label) > 50 || utf8.RuneCountInString(value) > 50 {
+			return fmt.Errorf("%w: metrics need a label and a value of at most 50 characters each", domainErrors.ErrValidationFailed)
+		}
+		metrics = append(metrics, entities.ProjectMetric{Label: label, Value: value})
+	}
+
+	project.Title = title
+	project.Category = strings.TrimSpace(req.Category)
+	project.Description = strings.TrimSpace(req.Description)
+	project.Technologies = technologies
+	project.Metrics = metrics
+	project.Status = strings.TrimSpace(req.Status)
+	project.Timeline = strings.TrimSpace(req.Timeline)
+	project.URL = strings.TrimSpace(req.URL)
+	project.SortOrder = req.SortOrder
+	project.Published = req.Published
+	return nil
+}
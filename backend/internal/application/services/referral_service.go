@@ -0,0 +1,200 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"net/mail"
+	"strings"
+	"unicode/utf8"
+
+	"go.uber.org/zap"
+
+	"super2025-backend/internal/application/dto"
+	"super2025-backend/internal/domain/entities"
+	domainErrors "super2025-backend/internal/domain/errors"
+	"super2025-backend/internal/domain/repositories"
+)
+
+const (
+	// referralCodeLength is the length of generated referral codes
+	referralCodeLength = 8
+	// referralCodeAlphabet leaves out characters that are easily confused when typed (0/O, 1/I/L)
+	referralCodeAlphabet = "23456789ABCDEFGHJKMNPQRSTUVWXYZ"
+)
+
+// ReferralService implements business logic for employee referral codes
+type ReferralService struct {
+	referralRepo repositories.ReferralCodeRepository
+	logger       *zap.Logger
+}
+
+// NewReferralService creates a new referral service
+func NewReferralService(referralRepo repositories.ReferralCodeRepository, logger *zap.Logger) *ReferralService {
+	return &ReferralService{
+		referralRepo: referralRepo,
+		logger:       logger,
+	}
+}
+
+// CreateCode issues a referral code to an employee
+func (s *ReferralService) CreateCode(ctx context.Context, req *dto.CreateReferralCodeRequest, actor string) (*dto.ReferralCodeResponse, error) {
+	name, email, err := validateReferrer(req.EmployeeName, req.EmployeeEmail)
+	if err != nil {
+		return nil, err
+	}
+
+	code := entities.NormalizeReferralCode(req.Code)
+	if code != "" {
+		if err := checkReferralCode(code); err != nil {
+			return nil, err
+		}
+		exists, err := s.referralRepo.CodeExists(ctx, code)
+		if err != nil {
+			s.logger.Error("Failed to check referral code", zap.Error(err))
+			return nil, domainErrors.ErrDatabaseQuery
+		}
+		if exists {
+			return nil, domainErrors.ErrReferralCodeTaken
+		}
+	} else if code, err = s.generateCode(ctx); err != nil {
+		return nil, err
+	}
+
+	referral := &entities.ReferralCode{
+		Code:          code,
+		EmployeeName:  name,
+		EmployeeEmail: email,
+		Active:        true,
+		CreatedBy:     actor,
+	}
+	if err := s.referralRepo.Create(ctx, referral); err != nil {
+		s.logger.Error("Failed to create referral code", zap.Error(err))
+		return nil, domainErrors.ErrDatabaseQuery
+	}
+
+	s.logger.Info("Referral code issued",
+		zap.String("id", referral.ID),
+		zap.String("employee_email", referral.EmployeeEmail),
+		zap.String("created_by", actor))
+	return dto.ToReferralCodeResponse(referral), nil
+}
+
+// GetCode retrieves a referral code by ID
+func (s *ReferralService) GetCode(ctx context.Context, id string) (*dto.ReferralCodeResponse, error) {
+	referral, err := s.getCode(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return dto.ToReferralCodeResponse(referral), nil
+}
+
+// ListCodes retrieves referral codes, optionally only active ones
+func (s *ReferralService) ListCodes(ctx context.Context, activeOnly bool) (*dto.ListReferralCodesResponse, error) {
+	codes, err := s.referralRepo.List(ctx, activeOnly)
+	if err != nil {
+		s.logger.Error("Failed to list referral codes", zap.Error(err))
+		return nil, domainErrors.ErrDatabaseQuery
+	}
+
+	response := &dto.ListReferralCodesResponse{Codes: make([]*dto.ReferralCodeResponse, len(codes))}
+	for i, code := range codes {
+		response.Codes[i] = dto.ToReferralCodeResponse(code)
+	}
+	return response, nil
+}
+
+// UpdateCode changes the employee details of a referral code or (de)activates it.
+// Applications already attributed to the code keep their attribution.
+func (s *ReferralService) UpdateCode(ctx context.Context, id string, req *dto.UpdateReferralCodeRequest) (*dto.ReferralCodeResponse, error) {
+	referral, err := s.getCode(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	name, email := referral.EmployeeName, referral.EmployeeEmail
+	if req.EmployeeName != "" {
+		name = req.EmployeeName
+	}
+	if req.EmployeeEmail != "" {
+		email = req.EmployeeEmail
+	}
+	if referral.EmployeeName, referral.EmployeeEmail, err = validateReferrer(name, email); err != nil {
+		return nil, err
+	}
+	if req.Active != nil {
+		referral.Active = *req.Active
+	}
+
+	if err := s.referralRepo.Update(ctx, referral); err != nil {
+		s.logger.Error("Failed to update referral code", zap.String("id", id), zap.Error(err))
+		return nil, domainErrors.ErrDatabaseQuery
+	}
+
+	s.logger.Info("Referral code updated", zap.String("id", id), zap.Bool("active", referral.Active))
+	return dto.ToReferralCodeResponse(referral), nil
+}
+
+func (s *ReferralService) getCode(ctx context.Context, id string) (*entities.ReferralCode, error) {
+	referral, err := s.referralRepo.GetByID(ctx, id)
+	if err != nil {
+		if err == domainErrors.ErrReferralCodeNotFound {
+			return nil, err
+		}
+		s.logger.Error("Failed to get referral code", zap.String("id", id), zap.Error(err))
+		return nil, domainErrors.ErrDatabaseQuery
+	}
+	return referral, nil
+}
+
+// generateCode returns a random code that has not been issued yet
+func (s *ReferralService) generateCode(ctx context.Context) (string, error) {
+	for attempt := 0; attempt < 5; attempt++ {
+		raw := make([]byte, referralCodeLength)
+		if _, err := rand.Read(raw); err != nil {
+			s.logger.Error("Failed to generate referral code", zap.Error(err))
+			return "", fmt.Errorf("failed to generate referral code: %w", err)
+		}
+		code := make([]byte, referralCodeLength)
+		for i, b := range raw {
+			code[i] = referralCodeAlphabet[int(b)%len(referralCodeAlphabet)]
+		}
+
+		exists, err := s.referralRepo.CodeExists(ctx, string(code))
+		if err != nil {
+			s.logger.Error("Failed to check referral code", zap.Error(err))
+			return "", domainErrors.ErrDatabaseQuery
+		}
+		if !exists {
+			return string(code), nil
+		}
+	}
+	return "", domainErrors.ErrReferralCodeTaken
+}
+
+// validateReferrer normalizes the employee a referral code is issued to
+func validateReferrer(name, email string) (string, string, error) {
+	name = strings.TrimSpace(name)
+	if length := utf8.RuneCountInString(name); length < 2 || length > 100 {
+		return "", "", fmt.Errorf("%w: employee_name must be between 2 and 100 characters", domainErrors.ErrValidationFailed)
+	}
+
+	address, err := mail.ParseAddress(strings.TrimSpace(email))
+	if err != nil || len(address.Address) > 255 {
+		return "", "", domainErrors.ErrInvalidEmail
+	}
+	return name, strings.ToLower(address.Address), nil
+}
+
+// checkReferralCode validates a custom code: 4 to 32 letters and digits
+func checkReferralCode(code string) error {
+	if len(code) < 4 || len(code) > 32 {
+		return fmt.Errorf("%w: code must be between 4 and 32 characters", domainErrors.ErrValidationFailed)
+	}
+	for _, r := range code {
+		if (r < 'A' || r > 'Z') && (r < '0' || r > '9') {
+			return fmt.Errorf("%w: code may only contain letters and digits", domainErrors.ErrValidationFailed)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,118 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"super2025-backend/internal/application/dto"
+	domainErrors "super2025-backend/internal/domain/errors"
+	"super2025-backend/internal/domain/repositories"
+)
+
+// ReportService implements reporting use cases
+type ReportService struct {
+	reportRepo repositories.ReportRepository
+	logger     *zap.Logger
+}
+
+// NewReportService creates a new report service
+func NewReportService(reportRepo repositories.ReportRepository, logger *zap.Logger) *ReportService {
+	return &ReportService{
+		reportRepo: reportRepo,
+		logger:     logger,
+	}
+}
+
+// TimeInStage reports how long applications spend in each status
+func (s *ReportService) TimeInStage(ctx context.Context, req *dto.ReportRequest) (*dto.TimeInStageResponse, error) {
+	stats, err := s.reportRepo.TimeInStage(ctx, toReportFilter(req))
+	if err != nil {
+		s.logger.Error("Failed to build time-in-stage report", zap.Error(err))
+		return nil, domainErrors.ErrDatabaseQuery
+	}
+
+	return &dto.TimeInStageResponse{
+		Filter: *req,
+		Stages: stats,
+	}, nil
+}
+
+// ReferralBonuses reports referred applications that reached the offered stage,
+// grouped by referrer, for bonus payout
+func (s *ReportService) ReferralBonuses(ctx context.Context, req *dto.ReportRequest) (*dto.ReferralBonusReportResponse, error) {
+	bonuses, err := s.reportRepo.ReferralBonuses(ctx, toReportFilter(req))
+	if err != nil {
+		s.logger.Error("Failed to build referral bonus report", zap.Error(err))
+		return nil, domainErrors.ErrDatabaseQuery
+	}
+
+	response := &dto.ReferralBonusReportResponse{
+		Filter:    *req,
+		Total:     len(bonuses),
+		Referrers: []*dto.ReferrerBonusResponse{},
+	}
+	byCode := make(map[string]*dto.ReferrerBonusResponse)
+	for _, bonus := range bonuses {
+		referrer, ok := byCode[bonus.ReferralCodeID]
+		if !ok {
+			referrer = &dto.ReferrerBonusResponse{
+				ReferralCodeID: bonus.ReferralCodeID,
+				Code:           bonus.Code,
+				EmployeeName:   bonus.EmployeeName,
+				EmployeeEmail:  bonus.EmployeeEmail,
+			}
+			byCode[bonus.ReferralCodeID] = referrer
+			response.Referrers = append(response.Referrers, referrer)
+		}
+		referrer.Referrals = append(referrer.Referrals, bonus)
+		referrer.Count++
+	}
+	return response, nil
+}
+
+// SourceEffectiveness reports how many applications each source brought in and how
+// many of them made it to interviews, offers and hires. Without a grouping, applications
+// are grouped by source.
+func (s *ReportService) SourceEffectiveness(ctx context.Context, req *dto.ReportRequest, groupBy repositories.SourceGrouping) (*dto.SourceEffectivenessResponse, error) {
+	if groupBy == "" {
+		groupBy = repositories.SourceGroupingSource
+	}
+	if !groupBy.IsValid() {
+		return nil, fmt.Errorf("%w: group_by must be source, medium or campaign", domainErrors.ErrValidationFailed)
+	}
+
+	stats, err := s.reportRepo.SourceEffectiveness(ctx, toReportFilter(req), groupBy)
+	if err != nil {
+		s.logger.Error("Failed to build source effectiveness report", zap.Error(err))
+		return nil, domainErrors.ErrDatabaseQuery
+	}
+
+	for _, source := range stats {
+		if source.Applications > 0 {
+			total := float64(source.Applications)
+			source.InterviewRate = float64(source.Interviews) / total
+			source.OfferRate = float64(source.Offers) / total
+			source.HireRate = float64(source.Hires) / total
+		}
+	}
+	if stats == nil {
+		stats = []*repositories.SourceStats{}
+	}
+
+	return &dto.SourceEffectivenessResponse{
+		Filter:  *req,
+		GroupBy: groupBy,
+		Sources: stats,
+	}, nil
+}
+
+// toReportFilter converts a report request DTO to a repository filter
+func toReportFilter(req *dto.ReportRequest) repositories.ReportFilter {
+	return repositories.ReportFilter{
+		PositionID: req.PositionID,
+		DateFrom:   req.DateFrom,
+		DateTo:     req.DateTo,
+	}
+}
@@ -0,0 +1,156 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"super2025-backend/internal/application/dto"
+	"super2025-backend/internal/domain/entities"
+	domainErrors "super2025-backend/internal/domain/errors"
+	"super2025-backend/internal/domain/repositories"
+)
+
+// retentionBatchSize limits how many applications a retention run handles at a time
+const retentionBatchSize = 200
+
+// RetentionService applies the data retention rules
+type RetentionService struct {
+	privacyRepo repositories.PrivacyRepository
+	fileStorage FileStorageService
+	rules       []entities.RetentionRule
+	logger      *zap.Logger
+}
+
+// NewRetentionService creates a new retention service. Rules with no days are ignored.
+func NewRetentionService(
+	privacyRepo repositories.PrivacyRepository,
+	fileStorage FileStorageService,
+	rules []entities.RetentionRule,
+	logger *zap.Logger,
+) *RetentionService {
+	active := make([]entities.RetentionRule, 0, len(rules))
+	for _, rule := range rules {
+		if rule.Days > 0 {
+			active = append(active, rule)
+		}
+	}
+	return &RetentionService{
+		privacyRepo: privacyRepo,
+		fileStorage: fileStorage,
+		rules:       active,
+		logger:      logger,
+	}
+}
+
+// Preview reports what the retention rules would do if they ran now, without changing anything
+func (s *RetentionService) Preview(ctx context.Context) (*dto.RetentionPreviewResponse, error) {
+	now := time.Now()
+	response := &dto.RetentionPreviewResponse{
+		GeneratedAt: now,
+		Rules:       make([]*dto.RetentionRulePreview, len(s.rules)),
+	}
+
+	for i, rule := range s.rules {
+		cutoff := rule.Cutoff(now)
+		applications, err := s.privacyRepo.ListRetentionDue(ctx, rule, cutoff, 0)
+		if err != nil {
+			s.logger.Error("Failed to list applications due for retention", zap.String("rule", rule.Name), zap.Error(err))
+			return nil, domainErrors.ErrDatabaseQuery
+		}
+		response.Rules[i] = dto.ToRetentionRulePreview(rule, cutoff, applications)
+		response.Total += len(applications)
+	}
+
+	return response, nil
+}
+
+// Apply runs every retention rule, in order. Applications whose resume cannot be
+// deleted are left for the next run.
+func (s *RetentionService) Apply(ctx context.Context) error {
+	for _, rule := range s.rules {
+		if err := s.applyRule(ctx, rule, time.Now()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// applyRule handles the applications a rule applies to in batches, until none are
+// left or a batch makes no progress
+func (s *RetentionService) applyRule(ctx context.Context, rule entities.RetentionRule, now time.Time) error {
+	cutoff := rule.Cutoff(now)
+	total := 0
+
+	for {
+		applications, err := s.privacyRepo.ListRetentionDue(ctx, rule, cutoff, retentionBatchSize)
+		if err != nil {
+			return err
+		}
+		if len(applications) == 0 {
+			break
+		}
+
+		affected, err := s.applyBatch(ctx, rule, applications)
+		if err != nil {
+			return err
+		}
+
+		total += affected
+		if affected == 0 || len(applications) < retentionBatchSize {
+			break
+		}
+	}
+
+	if total > 0 {
+		s.logger.Info("Retention rule applied",
+			zap.String("rule", rule.Name),
+			zap.String("action", string(rule.Action)),
+			zap.Int("applications", total))
+	}
+	return nil
+}
+
+// applyBatch takes the rule's action on a batch of applications and returns how many were changed
+func (s *RetentionService) applyBatch(ctx context.Context, rule entities.RetentionRule, applications []*entities.Application) (int, error) {
+	if rule.Action == entities.RetentionClearMetadata {
+		return s.privacyRepo.ClearApplicationMetadata(ctx, applicationIDs(applications))
+	}
+
+	ids := s.deleteResumes(ctx, applications)
+	if len(ids) == 0 {
+		return 0, nil
+	}
+	if rule.Action == entities.RetentionPurge {
+		return s.privacyRepo.PurgeApplications(ctx, ids)
+	}
+	return s.privacyRepo.AnonymizeApplications(ctx, ids, time.Now().UTC())
+}
+
+// deleteResumes deletes the resume files of applications and returns the IDs of
+// those with no resume left in storage
+func (s *RetentionService) deleteResumes(ctx context.Context, applications []*entities.Application) []string {
+	ids := make([]string, 0, len(applications))
+	for _, application := range applications {
+		if application.ResumeURL != "" {
+			if err := s.fileStorage.Delete(ctx, application.ResumeURL); err != nil {
+				s.logger.Error("Failed to delete resume for retention",
+					zap.String("application_id", application.ID),
+					zap.Error(err))
+				continue
+			}
+		}
+		ids = append(ids, application.ID)
+	}
+	return ids
+}
+
+// applicationIDs returns the IDs of applications
+func applicationIDs(applications []*entities.Application) []string {
+	ids := make([]string, len(applications))
+	for i, application := range applications {
+		ids[i] = application.ID
+	}
+	return ids
+}
@@ -0,0 +1,305 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"super2025-backend/internal/application/dto"
+	"super2025-backend/internal/domain/entities"
+	domainErrors "super2025-backend/internal/domain/errors"
+	"super2025-backend/internal/domain/repositories"
+)
+
+const (
+	// defaultSchedulingLinkTTL is how long a scheduling link stays valid unless set explicitly
+	defaultSchedulingLinkTTL = 7 * 24 * time.Hour
+	// maxOfferedSlots bounds the number of slots in one invitation
+	maxOfferedSlots = 50
+	// candidateActor identifies the candidate in status history and audit fields
+	candidateActor = "candidate"
+)
+
+// SchedulingService implements candidate self-scheduling from offered interview slots
+type SchedulingService struct {
+	schedulingRepo   repositories.SchedulingRepository
+	applicationRepo  repositories.ApplicationRepository
+	positionRepo     repositories.PositionRepository
+	interviewService *InterviewService
+	emailService     EmailService
+	linkBaseURL      string
+	logger           *zap.Logger
+}
+
+// NewSchedulingService creates a new scheduling service. Links sent to candidates
+// are linkBaseURL followed by the token.
+func NewSchedulingService(
+	schedulingRepo repositories.SchedulingRepository,
+	applicationRepo repositories.ApplicationRepository,
+	positionRepo repositories.PositionRepository,
+	interviewService *InterviewService,
+	emailService EmailService,
+	linkBaseURL string,
+	logger *zap.Logger,
+) *SchedulingService {
+	return &SchedulingService{
+		schedulingRepo:   schedulingRepo,
+		applicationRepo:  applicationRepo,
+		positionRepo:     positionRepo,
+		interviewService: interviewService,
+		emailService:     emailService,
+		linkBaseURL:      strings.TrimRight(linkBaseURL, "/") + "/",
+		logger:           logger,
+	}
+}
+
+// CreateInvitation offers interview slots for an application and emails the
+// candidate a link to pick one
+func (s *SchedulingService) CreateInvitation(ctx context.Context, applicationID, actor string, req *dto.CreateSchedulingInvitationRequest) (*dto.SchedulingInvitationResponse, error) {
+	application, err := s.interviewService.getApplication(ctx, applicationID)
+	if err != nil {
+		return nil, err
+	}
+	if application.Status.IsTerminal() {
+		return nil, fmt.Errorf("%w: cannot schedule an interview for a %s application", domainErrors.ErrValidationFailed, application.Status)
+	}
+
+	if err := validateMeetingDetails(req.Timezone, req.Location, req.VideoLink); err != nil {
+		return nil, err
+	}
+	interviewers, err := normalizeInterviewers(req.Interviewers)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	slots, err := buildSlots(req.Slots, now)
+	if err != nil {
+		return nil, err
+	}
+
+	// A link is useless once the last slot has started
+	lastStart := slots[len(slots)-1].StartAt
+	expiresAt := now.Add(defaultSchedulingLinkTTL)
+	if req.ExpiresAt != nil {
+		expiresAt = *req.ExpiresAt
+		if !expiresAt.After(now) {
+			return nil, fmt.Errorf("%w: expires_at must be in the future", domainErrors.ErrValidationFailed)
+		}
+	}
+	if expiresAt.After(lastStart) {
+		expiresAt = lastStart
+	}
+
+	token, tokenHash, err := generateToken()
+	if err != nil {
+		s.logger.Error("Failed to generate scheduling token", zap.Error(err))
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	invitation := &entities.SchedulingInvitation{
+		ApplicationID: applicationID,
+		TokenHash:     tokenHash,
+		Interviewers:  interviewers,
+		Timezone:      req.Timezone,
+		Location:      strings.TrimSpace(req.Location),
+		VideoLink:     strings.TrimSpace(req.VideoLink),
+		Notes:         strings.TrimSpace(req.Notes),
+		ExpiresAt:     expiresAt.UTC(),
+		CreatedBy:     actor,
+		Slots:         slots,
+	}
+	if err := s.schedulingRepo.CreateInvitation(ctx, invitation); err != nil {
+		s.logger.Error("Failed to create scheduling invitation", zap.String("application_id", applicationID), zap.Error(err))
+		return nil, domainErrors.ErrDatabaseQuery
+	}
+
+	link := s.linkBaseURL + token
+	position := positionTitle(ctx, s.positionRepo, application)
+
+	// Send scheduling link to candidate (async)
+	sent := *invitation
+	go func() {
+		if err := s.emailService.SendSchedulingInvitation(&sent, application.Email, application.Name, position, link); err != nil {
+			s.logger.Error("Failed to send scheduling invitation",
+				zap.String("invitation_id", sent.ID),
+				zap.Error(err))
+		}
+	}()
+
+	s.logger.Info("Scheduling invitation created",
+		zap.String("id", invitation.ID),
+		zap.String("application_id", applicationID),
+		zap.Int("slots", len(slots)),
+		zap.String("actor", actor))
+
+	response := dto.ToSchedulingInvitationResponse(invitation)
+	response.Link = link
+	return response, nil
+}
+
+// ListInvitations retrieves the scheduling invitations of an application
+func (s *SchedulingService) ListInvitations(ctx context.Context, applicationID string) (*dto.ListSchedulingInvitationsResponse, error) {
+	if _, err := s.interviewService.getApplication(ctx, applicationID); err != nil {
+		return nil, err
+	}
+
+	invitations, err := s.schedulingRepo.ListInvitationsByApplication(ctx, applicationID)
+	if err != nil {
+		s.logger.Error("Failed to list scheduling invitations", zap.String("application_id", applicationID), zap.Error(err))
+		return nil, domainErrors.ErrDatabaseQuery
+	}
+
+	response := &dto.ListSchedulingInvitationsResponse{Invitations: make([]*dto.SchedulingInvitationResponse, len(invitations))}
+	for i, invitation := range invitations {
+		response.Invitations[i] = dto.ToSchedulingInvitationResponse(invitation)
+	}
+	return response, nil
+}
+
+// GetSchedulingPage returns the slots a candidate can pick from with the given token
+func (s *SchedulingService) GetSchedulingPage(ctx context.Context, token string) (*dto.SchedulingPageResponse, error) {
+	invitation, application, err := s.openInvitation(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	return s.schedulingPage(ctx, invitation, application, time.Now()), nil
+}
+
+// BookSlot books the chosen slot for the candidate, creates the interview and
+// sends calendar invites to the candidate and interviewers as confirmation
+func (s *SchedulingService) BookSlot(ctx context.Context, token string, req *dto.BookSlotRequest) (*dto.SchedulingPageResponse, error) {
+	invitation, application, err := s.openInvitation(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	if invitation.IsBooked() {
+		return nil, domainErrors.ErrSlotUnavailable
+	}
+
+	slot, ok := invitation.Slot(req.SlotID)
+	if !ok {
+		return nil, fmt.Errorf("%w: unknown slot_id", domainErrors.ErrValidationFailed)
+	}
+	now := time.Now()
+	if slot.BookedAt != nil || !slot.StartAt.After(now) {
+		return nil, domainErrors.ErrSlotUnavailable
+	}
+
+	interview := &entities.Interview{
+		ApplicationID: application.ID,
+		Interviewers:  invitation.Interviewers,
+		StartAt:       slot.StartAt,
+		EndAt:         slot.EndAt,
+		Timezone:      invitation.Timezone,
+		Location:      invitation.Location,
+		VideoLink:     invitation.VideoLink,
+		Notes:         invitation.Notes,
+		Status:        entities.InterviewScheduled,
+		CreatedBy:     candidateActor,
+	}
+	if err := s.schedulingRepo.BookSlot(ctx, invitation.ID, slot.ID, interview); err != nil {
+		if errors.Is(err, domainErrors.ErrSlotUnavailable) {
+			return nil, err
+		}
+		s.logger.Error("Failed to book interview slot",
+			zap.String("invitation_id", invitation.ID),
+			zap.String("slot_id", slot.ID),
+			zap.Error(err))
+		return nil, domainErrors.ErrDatabaseQuery
+	}
+
+	s.interviewService.interviewScheduled(ctx, application, interview, candidateActor)
+
+	invitation.BookedAt = &now
+	invitation.InterviewID = &interview.ID
+	for i := range invitation.Slots {
+		if invitation.Slots[i].ID == slot.ID {
+			invitation.Slots[i].BookedAt = &now
+		}
+	}
+	return s.schedulingPage(ctx, invitation, application, now), nil
+}
+
+// openInvitation resolves a token to a usable invitation and its application.
+// Booked invitations stay readable after expiry so the candidate can see their slot.
+func (s *SchedulingService) openInvitation(ctx context.Context, token string) (*entities.SchedulingInvitation, *entities.Application, error) {
+	if token == "" {
+		return nil, nil, domainErrors.ErrSchedulingLinkNotFound
+	}
+
+	invitation, err := s.schedulingRepo.GetInvitationByTokenHash(ctx, hashToken(token))
+	if err != nil {
+		if errors.Is(err, domainErrors.ErrSchedulingLinkNotFound) {
+			return nil, nil, err
+		}
+		s.logger.Error("Failed to get scheduling invitation", zap.Error(err))
+		return nil, nil, domainErrors.ErrDatabaseQuery
+	}
+
+	application, err := s.applicationRepo.GetByID(ctx, invitation.ApplicationID)
+	if err != nil {
+		if errors.Is(err, domainErrors.ErrApplicationNotFound) {
+			return nil, nil, domainErrors.ErrSchedulingLinkNotFound
+		}
+		s.logger.Error("Failed to get application for scheduling", zap.String("id", invitation.ApplicationID), zap.Error(err))
+		return nil, nil, domainErrors.ErrDatabaseQuery
+	}
+
+	if !invitation.IsBooked() && (invitation.IsExpired(time.Now()) || application.Status.IsTerminal()) {
+		return nil, nil, domainErrors.ErrSchedulingLinkExpired
+	}
+	return invitation, application, nil
+}
+
+func (s *SchedulingService) schedulingPage(ctx context.Context, invitation *entities.SchedulingInvitation, application *entities.Application, now time.Time) *dto.SchedulingPageResponse {
+	page := &dto.SchedulingPageResponse{
+		CandidateName: application.Name,
+		Position:      positionTitle(ctx, s.positionRepo, application),
+		Timezone:      invitation.Timezone,
+		Location:      invitation.Location,
+		VideoCall:     invitation.VideoLink != "",
+		ExpiresAt:     invitation.ExpiresAt,
+		Booked:        invitation.IsBooked(),
+		Slots:         []*dto.SlotResponse{},
+	}
+	if invitation.IsBooked() {
+		for _, slot := range invitation.Slots {
+			if slot.BookedAt != nil {
+				page.BookedSlot = dto.ToSlotResponse(slot)
+			}
+		}
+		return page
+	}
+	page.Slots = dto.ToSlotResponses(invitation.AvailableSlots(now))
+	return page
+}
+
+// buildSlots validates the offered slots and returns them sorted by start time
+func buildSlots(requested []dto.SlotRequest, now time.Time) ([]entities.InterviewSlot, error) {
+	if len(requested) == 0 {
+		return nil, fmt.Errorf("%w: at least one slot is required", domainErrors.ErrValidationFailed)
+	}
+	if len(requested) > maxOfferedSlots {
+		return nil, fmt.Errorf("%w: at most %d slots can be offered", domainErrors.ErrValidationFailed, maxOfferedSlots)
+	}
+
+	slots := make([]entities.InterviewSlot, 0, len(requested))
+	for _, slot := range requested {
+		if err := validateInterviewTime(slot.StartAt, slot.EndAt); err != nil {
+			return nil, err
+		}
+		if !slot.StartAt.After(now) {
+			return nil, fmt.Errorf("%w: slots must start in the future", domainErrors.ErrValidationFailed)
+		}
+		slots = append(slots, entities.InterviewSlot{StartAt: slot.StartAt.UTC(), EndAt: slot.EndAt.UTC()})
+	}
+
+	sort.Slice(slots, func(i, j int) bool { return slots[i].StartAt.Before(slots[j].StartAt) })
+	return slots, nil
+}
@@ -0,0 +1,299 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"super2025-backend/internal/application/dto"
+	"super2025-backend/internal/domain/entities"
+	domainErrors "super2025-backend/internal/domain/errors"
+	"super2025-backend/internal/domain/repositories"
+)
+
+const (
+	// maxCompetencies bounds the number of competencies rated on one scorecard
+	maxCompetencies = 20
+	// maxFeedbackLength bounds the free-text feedback of a scorecard
+	maxFeedbackLength = 10000
+)
+
+// ScorecardService implements business logic for interview scorecards
+type ScorecardService struct {
+	scorecardRepo   repositories.ScorecardRepository
+	applicationRepo repositories.ApplicationRepository
+	interviewRepo   repositories.InterviewRepository
+	logger          *zap.Logger
+}
+
+// NewScorecardService creates a new scorecard service
+func NewScorecardService(
+	scorecardRepo repositories.ScorecardRepository,
+	applicationRepo repositories.ApplicationRepository,
+	interviewRepo repositories.InterviewRepository,
+	logger *zap.Logger,
+) *ScorecardService {
+	return &ScorecardService{
+		scorecardRepo:   scorecardRepo,
+		applicationRepo: applicationRepo,
+		interviewRepo:   interviewRepo,
+		logger:          logger,
+	}
+}
+
+// SubmitScorecard records an interviewer's scorecard for an application
+func (s *ScorecardService) SubmitScorecard(ctx context.Context, applicationID, interviewer string, req *dto.SubmitScorecardRequest) (*dto.ScorecardResponse, error) {
+	if err := s.ensureApplication(ctx, applicationID); err != nil {
+		return nil, err
+	}
+
+	ratings, err := validateScorecard(req)
+	if err != nil {
+		return nil, err
+	}
+
+	scorecards, err := s.scorecardRepo.ListByApplication(ctx, applicationID)
+	if err != nil {
+		s.logger.Error("Failed to list scorecards", zap.String("application_id", applicationID), zap.Error(err))
+		return nil, domainErrors.ErrDatabaseQuery
+	}
+	if findScorecard(scorecards, interviewer) != nil {
+		return nil, domainErrors.ErrScorecardAlreadySubmitted
+	}
+
+	scorecard := &entities.Scorecard{
+		ApplicationID:  applicationID,
+		Interviewer:    interviewer,
+		Ratings:        ratings,
+		Recommendation: req.Recommendation,
+		Feedback:       strings.TrimSpace(req.Feedback),
+	}
+	if req.InterviewID != "" {
+		if err := s.ensureInterview(ctx, applicationID, req.InterviewID); err != nil {
+			return nil, err
+		}
+		scorecard.InterviewID = &req.InterviewID
+	}
+
+	if err := s.scorecardRepo.Create(ctx, scorecard); err != nil {
+		s.logger.Error("Failed to create scorecard", zap.String("application_id", applicationID), zap.Error(err))
+		return nil, domainErrors.ErrDatabaseQuery
+	}
+
+	s.logger.Info("Scorecard submitted",
+		zap.String("id", scorecard.ID),
+		zap.String("application_id", applicationID),
+		zap.String("interviewer", interviewer),
+		zap.String("recommendation", string(scorecard.Recommendation)))
+
+	return dto.ToScorecardResponse(scorecard), nil
+}
+
+// UpdateScorecard edits a scorecard. Only the interviewer who submitted it may edit it.
+func (s *ScorecardService) UpdateScorecard(ctx context.Context, applicationID, scorecardID, interviewer string, req *dto.SubmitScorecardRequest) (*dto.ScorecardResponse, error) {
+	ratings, err := validateScorecard(req)
+	if err != nil {
+		return nil, err
+	}
+
+	scorecard, err := s.scorecardRepo.GetByID(ctx, applicationID, scorecardID)
+	if err != nil {
+		if errors.Is(err, domainErrors.ErrScorecardNotFound) {
+			return nil, err
+		}
+		s.logger.Error("Failed to get scorecard", zap.String("id", scorecardID), zap.Error(err))
+		return nil, domainErrors.ErrDatabaseQuery
+	}
+	if scorecard.Interviewer != interviewer {
+		return nil, domainErrors.ErrForbidden
+	}
+
+	scorecard.InterviewID = nil
+	if req.InterviewID != "" {
+		if err := s.ensureInterview(ctx, applicationID, req.InterviewID); err != nil {
+			return nil, err
+		}
+		scorecard.InterviewID = &req.InterviewID
+	}
+	scorecard.Ratings = ratings
+	scorecard.Recommendation = req.Recommendation
+	scorecard.Feedback = strings.TrimSpace(req.Feedback)
+
+	if err := s.scorecardRepo.Update(ctx, scorecard); err != nil {
+		s.logger.Error("Failed to update scorecard", zap.String("id", scorecardID), zap.Error(err))
+		return nil, domainErrors.ErrDatabaseQuery
+	}
+
+	s.logger.Info("Scorecard updated", zap.String("id", scorecardID), zap.String("interviewer", interviewer))
+
+	return dto.ToScorecardResponse(scorecard), nil
+}
+
+// ListScorecards retrieves the scorecards of an application visible to viewer
+func (s *ScorecardService) ListScorecards(ctx context.Context, applicationID, viewer string) (*dto.ListScorecardsResponse, error) {
+	if err := s.ensureApplication(ctx, applicationID); err != nil {
+		return nil, err
+	}
+
+	scorecards, visible, err := s.visibleScorecards(ctx, applicationID, viewer)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.ListScorecardsResponse{Scorecards: []*dto.ScorecardResponse{}, Hidden: !visible}
+	if !visible {
+		return response, nil
+	}
+	for _, scorecard := range scorecards {
+		response.Scorecards = append(response.Scorecards, dto.ToScorecardResponse(scorecard))
+	}
+	return response, nil
+}
+
+// Summary averages the scorecards of an application per competency.
+// It returns nil if viewer may not see the scorecards yet.
+func (s *ScorecardService) Summary(ctx context.Context, applicationID, viewer string) (*dto.ScorecardSummary, error) {
+	scorecards, visible, err := s.visibleScorecards(ctx, applicationID, viewer)
+	if err != nil || !visible {
+		return nil, err
+	}
+	return summarizeScorecards(scorecards), nil
+}
+
+// visibleScorecards loads the scorecards of an application and reports whether
+// viewer may see them. Interviewers on the application only see the other
+// scorecards once they have submitted their own, so they are not biased by them.
+func (s *ScorecardService) visibleScorecards(ctx context.Context, applicationID, viewer string) ([]*entities.Scorecard, bool, error) {
+	scorecards, err := s.scorecardRepo.ListByApplication(ctx, applicationID)
+	if err != nil {
+		s.logger.Error("Failed to list scorecards", zap.String("application_id", applicationID), zap.Error(err))
+		return nil, false, domainErrors.ErrDatabaseQuery
+	}
+	if findScorecard(scorecards, viewer) != nil {
+		return scorecards, true, nil
+	}
+
+	interviews, err := s.interviewRepo.ListByApplication(ctx, applicationID)
+	if err != nil {
+		s.logger.Error("Failed to list interviews", zap.String("application_id", applicationID), zap.Error(err))
+		return nil, false, domainErrors.ErrDatabaseQuery
+	}
+	viewer = strings.ToLower(viewer)
+	for _, interview := range interviews {
+		if !interview.IsCancelled() && interview.Interviewers.Contains(viewer) {
+			return nil, false, nil
+		}
+	}
+	return scorecards, true, nil
+}
+
+func (s *ScorecardService) ensureApplication(ctx context.Context, applicationID string) error {
+	if _, err := s.applicationRepo.GetByID(ctx, applicationID); err != nil {
+		if err == domainErrors.ErrApplicationNotFound {
+			return err
+		}
+		s.logger.Error("Failed to get application for scorecards", zap.String("id", applicationID), zap.Error(err))
+		return domainErrors.ErrDatabaseQuery
+	}
+	return nil
+}
+
+func (s *ScorecardService) ensureInterview(ctx context.Context, applicationID, interviewID string) error {
+	interview, err := s.interviewRepo.GetByID(ctx, interviewID)
+	if err != nil {
+		if errors.Is(err, domainErrors.ErrInterviewNotFound) {
+			return fmt.Errorf("%w: unknown interview_id", domainErrors.ErrValidationFailed)
+		}
+		s.logger.Error("Failed to get interview for scorecard", zap.String("id", interviewID), zap.Error(err))
+		return domainErrors.ErrDatabaseQuery
+	}
+	if interview.ApplicationID != applicationID {
+		return fmt.Errorf("%w: interview_id belongs to another application", domainErrors.ErrValidationFailed)
+	}
+	return nil
+}
+
+// findScorecard returns the scorecard submitted by interviewer, if any
+func findScorecard(scorecards []*entities.Scorecard, interviewer string) *entities.Scorecard {
+	if interviewer == "" {
+		return nil
+	}
+	for _, scorecard := range scorecards {
+		if strings.EqualFold(scorecard.Interviewer, interviewer) {
+			return scorecard
+		}
+	}
+	return nil
+}
+
+// validateScorecard checks a scorecard request and returns its ratings keyed by
+// normalized competency name, so "System Design" and "system-design" are averaged together
+func validateScorecard(req *dto.SubmitScorecardRequest) (entities.CompetencyRatings, error) {
+	switch {
+	case len(req.Ratings) == 0:
+		return nil, fmt.Errorf("%w: at least one competency rating is required", domainErrors.ErrValidationFailed)
+	case len(req.Ratings) > maxCompetencies:
+		return nil, fmt.Errorf("%w: at most %d competencies can be rated", domainErrors.ErrValidationFailed, maxCompetencies)
+	case !req.Recommendation.IsValid():
+		return nil, fmt.Errorf("%w: recommendation must be one of strong_no, no, yes, strong_yes", domainErrors.ErrValidationFailed)
+	case len(req.Feedback) > maxFeedbackLength:
+		return nil, fmt.Errorf("%w: feedback must be at most %d characters", domainErrors.ErrValidationFailed, maxFeedbackLength)
+	}
+
+	ratings := make(entities.CompetencyRatings, len(req.Ratings))
+	for competency, rating := range req.Ratings {
+		key := entities.Slugify(competency)
+		if key == "" {
+			return nil, fmt.Errorf("%w: competency names cannot be empty", domainErrors.ErrValidationFailed)
+		}
+		if rating < entities.MinRating || rating > entities.MaxRating {
+			return nil, fmt.Errorf("%w: rating for %q must be between %d and %d", domainErrors.ErrValidationFailed, competency, entities.MinRating, entities.MaxRating)
+		}
+		if _, duplicate := ratings[key]; duplicate {
+			return nil, fmt.Errorf("%w: competency %q is rated twice", domainErrors.ErrValidationFailed, key)
+		}
+		ratings[key] = rating
+	}
+	return ratings, nil
+}
+
+// summarizeScorecards averages ratings per competency and counts recommendations
+func summarizeScorecards(scorecards []*entities.Scorecard) *dto.ScorecardSummary {
+	summary := &dto.ScorecardSummary{
+		Scorecards:      len(scorecards),
+		Competencies:    []*dto.CompetencySummary{},
+		Recommendations: make(map[entities.Recommendation]int),
+	}
+	for _, recommendation := range entities.AllRecommendations() {
+		summary.Recommendations[recommendation] = 0
+	}
+
+	totals := make(map[string]int)
+	counts := make(map[string]int)
+	for _, scorecard := range scorecards {
+		summary.Recommendations[scorecard.Recommendation]++
+		for competency, rating := range scorecard.Ratings {
+			totals[competency] += rating
+			counts[competency]++
+		}
+	}
+
+	for competency, total := range totals {
+		average := float64(total) / float64(counts[competency])
+		summary.Competencies = append(summary.Competencies, &dto.CompetencySummary{
+			Competency: competency,
+			Average:    math.Round(average*100) / 100,
+			Ratings:    counts[competency],
+		})
+	}
+	sort.Slice(summary.Competencies, func(i, j int) bool {
+		return summary.Competencies[i].Competency < summary.Competencies[j].Competency
+	})
+
+	return summary
+}
@@ -0,0 +1,478 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"net/mail"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"go.uber.org/zap"
+
+	"super2025-backend/internal/application/dto"
+	"super2025-backend/internal/domain/entities"
+	domainErrors "super2025-backend/internal/domain/errors"
+	"super2025-backend/internal/domain/repositories"
+)
+
+const (
+	// screeningActor is recorded on status changes made by screening rules
+	screeningActor = "screening"
+	// maxScreeningConditions limits the conditions of one rule
+	maxScreeningConditions = 20
+	// maxScreeningDelayHours limits how long a status change can be put off
+	maxScreeningDelayHours = 30 * 24
+)
+
+// ScreeningService implements automatic screening rules evaluated when an application is submitted
+type ScreeningService struct {
+	screeningRepo      repositories.ScreeningRepository
+	applicationRepo    repositories.ApplicationRepository
+	positionRepo       repositories.PositionRepository
+	tagRepo            repositories.TagRepository
+	applicationService *ApplicationService
+	emailService       EmailService
+	logger             *zap.Logger
+}
+
+// NewScreeningService creates a new screening service
+func NewScreeningService(
+	screeningRepo repositories.ScreeningRepository,
+	applicationRepo repositories.ApplicationRepository,
+	positionRepo repositories.PositionRepository,
+	tagRepo repositories.TagRepository,
+	applicationService *ApplicationService,
+	emailService EmailService,
+	logger *zap.Logger,
+) *ScreeningService {
+	return &ScreeningService{
+		screeningRepo:      screeningRepo,
+		applicationRepo:    applicationRepo,
+		positionRepo:       positionRepo,
+		tagRepo:            tagRepo,
+		applicationService: applicationService,
+		emailService:       emailService,
+		logger:             logger,
+	}
+}
+
+// ListRules returns the screening rules of a position in evaluation order
+func (s *ScreeningService) ListRules(ctx context.Context, positionID string) (*dto.ListScreeningRulesResponse, error) {
+	if _, err := s.getPosition(ctx, positionID); err != nil {
+		return nil, err
+	}
+
+	rules, err := s.screeningRepo.ListRules(ctx, positionID, false)
+	if err != nil {
+		s.logger.Error("Failed to list screening rules", zap.String("position_id", positionID), zap.Error(err))
+		return nil, domainErrors.ErrDatabaseQuery
+	}
+	return dto.ToListScreeningRulesResponse(positionID, rules), nil
+}
+
+// CreateRule adds a screening rule to a position
+func (s *ScreeningService) CreateRule(ctx context.Context, positionID string, req *dto.ScreeningRuleRequest, actor string) (*dto.ScreeningRuleResponse, error) {
+	if _, err := s.getPosition(ctx, positionID); err != nil {
+		return nil, err
+	}
+
+	rule := &entities.ScreeningRule{
+		PositionID: positionID,
+		Active:     true,
+		CreatedBy:  actor,
+	}
+	if err := s.buildRule(ctx, rule, req); err != nil {
+		return nil, err
+	}
+
+	if err := s.screeningRepo.CreateRule(ctx, rule); err != nil {
+		s.logger.Error("Failed to create screening rule", zap.Error(err))
+		return nil, domainErrors.ErrDatabaseQuery
+	}
+
+	// Inserts skip a false active flag in favour of the column default, so save it again
+	if !rule.Active {
+		if err := s.screeningRepo.UpdateRule(ctx, rule); err != nil {
+			s.logger.Error("Failed to deactivate screening rule", zap.String("id", rule.ID), zap.Error(err))
+			return nil, domainErrors.ErrDatabaseQuery
+		}
+	}
+
+	s.logger.Info("Screening rule created",
+		zap.String("id", rule.ID),
+		zap.String("position_id", positionID),
+		zap.String("action", string(rule.Action)),
+		zap.String("created_by", actor))
+	return dto.ToScreeningRuleResponse(rule), nil
+}
+
+// UpdateRule replaces the settings of a screening rule. Results of earlier
+// evaluations are kept; scheduled status changes still apply.
+func (s *ScreeningService) UpdateRule(ctx context.Context, positionID, ruleID string, req *dto.ScreeningRuleRequest) (*dto.ScreeningRuleResponse, error) {
+	rule, err := s.getRule(ctx, positionID, ruleID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.buildRule(ctx, rule, req); err != nil {
+		return nil, err
+	}
+
+	if err := s.screeningRepo.UpdateRule(ctx, rule); err != nil {
+		s.logger.Error("Failed to update screening rule", zap.String("id", ruleID), zap.Error(err))
+		return nil, domainErrors.ErrDatabaseQuery
+	}
+
+	s.logger.Info("Screening rule updated", zap.String("id", ruleID), zap.String("position_id", positionID))
+	return dto.ToScreeningRuleResponse(rule), nil
+}
+
+// DeleteRule removes a screening rule from a position
+func (s *ScreeningService) DeleteRule(ctx context.Context, positionID, ruleID string) error {
+	if _, err := s.getRule(ctx, positionID, ruleID); err != nil {
+		return err
+	}
+
+	if err := s.screeningRepo.DeleteRule(ctx, ruleID); err != nil {
+		if err == domainErrors.ErrScreeningRuleNotFound {
+			return err
+		}
+		s.logger.Error("Failed to delete screening rule", zap.String("id", ruleID), zap.Error(err))
+		return domainErrors.ErrDatabaseQuery
+	}
+
+	s.logger.Info("Screening rule deleted", zap.String("id", ruleID), zap.String("position_id", positionID))
+	return nil
+}
+
+// GetResults returns the screening rules that fired for an application and what came of them
+func (s *ScreeningService) GetResults(ctx context.Context, applicationID string) (*dto.ApplicationScreeningResponse, error) {
+	if _, err := s.applicationRepo.GetByID(ctx, applicationID); err != nil {
+		if err == domainErrors.ErrApplicationNotFound {
+			return nil, err
+		}
+		s.logger.Error("Failed to get application", zap.String("id", applicationID), zap.Error(err))
+		return nil, domainErrors.ErrDatabaseQuery
+	}
+
+	results, err := s.screeningRepo.ListResults(ctx, applicationID)
+	if err != nil {
+		s.logger.Error("Failed to list screening results", zap.String("application_id", applicationID), zap.Error(err))
+		return nil, domainErrors.ErrDatabaseQuery
+	}
+	return dto.ToApplicationScreeningResponse(applicationID, results), nil
+}
+
+// Screen evaluates the active screening rules of the application's position in
+// order, takes the actions of the rules that match and records them. Failures
+// are logged and recorded; they never affect the submission.
+func (s *ScreeningService) Screen(ctx context.Context, applicationID string) {
+	application, err := s.applicationRepo.GetByID(ctx, applicationID)
+	if err != nil {
+		s.logger.Error("Failed to get application for screening", zap.String("id", applicationID), zap.Error(err))
+		return
+	}
+
+	rules, err := s.screeningRepo.ListRules(ctx, application.PositionID, true)
+	if err != nil {
+		s.logger.Error("Failed to list screening rules",
+			zap.String("position_id", application.PositionID),
+			zap.Error(err))
+		return
+	}
+
+	if len(rules) == 0 {
+		return
+	}
+
+	questions, err := s.positionRepo.ListQuestions(ctx, application.PositionID)
+	if err != nil {
+		s.logger.Error("Failed to list position questions",
+			zap.String("position_id", application.PositionID),
+			zap.Error(err))
+		return
+	}
+
+	var results []*entities.ScreeningResult
+	for _, rule := range rules {
+		if !rule.Matches(application, questions) {
+			continue
+		}
+		results = append(results, s.applyRule(ctx, rule, application))
+	}
+	if len(results) == 0 {
+		return
+	}
+
+	if err := s.screeningRepo.CreateResults(ctx, results); err != nil {
+		s.logger.Error("Failed to record screening results", zap.String("application_id", applicationID), zap.Error(err))
+		return
+	}
+
+	s.logger.Info("Application screened",
+		zap.String("application_id", applicationID),
+		zap.Int("rules_fired", len(results)))
+}
+
+// ProcessDue applies delayed status changes whose time has come. A change is
+// skipped when the application has left the status it was in when the rule fired.
+// It is run periodically by the background scheduler.
+func (s *ScreeningService) ProcessDue(ctx context.Context) error {
+	now := time.Now()
+	results, err := s.screeningRepo.ListDueResults(ctx, now)
+	if err != nil {
+		return err
+	}
+
+	for _, result := range results {
+		application, err := s.applicationRepo.GetByID(ctx, result.ApplicationID)
+		switch {
+		case err == domainErrors.ErrApplicationNotFound:
+			result.Outcome = entities.ScreeningOutcomeSkipped
+			result.Error = "application no longer exists"
+		case err != nil:
+			// Retried on the next run
+			s.logger.Error("Failed to get application for screening", zap.String("id", result.ApplicationID), zap.Error(err))
+			continue
+		case application.Status != result.FromStatus:
+			result.Outcome = entities.ScreeningOutcomeSkipped
+			result.Error = fmt.Sprintf("application moved to %s", application.Status)
+		default:
+			change := StatusChange{
+				Status: result.ToStatus,
+				Actor:  screeningActor,
+				Notes:  "Screening rule: " + result.RuleName,
+			}
+			if err := s.applicationService.ChangeStatus(ctx, application, change); err != nil {
+				result.Outcome = entities.ScreeningOutcomeFailed
+				result.Error = err.Error()
+			} else {
+				result.Outcome = entities.ScreeningOutcomeApplied
+			}
+		}
+
+		processedAt := time.Now()
+		result.ProcessedAt = &processedAt
+		if err := s.screeningRepo.UpdateResult(ctx, result); err != nil {
+			s.logger.Error("Failed to update screening result", zap.String("id", result.ID), zap.Error(err))
+		}
+	}
+
+	return nil
+}
+
+// applyRule takes the action of a matching rule and returns the record of it
+func (s *ScreeningService) applyRule(ctx context.Context, rule *entities.ScreeningRule, application *entities.Application) *entities.ScreeningResult {
+	result := &entities.ScreeningResult{
+		ApplicationID: application.ID,
+		RuleID:        rule.ID,
+		RuleName:      rule.Name,
+		Action:        rule.Action,
+		Outcome:       entities.ScreeningOutcomeApplied,
+		CreatedAt:     time.Now(),
+	}
+
+	switch rule.Action {
+	case entities.ScreeningActionTag:
+		result.Detail = rule.Tag
+		if err := s.tagApplication(ctx, application.ID, rule.Tag); err != nil {
+			result.Outcome = entities.ScreeningOutcomeFailed
+			result.Error = err.Error()
+		}
+
+	case entities.ScreeningActionStatus:
+		result.Detail = string(rule.Status)
+		if rule.DelayHours > 0 {
+			dueAt := result.CreatedAt.Add(time.Duration(rule.DelayHours) * time.Hour)
+			result.Outcome = entities.ScreeningOutcomeScheduled
+			result.FromStatus = application.Status
+			result.ToStatus = rule.Status
+			result.DueAt = &dueAt
+			break
+		}
+		change := StatusChange{
+			Status: rule.Status,
+			Actor:  screeningActor,
+			Notes:  "Screening rule: " + rule.Name,
+		}
+		if err := s.applicationService.ChangeStatus(ctx, application, change); err != nil {
+			result.Outcome = entities.ScreeningOutcomeFailed
+			result.Error = err.Error()
+		}
+
+	case entities.ScreeningActionNotify:
+		result.Detail = rule.NotifyEmail
+		position := positionTitle(ctx, s.positionRepo, application)
+		go func() {
+			if err := s.emailService.SendScreeningNotification(rule.NotifyEmail, rule, application, position); err != nil {
+				s.logger.Error("Failed to send screening notification",
+					zap.String("application_id", application.ID),
+					zap.String("rule_id", rule.ID),
+					zap.Error(err))
+			}
+		}()
+	}
+
+	return result
+}
+
+// tagApplication adds a rule's tag to an application
+func (s *ScreeningService) tagApplication(ctx context.Context, applicationID, tag string) error {
+	tags, err := s.tagRepo.FindOrCreate(ctx, []string{tag})
+	if err != nil {
+		s.logger.Error("Failed to find or create tag", zap.String("tag", tag), zap.Error(err))
+		return domainErrors.ErrDatabaseQuery
+	}
+	if err := s.tagRepo.AddToApplication(ctx, applicationID, tags); err != nil {
+		s.logger.Error("Failed to tag application", zap.String("application_id", applicationID), zap.Error(err))
+		return domainErrors.ErrDatabaseQuery
+	}
+	return nil
+}
+
+// buildRule validates a rule request against the position's questions and applies it to the rule
+func (s *ScreeningService) buildRule(ctx context.Context, rule *entities.ScreeningRule, req *dto.ScreeningRuleRequest) error {
+	questions, err := s.positionRepo.ListQuestions(ctx, rule.PositionID)
+	if err != nil {
+		s.logger.Error("Failed to list position questions", zap.String("position_id", rule.PositionID), zap.Error(err))
+		return domainErrors.ErrDatabaseQuery
+	}
+
+	name := strings.TrimSpace(req.Name)
+	if name == "" || utf8.RuneCountInString(name) > 200 {
+		return fmt.Errorf("%w: name must be between 1 and 200 characters", domainErrors.ErrValidationFailed)
+	}
+	conditions, err := screeningConditions(questions, req.Conditions)
+	if err != nil {
+		return err
+	}
+
+	rule.Name = name
+	rule.Conditions = conditions
+	rule.Action = req.Action
+	rule.Tag, rule.Status, rule.DelayHours, rule.NotifyEmail = "", "", 0, ""
+	rule.SortOrder = req.SortOrder
+	if req.Active != nil {
+		rule.Active = *req.Active
+	}
+
+	switch req.Action {
+	case entities.ScreeningActionTag:
+		names, err := normalizeTagNames([]string{req.Tag})
+		if err != nil {
+			return err
+		}
+		rule.Tag = names[0]
+	case entities.ScreeningActionStatus:
+		// Rules run on new applications, so the status must be reachable from pending
+		if !entities.StatusPending.CanTransitionTo(req.Status) {
+			return fmt.Errorf("%w: status must be a status pending applications can move to", domainErrors.ErrValidationFailed)
+		}
+		if req.DelayHours < 0 || req.DelayHours > maxScreeningDelayHours {
+			return fmt.Errorf("%w: delay_hours must be between 0 and %d", domainErrors.ErrValidationFailed, maxScreeningDelayHours)
+		}
+		rule.Status = req.Status
+		rule.DelayHours = req.DelayHours
+	case entities.ScreeningActionNotify:
+		if email := strings.TrimSpace(req.NotifyEmail); email != "" {
+			address, err := mail.ParseAddress(email)
+			if err != nil {
+				return fmt.Errorf("%w: notify_email must be a valid email address", domainErrors.ErrValidationFailed)
+			}
+			rule.NotifyEmail = address.Address
+		}
+	default:
+		return fmt.Errorf("%w: action must be one of tag, status, notify", domainErrors.ErrValidationFailed)
+	}
+	return nil
+}
+
+// screeningConditions validates the conditions of a rule. Answer conditions must name
+// a question of the position and compare values that question can have.
+func screeningConditions(questions []*entities.PositionQuestion, requested []entities.ScreeningCondition) (entities.ScreeningConditions, error) {
+	if len(requested) == 0 || len(requested) > maxScreeningConditions {
+		return nil, fmt.Errorf("%w: a rule must have between 1 and %d conditions", domainErrors.ErrValidationFailed, maxScreeningConditions)
+	}
+
+	byID := make(map[string]*entities.PositionQuestion, len(questions))
+	for _, question := range questions {
+		byID[question.ID] = question
+	}
+
+	conditions := make(entities.ScreeningConditions, len(requested))
+	for i, condition := range requested {
+		field := fmt.Sprintf("conditions[%d]", i)
+		condition.Value = strings.TrimSpace(condition.Value)
+
+		switch {
+		case !condition.Field.IsValid():
+			return nil, fmt.Errorf("%w: %s.field must be one of answer, source, email, phone, cover_letter", domainErrors.ErrValidationFailed, field)
+		case !condition.Operator.IsValid():
+			return nil, fmt.Errorf("%w: %s.operator must be one of equals, not_equals, contains, not_contains, lt, lte, gt, gte, is_empty, is_not_empty", domainErrors.ErrValidationFailed, field)
+		case condition.Operator.NeedsValue() && condition.Value == "":
+			return nil, fmt.Errorf("%w: %s.value is required for %s", domainErrors.ErrValidationFailed, field, condition.Operator)
+		case !condition.Operator.NeedsValue():
+			condition.Value = ""
+		}
+		if condition.Operator.IsNumeric() {
+			if _, err := strconv.ParseFloat(condition.Value, 64); err != nil {
+				return nil, fmt.Errorf("%w: %s.value must be a number", domainErrors.ErrValidationFailed, field)
+			}
+		}
+
+		if condition.Field != entities.ScreeningFieldAnswer {
+			condition.QuestionID = ""
+			conditions[i] = condition
+			continue
+		}
+
+		question, ok := byID[condition.QuestionID]
+		if !ok {
+			return nil, fmt.Errorf("%w: %s.question_id is not a question of this position", domainErrors.ErrValidationFailed, field)
+		}
+		if err := checkAnswerCondition(field, question, condition); err != nil {
+			return nil, err
+		}
+		conditions[i] = condition
+	}
+	return conditions, nil
+}
+
+// checkAnswerCondition rejects conditions that no answer to the question could satisfy
+func checkAnswerCondition(field string, question *entities.PositionQuestion, condition entities.ScreeningCondition) error {
+	if err := condition.CheckQuestion(question); err != nil {
+		return fmt.Errorf("%w: %s.%v", domainErrors.ErrValidationFailed, field, err)
+	}
+	return nil
+}
+
+// getPosition loads a position and normalizes repository errors
+func (s *ScreeningService) getPosition(ctx context.Context, id string) (*entities.Position, error) {
+	position, err := s.positionRepo.GetByID(ctx, id)
+	if err != nil {
+		if err == domainErrors.ErrPositionNotFound {
+			return nil, err
+		}
+		s.logger.Error("Failed to get position", zap.String("id", id), zap.Error(err))
+		return nil, domainErrors.ErrDatabaseQuery
+	}
+	return position, nil
+}
+
+// getRule loads a rule of a position; rules of other positions are reported as not found
+func (s *ScreeningService) getRule(ctx context.Context, positionID, ruleID string) (*entities.ScreeningRule, error) {
+	rule, err := s.screeningRepo.GetRule(ctx, ruleID)
+	if err != nil {
+		if err == domainErrors.ErrScreeningRuleNotFound {
+			return nil, err
+		}
+		s.logger.Error("Failed to get screening rule", zap.String("id", ruleID), zap.Error(err))
+		return nil, domainErrors.ErrDatabaseQuery
+	}
+	if rule.PositionID != positionID {
+		return nil, domainErrors.ErrScreeningRuleNotFound
+	}
+	return rule, nil
+}
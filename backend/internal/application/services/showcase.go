@@ -0,0 +1,127 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"super2025-backend/internal/domain/entities"
+	domainErrors "super2025-backend/internal/domain/errors"
+	"super2025-backend/internal/infrastructure/imaging"
+)
+
+// imageWidths are the standard widths team and project images are resized to
+var imageWidths = []int{320, 640, 1280}
+
+const (
+	// maxListItems bounds skills and technologies on team members and projects
+	maxListItems = 20
+	// maxListItemLength bounds a single skill or technology
+	maxListItemLength = 50
+)
+
+// storeImage resizes an uploaded image to imageWidths and stores every variant
+// under a filename starting with name. Nothing is left behind on failure.
+func storeImage(ctx context.Context, storage FileStorageService, name string, content []byte, logger *zap.Logger) (entities.ImageVariants, error) {
+	resized, err := imaging.Resize(content, imageWidths)
+	switch {
+	case errors.Is(err, imaging.ErrUnsupportedFormat):
+		return nil, domainErrors.ErrInvalidFileType
+	case errors.Is(err, imaging.ErrTooManyPixels):
+		return nil, fmt.Errorf("%w: image dimensions are too large", domainErrors.ErrValidationFailed)
+	case err != nil:
+		logger.Error("Failed to resize image", zap.String("name", name), zap.Error(err))
+		return nil, domainErrors.ErrFileUploadFailed
+	}
+
+	stamp := time.Now().UnixNano()
+	variants := make(entities.ImageVariants, 0, len(resized))
+	for _, r := range resized {
+		filename := fmt.Sprintf("%s_%d_%dw%s", name, stamp, r.Width, r.Extension)
+		url, err := storage.Upload(ctx, filename, r.Content)
+		if err != nil {
+			logger.Error("Failed to store image", zap.String("filename", filename), zap.Error(err))
+			deleteImage(ctx, storage, variants, logger)
+			return nil, domainErrors.ErrFileUploadFailed
+		}
+		variants = append(variants, entities.ImageVariant{Width: r.Width, Height: r.Height, URL: url})
+	}
+	return variants, nil
+}
+
+// deleteImage removes the stored variants of an image. Failures are only logged:
+// by the time this runs no record refers to the files any more.
+func deleteImage(ctx context.Context, storage FileStorageService, variants entities.ImageVariants, logger *zap.Logger) {
+	for _, u := range variants.URLs() {
+		if err := storage.Delete(ctx, u); err != nil {
+			logger.Warn("Failed to delete image", zap.String("url", u), zap.Error(err))
+		}
+	}
+}
+
+// showcaseSlug validates an explicit slug or derives one from fallback
+func showcaseSlug(requested, fallback string) (string, error) {
+	slug := strings.TrimSpace(requested)
+	if slug == "" {
+		slug = entities.Slugify(fallback)
+	} else if slug != entities.Slugify(slug) {
+		return "", fmt.Errorf("%w: slug must be a lowercase slug such as jane-smith", domainErrors.ErrValidationFailed)
+	}
+	if slug == "" || len(slug) > 200 {
+		return "", fmt.Errorf("%w: a slug of at most 200 characters is required", domainErrors.ErrValidationFailed)
+	}
+	return slug, nil
+}
+
+// cleanList trims the items of a skills or technologies list and drops empty and duplicate ones
+func cleanList(field string, items []string) (entities.StringList, error) {
+	list := entities.StringList{}
+	for _, item := range items {
+		item = strings.TrimSpace(item)
+		if item == "" || list.Contains(item) {
+			continue
+		}
+		if len(item) > maxListItemLength {
+			return nil, fmt.Errorf("%w: %s entries must be at most %d characters", domainErrors.ErrValidationFailed, field, maxListItemLength)
+		}
+		list = append(list, item)
+	}
+	if len(list) > maxListItems {
+		return nil, fmt.Errorf("%w: at most %d %s are allowed", domainErrors.ErrValidationFailed, maxListItems, field)
+	}
+	return list, nil
+}
+
+// checkWebLink validates an optional http(s) link
+func checkWebLink(field, link string) error {
+	if link == "" {
+		return nil
+	}
+	if len(link) > 500 {
+		return fmt.Errorf("%w: %s must be at most 500 characters", domainErrors.ErrValidationFailed, field)
+	}
+	if u, err := url.Parse(link); err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+		return fmt.Errorf("%w: %s must be an http or https URL", domainErrors.ErrValidationFailed, field)
+	}
+	return nil
+}
+
+// checkReorder validates the IDs of a reorder request
+func checkReorder(ids []string) error {
+	if len(ids) == 0 {
+		return fmt.Errorf("%w: ids are required", domainErrors.ErrValidationFailed)
+	}
+	seen := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		if seen[id] {
+			return fmt.Errorf("%w: id %s is listed more than once", domainErrors.ErrValidationFailed, id)
+		}
+		seen[id] = true
+	}
+	return nil
+}
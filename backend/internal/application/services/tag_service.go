@@ -0,0 +1,163 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"super2025-backend/internal/application/dto"
+	"super2025-backend/internal/domain/entities"
+	domainErrors "super2025-backend/internal/domain/errors"
+	"super2025-backend/internal/domain/repositories"
+)
+
+const (
+	// maxTagsPerRequest bounds how many tags can be added at once
+	maxTagsPerRequest = 20
+	// maxTagLength bounds the length of a normalized tag name
+	maxTagLength = 50
+)
+
+// TagService implements business logic for application tags
+type TagService struct {
+	tagRepo         repositories.TagRepository
+	applicationRepo repositories.ApplicationRepository
+	logger          *zap.Logger
+}
+
+// NewTagService creates a new tag service
+func NewTagService(
+	tagRepo repositories.TagRepository,
+	applicationRepo repositories.ApplicationRepository,
+	logger *zap.Logger,
+) *TagService {
+	return &TagService{
+		tagRepo:         tagRepo,
+		applicationRepo: applicationRepo,
+		logger:          logger,
+	}
+}
+
+// ListTags retrieves all tags with their usage counts
+func (s *TagService) ListTags(ctx context.Context) ([]*repositories.TagUsage, error) {
+	tags, err := s.tagRepo.List(ctx)
+	if err != nil {
+		s.logger.Error("Failed to list tags", zap.Error(err))
+		return nil, domainErrors.ErrDatabaseQuery
+	}
+	return tags, nil
+}
+
+// AddTags tags an application, creating tags that do not exist yet
+func (s *TagService) AddTags(ctx context.Context, applicationID string, req *dto.AddTagsRequest) (*dto.ApplicationTagsResponse, error) {
+	names, err := normalizeTagNames(req.Tags)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.ensureApplication(ctx, applicationID); err != nil {
+		return nil, err
+	}
+
+	tags, err := s.tagRepo.FindOrCreate(ctx, names)
+	if err != nil {
+		s.logger.Error("Failed to find or create tags", zap.Error(err))
+		return nil, domainErrors.ErrDatabaseQuery
+	}
+
+	if err := s.tagRepo.AddToApplication(ctx, applicationID, tags); err != nil {
+		s.logger.Error("Failed to tag application", zap.String("application_id", applicationID), zap.Error(err))
+		return nil, domainErrors.ErrDatabaseQuery
+	}
+
+	s.logger.Info("Application tagged",
+		zap.String("application_id", applicationID),
+		zap.Strings("tags", names))
+
+	return s.applicationTags(ctx, applicationID)
+}
+
+// RemoveTag removes a tag from an application
+func (s *TagService) RemoveTag(ctx context.Context, applicationID, name string) (*dto.ApplicationTagsResponse, error) {
+	if err := s.ensureApplication(ctx, applicationID); err != nil {
+		return nil, err
+	}
+
+	tag, err := s.tagRepo.GetByName(ctx, entities.NormalizeTagName(name))
+	if err != nil {
+		if err == domainErrors.ErrTagNotFound {
+			return nil, err
+		}
+		s.logger.Error("Failed to get tag", zap.String("tag", name), zap.Error(err))
+		return nil, domainErrors.ErrDatabaseQuery
+	}
+
+	if err := s.tagRepo.RemoveFromApplication(ctx, applicationID, tag); err != nil {
+		if err == domainErrors.ErrTagNotFound {
+			return nil, err
+		}
+		s.logger.Error("Failed to untag application", zap.String("application_id", applicationID), zap.Error(err))
+		return nil, domainErrors.ErrDatabaseQuery
+	}
+
+	s.logger.Info("Application untagged",
+		zap.String("application_id", applicationID),
+		zap.String("tag", tag.Name))
+
+	return s.applicationTags(ctx, applicationID)
+}
+
+// applicationTags builds the response listing an application's current tags
+func (s *TagService) applicationTags(ctx context.Context, applicationID string) (*dto.ApplicationTagsResponse, error) {
+	tags, err := s.tagRepo.ListByApplication(ctx, applicationID)
+	if err != nil {
+		s.logger.Error("Failed to get application tags", zap.String("application_id", applicationID), zap.Error(err))
+		return nil, domainErrors.ErrDatabaseQuery
+	}
+
+	names := make([]string, len(tags))
+	for i, tag := range tags {
+		names[i] = tag.Name
+	}
+
+	return &dto.ApplicationTagsResponse{
+		ApplicationID: applicationID,
+		Tags:          names,
+	}, nil
+}
+
+// ensureApplication checks that the application exists
+func (s *TagService) ensureApplication(ctx context.Context, applicationID string) error {
+	if _, err := s.applicationRepo.GetByID(ctx, applicationID); err != nil {
+		if err == domainErrors.ErrApplicationNotFound {
+			return err
+		}
+		s.logger.Error("Failed to get application for tagging", zap.String("id", applicationID), zap.Error(err))
+		return domainErrors.ErrDatabaseQuery
+	}
+	return nil
+}
+
+// normalizeTagNames validates tag names and returns their unique normalized forms
+func normalizeTagNames(raw []string) ([]string, error) {
+	if len(raw) == 0 {
+		return nil, fmt.Errorf("%w: at least one tag is required", domainErrors.ErrValidationFailed)
+	}
+	if len(raw) > maxTagsPerRequest {
+		return nil, fmt.Errorf("%w: at most %d tags can be added at once", domainErrors.ErrValidationFailed, maxTagsPerRequest)
+	}
+
+	seen := make(map[string]bool, len(raw))
+	names := make([]string, 0, len(raw))
+	for _, tag := range raw {
+		name := entities.NormalizeTagName(tag)
+		if name == "" || len(name) > maxTagLength {
+			return nil, fmt.Errorf("%w: invalid tag %q", domainErrors.ErrValidationFailed, tag)
+		}
+		if !seen[name] {
+			seen[name] = true
+			names = append(names, name)
+		}
+	}
+	return names, nil
+}
@@ -0,0 +1,303 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"net/mail"
+	"strings"
+	"unicode/utf8"
+
+	"go.uber.org/zap"
+
+	"super2025-backend/internal/application/dto"
+	"super2025-backend/internal/domain/entities"
+	domainErrors "super2025-backend/internal/domain/errors"
+	"super2025-backend/internal/domain/repositories"
+)
+
+// TeamService implements business logic for the team page
+type TeamService struct {
+	memberRepo   repositories.TeamMemberRepository
+	imageStorage FileStorageService
+	logger       *zap.Logger
+}
+
+// NewTeamService creates a new team service
+func NewTeamService(memberRepo repositories.TeamMemberRepository, imageStorage FileStorageService, logger *zap.Logger) *TeamService {
+	return &TeamService{
+		memberRepo:   memberRepo,
+		imageStorage: imageStorage,
+		logger:       logger,
+	}
+}
+
+// CreateMember adds a team member. Without a sort order the member is placed last.
+func (s *TeamService) CreateMember(ctx context.Context, req *dto.CreateTeamMemberRequest) (*dto.TeamMemberResponse, error) {
+	member := &entities.TeamMember{}
+	if err := applyTeamMemberRequest(member, req); err != nil {
+		return nil, err
+	}
+	if err := s.assignSlug(ctx, member, req.Slug); err != nil {
+		return nil, err
+	}
+
+	if member.SortOrder == 0 {
+		members, err := s.memberRepo.List(ctx, repositories.TeamMemberFilter{})
+		if err != nil {
+			s.logger.Error("Failed to list team members", zap.Error(err))
+			return nil, domainErrors.ErrDatabaseQuery
+		}
+		for _, m := range members {
+			if m.SortOrder >= member.SortOrder {
+				member.SortOrder = m.SortOrder + 1
+			}
+		}
+	}
+
+	if err := s.memberRepo.Create(ctx, member); err != nil {
+		s.logger.Error("Failed to create team member", zap.Error(err))
+		return nil, domainErrors.ErrDatabaseQuery
+	}
+
+	s.logger.Info("Team member created", zap.String("id", member.ID), zap.String("slug", member.Slug))
+	return dto.ToTeamMemberResponse(member), nil
+}
+
+// GetMember retrieves a team member by slug. Unpublished members are only visible when includeUnpublished is set.
+func (s *TeamService) GetMember(ctx context.Context, slug string, includeUnpublished bool) (*dto.TeamMemberResponse, error) {
+	member, err := s.memberRepo.GetBySlug(ctx, slug)
+	if err != nil {
+		if err == domainErrors.ErrTeamMemberNotFound {
+			return nil, err
+		}
+		s.logger.Error("Failed to get team member", zap.String("slug", slug), zap.Error(err))
+		return nil, domainErrors.ErrDatabaseQuery
+	}
+	if !includeUnpublished && !member.Published {
+		return nil, domainErrors.ErrTeamMemberNotFound
+	}
+	return dto.ToTeamMemberResponse(member), nil
+}
+
+// ListMembers retrieves the team in display order, optionally limited to one role
+func (s *TeamService) ListMembers(ctx context.Context, role entities.TeamRole, includeUnpublished bool) (*dto.ListTeamMembersResponse, error) {
+	if role != "" && !role.IsValid() {
+		return nil, fmt.Errorf("%w: role must be leadership, tech or client", domainErrors.ErrValidationFailed)
+	}
+
+	members, err := s.memberRepo.List(ctx, repositories.TeamMemberFilter{Role: role, PublishedOnly: !includeUnpublished})
+	if err != nil {
+		s.logger.Error("Failed to list team members", zap.Error(err))
+		return nil, domainErrors.ErrDatabaseQuery
+	}
+
+	response := &dto.ListTeamMembersResponse{Members: make([]*dto.TeamMemberResponse, len(members))}
+	for i, member := range members {
+		response.Members[i] = dto.ToTeamMemberResponse(member)
+	}
+	return response, nil
+}
+
+// UpdateMember replaces the details of a team member
+func (s *TeamService) UpdateMember(ctx context.Context, id string, req *dto.UpdateTeamMemberRequest) (*dto.TeamMemberResponse, error) {
+	member, err := s.getMember(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	sortOrder := member.SortOrder
+	if err := applyTeamMemberRequest(member, req); err != nil {
+		return nil, err
+	}
+	if member.SortOrder == 0 {
+		member.SortOrder = sortOrder
+	}
+	if req.Slug != "" && req.Slug != member.Slug {
+		if err := s.assignSlug(ctx, member, req.Slug); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.memberRepo.Update(ctx, member); err != nil {
+		s.logger.Error("Failed to update team member", zap.String("id", id), zap.Error(err))
+		return nil, domainErrors.ErrDatabaseQuery
+	}
+
+	s.logger.Info("Team member updated", zap.String("id", id))
+	return dto.ToTeamMemberResponse(member), nil
+}
+
+// ReorderMembers sets the display order to the order of the given IDs. Members not
+// listed keep their sort order.
+func (s *TeamService) ReorderMembers(ctx context.Context, req *dto.ReorderRequest) (*dto.ListTeamMembersResponse, error) {
+	if err := checkReorder(req.IDs); err != nil {
+		return nil, err
+	}
+
+	if err := s.memberRepo.Reorder(ctx, req.IDs); err != nil {
+		if err == domainErrors.ErrTeamMemberNotFound {
+			return nil, err
+		}
+		s.logger.Error("Failed to reorder team members", zap.Error(err))
+		return nil, domainErrors.ErrDatabaseQuery
+	}
+
+	s.logger.Info("Team members reordered", zap.Int("count", len(req.IDs)))
+	return s.ListMembers(ctx, "", true)
+}
+
+// UploadPhoto stores a portrait in the standard widths and replaces the member's previous photo
+func (s *TeamService) UploadPhoto(ctx context.Context, id string, content []byte) (*dto.TeamMemberResponse, error) {
+	member, err := s.getMember(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	photo, err := storeImage(ctx, s.imageStorage, "team_"+member.Slug, content, s.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	previous := member.Photo
+	member.Photo = photo
+	if err := s.memberRepo.Update(ctx, member); err != nil {
+		s.logger.Error("Failed to update team member photo", zap.String("id", id), zap.Error(err))
+		deleteImage(ctx, s.imageStorage, photo, s.logger)
+		return nil, domainErrors.ErrDatabaseQuery
+	}
+	deleteImage(ctx, s.imageStorage, previous, s.logger)
+
+	s.logger.Info("Team member photo uploaded", zap.String("id", id), zap.Int("variants", len(photo)))
+	return dto.ToTeamMemberResponse(member), nil
+}
+
+// DeletePhoto removes a member's photo
+func (s *TeamService) DeletePhoto(ctx context.Context, id string) (*dto.TeamMemberResponse, error) {
+	member, err := s.getMember(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	previous := member.Photo
+	member.Photo = entities.ImageVariants{}
+	if err := s.memberRepo.Update(ctx, member); err != nil {
+		s.logger.Error("Failed to remove team member photo", zap.String("id", id), zap.Error(err))
+		return nil, domainErrors.ErrDatabaseQuery
+	}
+	deleteImage(ctx, s.imageStorage, previous, s.logger)
+
+	return dto.ToTeamMemberResponse(member), nil
+}
+
+// DeleteMember deletes a team member and their photo
+func (s *TeamService) DeleteMember(ctx context.Context, id string) error {
+	member, err := s.getMember(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := s.memberRepo.Delete(ctx, id); err != nil {
+		if err == domainErrors.ErrTeamMemberNotFound {
+			return err
+		}
+		s.logger.Error("Failed to delete team member", zap.String("id", id), zap.Error(err))
+		return domainErrors.ErrDatabaseQuery
+	}
+	deleteImage(ctx, s.imageStorage, member.Photo, s.logger)
+
+	s.logger.Info("Team member deleted", zap.String("id", id))
+	return nil
+}
+
+func (s *TeamService) getMember(ctx context.Context, id string) (*entities.TeamMember, error) {
+	member, err := s.memberRepo.GetByID(ctx, id)
+	if err != nil {
+		if err == domainErrors.ErrTeamMemberNotFound {
+			return nil, err
+		}
+		s.logger.Error("Failed to get team member", zap.String("id", id), zap.Error(err))
+		return nil, domainErrors.ErrDatabaseQuery
+	}
+	return member, nil
+}
+
+// assignSlug sets the member's slug from requested, or from the name, if no other member uses it
+func (s *TeamService) assignSlug(ctx context.Context, member *entities.TeamMember, requested string) error {
+	slug, err := showcaseSlug(requested, member.Name)
+	if err != nil {
+		return err
+	}
+
+	taken, err := s.memberRepo.SlugExists(ctx, slug, member.ID)
+	if err != nil {
+		s.logger.Error("Failed to check team member slug", zap.Error(err))
+		return domainErrors.ErrDatabaseQuery
+	}
+	if taken {
+		return domainErrors.ErrTeamMemberSlugTaken
+	}
+
+	member.Slug = slug
+	return nil
+}
+
+// applyTeamMemberRequest validates a create or update request and copies it onto member
+func applyTeamMemberRequest(member *entities.TeamMember, req *dto.CreateTeamMemberRequest) error {
+	name := strings.TrimSpace(req.Name)
+	title := strings.TrimSpace(req.Title)
+	email := strings.TrimSpace(req.Email)
+
+	switch {
+	case utf8.RuneCountInString(name) < 2 || utf8.RuneCountInString(name) > 100:
+		return fmt.Errorf("%w: name must be between 2 and 100 characters", domainErrors.ErrValidationFailed)
+	case title == "" || utf8.RuneCountInString(title) > 100:
+		return fmt.Errorf("%w: title is required and must be at most 100 characters", domainErrors.ErrValidationFailed)
+	case !req.Role.IsValid():
+		return fmt.Errorf("%w: role must be leadership, tech or client", domainErrors.ErrValidationFailed)
+	case utf8.RuneCountInString(req.Bio) > 2000:
+		return fmt.Errorf("%w: bio must be at most 2000 characters", domainErrors.ErrValidationFailed)
+	case utf8.RuneCountInString(req.Quote) > 500:
+		return fmt.Errorf("%w: quote must be at most 500 characters", domainErrors.ErrValidationFailed)
+	case utf8.RuneCountInString(req.Location) > 100 || utf8.RuneCountInString(req.Country) > 100:
+		return fmt.Errorf("%w: location and country must be at most 100 characters", domainErrors.ErrValidationFailed)
+	case utf8.RuneCountInString(req.Experience) > 50:
+		return fmt.Errorf("%w: experience must be at most 50 characters", domainErrors.ErrValidationFailed)
+	case req.SortOrder < 0:
+		return fmt.Errorf("%w: sort_order must not be negative", domainErrors.ErrValidationFailed)
+	}
+	if email != "" {
+		if _, err := mail.ParseAddress(email); err != nil {
+			return fmt.Errorf("%w: email is not a valid address", domainErrors.ErrValidationFailed)
+		}
+	}
+	if err := checkWebLink("linkedin_url", strings.TrimSpace(req.LinkedInURL)); err != nil {
+		return err
+	}
+	if err := checkWebLink("github_url", strings.TrimSpace(req.GitHubURL)); err != nil {
+		return err
+	}
+	if err := checkWebLink("twitter_url", strings.TrimSpace(req.TwitterURL)); err != nil {
+		return err
+	}
+	skills, err := cleanList("skills", req.Skills)
+	if err != nil {
+		return err
+	}
+
+	member.Name = name
+	member.Title = title
+	member.Role = req.Role
+	member.Location = strings.TrimSpace(req.Location)
+	member.Country = strings.TrimSpace(req.Country)
+	member.Bio = strings.TrimSpace(req.Bio)
+	member.Quote = strings.TrimSpace(req.Quote)
+	member.Skills = skills
+	member.Experience = strings.TrimSpace(req.Experience)
+	member.LinkedInURL = strings.TrimSpace(req.LinkedInURL)
+	member.GitHubURL = strings.TrimSpace(req.GitHubURL)
+	member.TwitterURL = strings.TrimSpace(req.TwitterURL)
+	member.Email = email
+	member.SortOrder = req.SortOrder
+	member.Published = req.Published
+	return nil
+}
@@ -0,0 +1,83 @@
+package services
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	domainErrors "super2025-backend/internal/domain/errors"
+)
+
+// generateToken returns a random URL-safe token for emailed links and the hash
+// that is stored in its place, so a database leak does not expose usable links
+func generateToken() (token, tokenHash string, err error) {
+	raw := make([]byte, 32)
+	if _, err := rand.Read(raw); err != nil {
+		return "", "", fmt.Errorf("failed to generate token: %w", err)
+	}
+	token = base64.RawURLEncoding.EncodeToString(raw)
+	return token, hashToken(token), nil
+}
+
+// hashToken returns the stored form of a token
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// signLink returns a link token of the form "<id>.<signature>". The purpose is
+// part of the signature so a token issued for one kind of link cannot be used for another.
+func signLink(secret []byte, purpose, id string) string {
+	return id + "." + linkSignature(secret, purpose, id)
+}
+
+// verifyLink checks a token produced by signLink for purpose and returns its ID
+func verifyLink(secret []byte, purpose, token string) (string, bool) {
+	dot := strings.LastIndex(token, ".")
+	if dot <= 0 {
+		return "", false
+	}
+	id, signature := token[:dot], token[dot+1:]
+	if !hmac.Equal([]byte(signature), []byte(linkSignature(secret, purpose, id))) {
+		return "", false
+	}
+	return id, true
+}
+
+// signExpiringLink returns a signed link token of the form "<id>.<unix expiry>.<signature>"
+func signExpiringLink(secret []byte, purpose, id string, expiresAt time.Time) string {
+	return signLink(secret, purpose, id+"."+strconv.FormatInt(expiresAt.Unix(), 10))
+}
+
+// verifyExpiringLink checks a token produced by signExpiringLink and returns its ID.
+// It returns ErrInvalidLink for forged tokens and ErrLinkExpired once the expiry has passed.
+func verifyExpiringLink(secret []byte, purpose, token string, now time.Time) (string, error) {
+	payload, ok := verifyLink(secret, purpose, token)
+	if !ok {
+		return "", domainErrors.ErrInvalidLink
+	}
+	dot := strings.LastIndex(payload, ".")
+	if dot <= 0 {
+		return "", domainErrors.ErrInvalidLink
+	}
+	expiresAt, err := strconv.ParseInt(payload[dot+1:], 10, 64)
+	if err != nil {
+		return "", domainErrors.ErrInvalidLink
+	}
+	if !now.Before(time.Unix(expiresAt, 0)) {
+		return "", domainErrors.ErrLinkExpired
+	}
+	return payload[:dot], nil
+}
+
+func linkSignature(secret []byte, purpose, id string) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(purpose + ":" + id))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
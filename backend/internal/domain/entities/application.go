@@ -1,8 +1,11 @@
 package entities
 
 import (
+	"fmt"
 	"time"
 
+	domainErrors "super2025-backend/internal/domain/errors"
+
 	"github.com/google/uuid"
 	"gorm.io/gorm"
 )
@@ -15,14 +18,86 @@ const (
 	StatusReviewing ApplicationStatus = "reviewing"
 	StatusInterview ApplicationStatus = "interview"
 	StatusOffered   ApplicationStatus = "offered"
+	StatusHired     ApplicationStatus = "hired"
 	StatusRejected  ApplicationStatus = "rejected"
 	StatusWithdrawn ApplicationStatus = "withdrawn"
 )
 
+// statusTransitions declares the transition graph for application statuses.
+// Hired, rejected and withdrawn are terminal and can only be left via an admin override.
+var statusTransitions = map[ApplicationStatus][]ApplicationStatus{
+	StatusPending:   {StatusReviewing, StatusRejected, StatusWithdrawn},
+	StatusReviewing: {StatusInterview, StatusRejected, StatusWithdrawn},
+	StatusInterview: {StatusOffered, StatusRejected, StatusWithdrawn},
+	StatusOffered:   {StatusHired, StatusRejected, StatusWithdrawn},
+	StatusHired:     {},
+	StatusRejected:  {},
+	StatusWithdrawn: {},
+}
+
+// AllStatuses returns every known application status in pipeline order
+func AllStatuses() []ApplicationStatus {
+	return []ApplicationStatus{
+		StatusPending,
+		StatusReviewing,
+		StatusInterview,
+		StatusOffered,
+		StatusHired,
+		StatusRejected,
+		StatusWithdrawn,
+	}
+}
+
+// IsValid reports whether the status is a known application status
+func (s ApplicationStatus) IsValid() bool {
+	_, ok := statusTransitions[s]
+	return ok
+}
+
+// IsTerminal reports whether no further transitions are allowed without an override
+func (s ApplicationStatus) IsTerminal() bool {
+	return s.IsValid() && len(statusTransitions[s]) == 0
+}
+
+// AllowedTransitions returns the statuses reachable from s without an override
+func (s ApplicationStatus) AllowedTransitions() []ApplicationStatus {
+	allowed := make([]ApplicationStatus, len(statusTransitions[s]))
+	copy(allowed, statusTransitions[s])
+	return allowed
+}
+
+// CanTransitionTo reports whether s may move to next without an override
+func (s ApplicationStatus) CanTransitionTo(next ApplicationStatus) bool {
+	for _, allowed := range statusTransitions[s] {
+		if allowed == next {
+			return true
+		}
+	}
+	return false
+}
+
+// StatusTransitionError describes a refused status change
+type StatusTransitionError struct {
+	From    ApplicationStatus
+	To      ApplicationStatus
+	Allowed []ApplicationStatus
+}
+
+// Error implements the error interface
+func (e *StatusTransitionError) Error() string {
+	return fmt.Sprintf("invalid status transition from %q to %q", e.From, e.To)
+}
+
+// Unwrap allows errors.Is to match ErrInvalidStatusTransition
+func (e *StatusTransitionError) Unwrap() error {
+	return domainErrors.ErrInvalidStatusTransition
+}
+
 // Application represents a job application in the domain
 type Application struct {
 	ID          string            `json:"id" gorm:"type:varchar(50);primaryKey"`
 	PositionID  string            `json:"position_id" gorm:"not null"`
+	CandidateID *string           `json:"candidate_id,omitempty" gorm:"type:varchar(50);index"`
 	Name        string            `json:"name" gorm:"not null"`
 	Email       string            `json:"email" gorm:"not null"`
 	Phone       string            `json:"phone"`
@@ -38,10 +113,25 @@ type Application struct {
 	UserAgent   string `json:"user_agent,omitempty"`
 	Source      string `json:"source,omitempty" gorm:"default:website"`
 	
+	// Referral code the candidate applied with, if any
+	ReferralCodeID *string `json:"referral_code_id,omitempty" gorm:"type:varchar(50);index"`
+
+	// Campaign and referrer details captured when the candidate applied
+	Attribution *ApplicationAttribution `json:"attribution,omitempty" gorm:"foreignKey:ApplicationID"`
+
+	// Answers to the position's custom questions
+	Answers []ApplicationAnswer `json:"answers,omitempty" gorm:"foreignKey:ApplicationID"`
+
 	// Processing info
 	ProcessedAt *time.Time `json:"processed_at,omitempty"`
 	ProcessedBy string     `json:"processed_by,omitempty"`
 	Notes       string     `json:"notes,omitempty" gorm:"type:text"`
+
+	// Set when the candidate's personal data was erased; the application is kept anonymized for reporting
+	ErasedAt *time.Time `json:"erased_at,omitempty" gorm:"index"`
+
+	// Recruiter labels, managed through the tag repository
+	Tags []Tag `json:"tags,omitempty" gorm:"many2many:application_tags;"`
 }
 
 // BeforeCreate sets the ID if not already set
@@ -52,14 +142,41 @@ func (a *Application) BeforeCreate(tx *gorm.DB) error {
 	return nil
 }
 
-// UpdateStatus updates the application status (simplified version)
+// UpdateStatus moves the application to newStatus if the transition graph allows it
 func (a *Application) UpdateStatus(newStatus ApplicationStatus, processedBy string) error {
+	if !a.Status.CanTransitionTo(newStatus) {
+		return a.transitionError(newStatus)
+	}
+	a.setStatus(newStatus, processedBy)
+	return nil
+}
+
+// OverrideStatus moves the application to any other valid status, including
+// out of a terminal state. Only admins may request an override.
+func (a *Application) OverrideStatus(newStatus ApplicationStatus, processedBy string) error {
+	if !newStatus.IsValid() || newStatus == a.Status {
+		return a.transitionError(newStatus)
+	}
+	a.setStatus(newStatus, processedBy)
+	return nil
+}
+
+// transitionError builds the error returned when a transition is refused
+func (a *Application) transitionError(newStatus ApplicationStatus) error {
+	return &StatusTransitionError{
+		From:    a.Status,
+		To:      newStatus,
+		Allowed: a.Status.AllowedTransitions(),
+	}
+}
+
+// setStatus records the new status and who processed it
+func (a *Application) setStatus(newStatus ApplicationStatus, processedBy string) {
 	a.Status = newStatus
 	now := time.Now()
 	a.ProcessedAt = &now
 	a.ProcessedBy = processedBy
 	a.UpdatedAt = now
-	return nil
 }
 
 // TableName returns the table name for GORM
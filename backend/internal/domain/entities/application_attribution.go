@@ -0,0 +1,26 @@
+package entities
+
+import "time"
+
+// ApplicationSourceDirect is the source recorded when there is no campaign or external referrer to attribute to
+const ApplicationSourceDirect = "direct"
+
+// ApplicationAttribution records where a candidate came from when they applied:
+// the UTM parameters of the link they followed, the referring page and the
+// careers page they landed on.
+type ApplicationAttribution struct {
+	ApplicationID string    `json:"application_id" gorm:"type:varchar(50);primaryKey"`
+	UTMSource     string    `json:"utm_source,omitempty" gorm:"type:varchar(100);index"`
+	UTMMedium     string    `json:"utm_medium,omitempty" gorm:"type:varchar(100)"`
+	UTMCampaign   string    `json:"utm_campaign,omitempty" gorm:"type:varchar(100)"`
+	UTMTerm       string    `json:"utm_term,omitempty" gorm:"type:varchar(100)"`
+	UTMContent    string    `json:"utm_content,omitempty" gorm:"type:varchar(100)"`
+	Referrer      string    `json:"referrer,omitempty" gorm:"type:varchar(500)"`
+	LandingPage   string    `json:"landing_page,omitempty" gorm:"type:varchar(500)"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+// TableName returns the table name for GORM
+func (ApplicationAttribution) TableName() string {
+	return "application_attributions"
+}
@@ -0,0 +1,67 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// CommentVisibility controls who can read a reviewer comment
+type CommentVisibility string
+
+const (
+	// VisibilityPrivate comments are only visible to their author
+	VisibilityPrivate CommentVisibility = "private"
+	// VisibilityTeam comments are visible to every reviewer
+	VisibilityTeam CommentVisibility = "team"
+)
+
+// IsValid reports whether the visibility is known
+func (v CommentVisibility) IsValid() bool {
+	return v == VisibilityPrivate || v == VisibilityTeam
+}
+
+// ApplicationComment represents a reviewer comment on an application.
+// Comments with a ParentID are replies within a thread.
+type ApplicationComment struct {
+	ID            string            `json:"id" gorm:"type:varchar(50);primaryKey"`
+	ApplicationID string            `json:"application_id" gorm:"type:varchar(50);not null;index"`
+	ParentID      *string           `json:"parent_id,omitempty" gorm:"type:varchar(50);index"`
+	Author        string            `json:"author" gorm:"not null"`
+	Body          string            `json:"body" gorm:"type:text;not null"`
+	Visibility    CommentVisibility `json:"visibility" gorm:"default:team"`
+	CreatedAt     time.Time         `json:"created_at"`
+	UpdatedAt     time.Time         `json:"updated_at"`
+	EditedAt      *time.Time        `json:"edited_at,omitempty"`
+	DeletedAt     gorm.DeletedAt    `json:"-" gorm:"index"`
+}
+
+// BeforeCreate sets the ID if not already set
+func (c *ApplicationComment) BeforeCreate(tx *gorm.DB) error {
+	if c.ID == "" {
+		c.ID = uuid.New().String()
+	}
+	return nil
+}
+
+// VisibleTo reports whether the given reviewer may read the comment
+func (c *ApplicationComment) VisibleTo(viewer string) bool {
+	return c.Visibility == VisibilityTeam || c.Author == viewer
+}
+
+// Edit replaces the comment body and records when it was edited
+func (c *ApplicationComment) Edit(body string, visibility CommentVisibility) {
+	now := time.Now()
+	c.Body = body
+	if visibility != "" {
+		c.Visibility = visibility
+	}
+	c.EditedAt = &now
+	c.UpdatedAt = now
+}
+
+// TableName returns the table name for GORM
+func (ApplicationComment) TableName() string {
+	return "application_comments"
+}
@@ -0,0 +1,76 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// ApplicationStatusEvent records a single status change of an application
+type ApplicationStatusEvent struct {
+	ID            string            `json:"id" gorm:"type:varchar(50);primaryKey"`
+	ApplicationID string            `json:"application_id" gorm:"type:varchar(50);not null;index"`
+	FromStatus    ApplicationStatus `json:"from_status,omitempty"`
+	ToStatus      ApplicationStatus `json:"to_status" gorm:"not null"`
+	Actor         string            `json:"actor"`
+	Notes         string            `json:"notes,omitempty" gorm:"type:text"`
+	Override      bool              `json:"override"`
+	CreatedAt     time.Time         `json:"created_at" gorm:"index"`
+}
+
+// StageDuration describes how long an application spent in one status
+type StageDuration struct {
+	Status    ApplicationStatus `json:"status"`
+	EnteredAt time.Time         `json:"entered_at"`
+	ExitedAt  *time.Time        `json:"exited_at,omitempty"`
+	Duration  time.Duration     `json:"-"`
+}
+
+// NewStatusEvent creates a status event for a change made to the application
+func NewStatusEvent(applicationID string, from, to ApplicationStatus, actor, notes string, override bool) *ApplicationStatusEvent {
+	return &ApplicationStatusEvent{
+		ID:            uuid.New().String(),
+		ApplicationID: applicationID,
+		FromStatus:    from,
+		ToStatus:      to,
+		Actor:         actor,
+		Notes:         notes,
+		Override:      override,
+		CreatedAt:     time.Now(),
+	}
+}
+
+// BeforeCreate sets the ID if not already set
+func (e *ApplicationStatusEvent) BeforeCreate(tx *gorm.DB) error {
+	if e.ID == "" {
+		e.ID = uuid.New().String()
+	}
+	return nil
+}
+
+// TableName returns the table name for GORM
+func (ApplicationStatusEvent) TableName() string {
+	return "application_status_events"
+}
+
+// StageDurations derives time spent in each status from chronologically
+// ordered events. The last stage is still open and is measured up to now.
+func StageDurations(events []*ApplicationStatusEvent, now time.Time) []StageDuration {
+	stages := make([]StageDuration, 0, len(events))
+	for i, event := range events {
+		stage := StageDuration{
+			Status:    event.ToStatus,
+			EnteredAt: event.CreatedAt,
+		}
+		end := now
+		if i+1 < len(events) {
+			exitedAt := events[i+1].CreatedAt
+			stage.ExitedAt = &exitedAt
+			end = exitedAt
+		}
+		stage.Duration = end.Sub(stage.EnteredAt)
+		stages = append(stages, stage)
+	}
+	return stages
+}
@@ -0,0 +1,84 @@
+package entities
+
+import (
+	"testing"
+	"time"
+)
+
+func TestStageDurations(t *testing.T) {
+	start := time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC)
+	now := start.Add(100 * time.Hour)
+	event := func(status ApplicationStatus, after time.Duration) *ApplicationStatusEvent {
+		return &ApplicationStatusEvent{ToStatus: status, CreatedAt: start.Add(after)}
+	}
+	type stage struct {
+		status   ApplicationStatus
+		duration time.Duration
+		open     bool
+	}
+
+	tests := []struct {
+		name   string
+		events []*ApplicationStatusEvent
+		want   []stage
+	}{
+		{
+			name:   "no events",
+			events: nil,
+			want:   []stage{},
+		},
+		{
+			name:   "single open stage",
+			events: []*ApplicationStatusEvent{event(StatusPending, 0)},
+			want:   []stage{{StatusPending, 100 * time.Hour, true}},
+		},
+		{
+			name: "closed stages then an open one",
+			events: []*ApplicationStatusEvent{
+				event(StatusPending, 0),
+				event(StatusReviewing, 24*time.Hour),
+				event(StatusInterview, 72*time.Hour),
+			},
+			want: []stage{
+				{StatusPending, 24 * time.Hour, false},
+				{StatusReviewing, 48 * time.Hour, false},
+				{StatusInterview, 28 * time.Hour, true},
+			},
+		},
+		{
+			name: "status entered twice",
+			events: []*ApplicationStatusEvent{
+				event(StatusRejected, 0),
+				event(StatusReviewing, time.Hour),
+				event(StatusRejected, 3*time.Hour),
+			},
+			want: []stage{
+				{StatusRejected, time.Hour, false},
+				{StatusReviewing, 2 * time.Hour, false},
+				{StatusRejected, 97 * time.Hour, true},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := StageDurations(tt.events, now)
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %d stages, want %d", len(got), len(tt.want))
+			}
+			for i, want := range tt.want {
+				if got[i].Status != want.status || got[i].Duration != want.duration {
+					t.Errorf("stage %d = %s for %v, want %s for %v", i, got[i].Status, got[i].Duration, want.status, want.duration)
+				}
+				if !got[i].EnteredAt.Equal(tt.events[i].CreatedAt) {
+					t.Errorf("stage %d EnteredAt = %v, want %v", i, got[i].EnteredAt, tt.events[i].CreatedAt)
+				}
+				if open := got[i].ExitedAt == nil; open != want.open {
+					t.Errorf("stage %d open = %v, want %v", i, open, want.open)
+				} else if !open && !got[i].ExitedAt.Equal(tt.events[i+1].CreatedAt) {
+					t.Errorf("stage %d ExitedAt = %v, want %v", i, got[i].ExitedAt, tt.events[i+1].CreatedAt)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,172 @@
+package entities
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	domainErrors "super2025-backend/internal/domain/errors"
+)
+
+func TestStatusTransitions(t *testing.T) {
+	tests := []struct {
+		from     ApplicationStatus
+		allowed  []ApplicationStatus
+		terminal bool
+	}{
+		{StatusPending, []ApplicationStatus{StatusReviewing, StatusRejected, StatusWithdrawn}, false},
+		{StatusReviewing, []ApplicationStatus{StatusInterview, StatusRejected, StatusWithdrawn}, false},
+		{StatusInterview, []ApplicationStatus{StatusOffered, StatusRejected, StatusWithdrawn}, false},
+		{StatusOffered, []ApplicationStatus{StatusHired, StatusRejected, StatusWithdrawn}, false},
+		{StatusHired, []ApplicationStatus{}, true},
+		{StatusRejected, []ApplicationStatus{}, true},
+		{StatusWithdrawn, []ApplicationStatus{}, true},
+	}
+	if len(tests) != len(AllStatuses()) {
+		t.Fatalf("table covers %d statuses, want %d", len(tests), len(AllStatuses()))
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.from), func(t *testing.T) {
+			if !tt.from.IsValid() {
+				t.Fatalf("%q is not valid", tt.from)
+			}
+			if got := tt.from.IsTerminal(); got != tt.terminal {
+				t.Errorf("IsTerminal() = %v, want %v", got, tt.terminal)
+			}
+			if got := tt.from.AllowedTransitions(); !reflect.DeepEqual(got, tt.allowed) {
+				t.Errorf("AllowedTransitions() = %v, want %v", got, tt.allowed)
+			}
+			for _, next := range AllStatuses() {
+				want := false
+				for _, allowed := range tt.allowed {
+					want = want || allowed == next
+				}
+				if got := tt.from.CanTransitionTo(next); got != want {
+					t.Errorf("CanTransitionTo(%q) = %v, want %v", next, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestUnknownStatus(t *testing.T) {
+	status := ApplicationStatus("archived")
+	if status.IsValid() {
+		t.Error("IsValid() = true, want false")
+	}
+	if status.IsTerminal() {
+		t.Error("IsTerminal() = true, want false")
+	}
+	if status.CanTransitionTo(StatusPending) {
+		t.Error("CanTransitionTo(pending) = true, want false")
+	}
+}
+
+func TestAllowedTransitionsReturnsCopy(t *testing.T) {
+	allowed := StatusPending.AllowedTransitions()
+	allowed[0] = StatusHired
+	if StatusPending.CanTransitionTo(StatusHired) {
+		t.Fatal("changing the returned slice changed the transition graph")
+	}
+}
+
+func TestApplicationUpdateStatus(t *testing.T) {
+	tests := []struct {
+		name    string
+		from    ApplicationStatus
+		to      ApplicationStatus
+		wantErr bool
+	}{
+		{"forward", StatusPending, StatusReviewing, false},
+		{"reject", StatusInterview, StatusRejected, false},
+		{"withdraw", StatusOffered, StatusWithdrawn, false},
+		{"hire", StatusOffered, StatusHired, false},
+		{"skip a stage", StatusPending, StatusInterview, true},
+		{"backwards", StatusInterview, StatusReviewing, true},
+		{"same status", StatusReviewing, StatusReviewing, true},
+		{"out of terminal", StatusRejected, StatusReviewing, true},
+		{"unknown target", StatusPending, ApplicationStatus("archived"), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			application := &Application{Status: tt.from}
+			err := application.UpdateStatus(tt.to, "admin")
+
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("UpdateStatus() error = nil, want an error")
+				}
+				if application.Status != tt.from || application.ProcessedAt != nil || application.ProcessedBy != "" {
+					t.Errorf("refused change modified the application: %+v", application)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("UpdateStatus() error = %v", err)
+			}
+			if application.Status != tt.to {
+				t.Errorf("Status = %q, want %q", application.Status, tt.to)
+			}
+			if application.ProcessedAt == nil || application.ProcessedBy != "admin" {
+				t.Errorf("ProcessedAt = %v, ProcessedBy = %q, want them set", application.ProcessedAt, application.ProcessedBy)
+			}
+		})
+	}
+}
+
+func TestApplicationOverrideStatus(t *testing.T) {
+	tests := []struct {
+		name    string
+		from    ApplicationStatus
+		to      ApplicationStatus
+		wantErr bool
+	}{
+		{"out of terminal", StatusRejected, StatusReviewing, false},
+		{"skip a stage", StatusPending, StatusOffered, false},
+		{"same status", StatusHired, StatusHired, true},
+		{"unknown target", StatusPending, ApplicationStatus("archived"), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			application := &Application{Status: tt.from}
+			err := application.OverrideStatus(tt.to, "admin")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("OverrideStatus() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			want := tt.to
+			if tt.wantErr {
+				want = tt.from
+			}
+			if application.Status != want {
+				t.Errorf("Status = %q, want %q", application.Status, want)
+			}
+		})
+	}
+}
+
+func TestStatusTransitionError(t *testing.T) {
+	application := &Application{Status: StatusReviewing}
+	err := application.UpdateStatus(StatusHired, "admin")
+
+	if !errors.Is(err, domainErrors.ErrInvalidStatusTransition) {
+		t.Fatalf("errors.Is(%v, ErrInvalidStatusTransition) = false", err)
+	}
+	var transitionErr *StatusTransitionError
+	if !errors.As(err, &transitionErr) {
+		t.Fatalf("errors.As(%v, *StatusTransitionError) = false", err)
+	}
+	want := &StatusTransitionError{
+		From:    StatusReviewing,
+		To:      StatusHired,
+		Allowed: []ApplicationStatus{StatusInterview, StatusRejected, StatusWithdrawn},
+	}
+	if !reflect.DeepEqual(transitionErr, want) {
+		t.Errorf("error = %+v, want %+v", transitionErr, want)
+	}
+	if got := err.Error(); got != `invalid status transition from "reviewing" to "hired"` {
+		t.Errorf("Error() = %q", got)
+	}
+}
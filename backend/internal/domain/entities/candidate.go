@@ -0,0 +1,39 @@
+package entities
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Candidate represents a person who has applied, identified by normalized email
+type Candidate struct {
+	ID        string         `json:"id" gorm:"type:varchar(50);primaryKey"`
+	Email     string         `json:"email" gorm:"not null;uniqueIndex"`
+	Name      string         `json:"name" gorm:"not null"`
+	Phone     string         `json:"phone"`
+	CreatedAt time.Time      `json:"created_at"`
+	UpdatedAt time.Time      `json:"updated_at"`
+	DeletedAt gorm.DeletedAt `json:"-" gorm:"index"`
+}
+
+// NormalizeEmail returns the canonical form of an email address used to match candidates
+func NormalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+// BeforeCreate sets the ID if not already set and normalizes the email
+func (c *Candidate) BeforeCreate(tx *gorm.DB) error {
+	if c.ID == "" {
+		c.ID = uuid.New().String()
+	}
+	c.Email = NormalizeEmail(c.Email)
+	return nil
+}
+
+// TableName returns the table name for GORM
+func (Candidate) TableName() string {
+	return "candidates"
+}
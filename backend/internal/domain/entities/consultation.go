@@ -0,0 +1,176 @@
+package entities
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// clockFormat is the HH:MM form of availability window boundaries
+const clockFormat = "15:04"
+
+// AvailabilityWindow is a recurring weekly period in which consultations can be booked,
+// e.g. Monday 09:00-12:00 in the availability's time zone
+type AvailabilityWindow struct {
+	Day   string `json:"day"`
+	Start string `json:"start"`
+	End   string `json:"end"`
+}
+
+// Weekday returns the window's day of the week
+func (w AvailabilityWindow) Weekday() (time.Weekday, bool) {
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		if strings.EqualFold(w.Day, day.String()) {
+			return day, true
+		}
+	}
+	return 0, false
+}
+
+// Bounds returns the window's start and end as offsets from midnight
+func (w AvailabilityWindow) Bounds() (start, end time.Duration, err error) {
+	startClock, err := time.Parse(clockFormat, w.Start)
+	if err != nil {
+		return 0, 0, fmt.Errorf("start must be HH:MM")
+	}
+	endClock, err := time.Parse(clockFormat, w.End)
+	if err != nil {
+		return 0, 0, fmt.Errorf("end must be HH:MM")
+	}
+	midnight := time.Date(0, 1, 1, 0, 0, 0, 0, time.UTC)
+	return startClock.Sub(midnight), endClock.Sub(midnight), nil
+}
+
+// AvailabilityWindows is a list of weekly windows stored as a JSON array column
+type AvailabilityWindows []AvailabilityWindow
+
+// Value implements driver.Valuer
+func (w AvailabilityWindows) Value() (driver.Value, error) {
+	if w == nil {
+		return "[]", nil
+	}
+	data, err := json.Marshal([]AvailabilityWindow(w))
+	if err != nil {
+		return nil, err
+	}
+	return string(data), nil
+}
+
+// Scan implements sql.Scanner
+func (w *AvailabilityWindows) Scan(value interface{}) error {
+	var data []byte
+	switch v := value.(type) {
+	case nil:
+		*w = AvailabilityWindows{}
+		return nil
+	case []byte:
+		data = v
+	case string:
+		data = []byte(v)
+	default:
+		return fmt.Errorf("cannot scan %T into AvailabilityWindows", value)
+	}
+	return json.Unmarshal(data, (*[]AvailabilityWindow)(w))
+}
+
+// ConsultationAvailability configures when visitors can book a consultation.
+// There is a single row; windows are interpreted in Timezone.
+type ConsultationAvailability struct {
+	ID             int                 `json:"-" gorm:"primaryKey"`
+	Timezone       string              `json:"timezone" gorm:"type:varchar(64);not null"`
+	SlotMinutes    int                 `json:"slot_minutes" gorm:"not null"`
+	MinNoticeHours int                 `json:"min_notice_hours" gorm:"not null"`
+	MaxDaysAhead   int                 `json:"max_days_ahead" gorm:"not null"`
+	Windows        AvailabilityWindows `json:"windows" gorm:"type:jsonb;not null"`
+	HostName       string              `json:"host_name"`
+	HostEmail      string              `json:"host_email"`
+	UpdatedBy      string              `json:"updated_by,omitempty"`
+	UpdatedAt      time.Time           `json:"updated_at"`
+}
+
+// TableName returns the table name for GORM
+func (ConsultationAvailability) TableName() string {
+	return "consultation_availability"
+}
+
+// TimeSlot is a bookable period
+type TimeSlot struct {
+	StartAt time.Time
+	EndAt   time.Time
+}
+
+// Overlaps reports whether the slot overlaps the period [start, end)
+func (s TimeSlot) Overlaps(start, end time.Time) bool {
+	return s.StartAt.Before(end) && start.Before(s.EndAt)
+}
+
+// Slots returns every slot of the weekly windows that starts in [from, to), in order.
+// Slots are laid out back to back from each window's start; a remainder shorter
+// than a slot at the end of a window is not offered.
+func (a *ConsultationAvailability) Slots(from, to time.Time) []TimeSlot {
+	loc, err := time.LoadLocation(a.Timezone)
+	if err != nil || a.SlotMinutes <= 0 {
+		return nil
+	}
+	length := time.Duration(a.SlotMinutes) * time.Minute
+
+	var slots []TimeSlot
+	first := from.In(loc)
+	for day := time.Date(first.Year(), first.Month(), first.Day(), 0, 0, 0, 0, loc); day.Before(to); day = day.AddDate(0, 0, 1) {
+		for _, window := range a.Windows {
+			weekday, ok := window.Weekday()
+			if !ok || weekday != day.Weekday() {
+				continue
+			}
+			startOffset, endOffset, err := window.Bounds()
+			if err != nil {
+				continue
+			}
+			// Build wall-clock times so windows stay put across DST changes
+			windowEnd := wallClock(day, endOffset, loc)
+			for start := wallClock(day, startOffset, loc); !start.Add(length).After(windowEnd); start = start.Add(length) {
+				if !start.Before(from) && start.Before(to) {
+					slots = append(slots, TimeSlot{StartAt: start, EndAt: start.Add(length)})
+				}
+			}
+		}
+	}
+
+	sort.Slice(slots, func(i, j int) bool { return slots[i].StartAt.Before(slots[j].StartAt) })
+	return slots
+}
+
+func wallClock(day time.Time, offset time.Duration, loc *time.Location) time.Time {
+	return time.Date(day.Year(), day.Month(), day.Day(), int(offset.Hours()), int(offset.Minutes())%60, 0, 0, loc)
+}
+
+// Consultation is a meeting booked by a website visitor
+type Consultation struct {
+	ID        string    `json:"id" gorm:"type:varchar(50);primaryKey"`
+	Name      string    `json:"name" gorm:"not null"`
+	Email     string    `json:"email" gorm:"not null;index"`
+	Topic     string    `json:"topic" gorm:"type:text"`
+	StartAt   time.Time `json:"start_at" gorm:"not null;index"`
+	EndAt     time.Time `json:"end_at" gorm:"not null"`
+	Timezone  string    `json:"timezone" gorm:"type:varchar(64);not null"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// BeforeCreate sets the ID if not already set
+func (c *Consultation) BeforeCreate(tx *gorm.DB) error {
+	if c.ID == "" {
+		c.ID = uuid.New().String()
+	}
+	return nil
+}
+
+// TableName returns the table name for GORM
+func (Consultation) TableName() string {
+	return "consultations"
+}
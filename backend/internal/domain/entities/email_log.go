@@ -0,0 +1,41 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// EmailLogStatus records whether an outgoing email was handed to the mail server
+type EmailLogStatus string
+
+const (
+	EmailLogSent   EmailLogStatus = "sent"
+	EmailLogFailed EmailLogStatus = "failed"
+)
+
+// EmailLog records an email sent by the system. Only the recipient and subject
+// are kept, not the body.
+type EmailLog struct {
+	ID        string         `json:"id" gorm:"type:varchar(50);primaryKey"`
+	Recipient string         `json:"recipient" gorm:"type:varchar(255);not null;index"`
+	Subject   string         `json:"subject" gorm:"type:text;not null"`
+	Status    EmailLogStatus `json:"status" gorm:"type:varchar(20);not null"`
+	Error     string         `json:"error,omitempty" gorm:"type:text"`
+	CreatedAt time.Time      `json:"created_at" gorm:"index"`
+}
+
+// BeforeCreate sets the ID if not already set and normalizes the recipient
+func (l *EmailLog) BeforeCreate(tx *gorm.DB) error {
+	if l.ID == "" {
+		l.ID = uuid.New().String()
+	}
+	l.Recipient = NormalizeEmail(l.Recipient)
+	return nil
+}
+
+// TableName returns the table name for GORM
+func (EmailLog) TableName() string {
+	return "email_logs"
+}
@@ -0,0 +1,122 @@
+package entities
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+const (
+	// ErasedName replaces the name on erased applications
+	ErasedName = "[erased]"
+	// ErasedEmailDomain is the domain of the placeholder addresses erased applications
+	// are given, which keeps emails unique per position. The .invalid TLD never resolves.
+	ErasedEmailDomain = "erased.invalid"
+)
+
+// ErasureReceipt records that the personal data about a person was erased. It
+// holds no personal data itself: the person is identified by a hash of their
+// email. Receipts form a hash chain, each one covering the hash of the one before,
+// so editing or removing a receipt breaks every hash after it.
+type ErasureReceipt struct {
+	ID             string     `json:"id" gorm:"type:varchar(50);primaryKey"`
+	Sequence       int64      `json:"sequence" gorm:"not null;uniqueIndex"`
+	SubjectHash    string     `json:"subject_hash" gorm:"type:varchar(64);not null;index"`
+	ApplicationIDs StringList `json:"application_ids" gorm:"type:jsonb;not null"`
+
+	// What was erased
+	ApplicationsAnonymized int  `json:"applications_anonymized" gorm:"not null;default:0"`
+	AnswersDeleted         int  `json:"answers_deleted" gorm:"not null;default:0"`
+	CommentsDeleted        int  `json:"comments_deleted" gorm:"not null;default:0"`
+	EmailLogsDeleted       int  `json:"email_logs_deleted" gorm:"not null;default:0"`
+	ResumesDeleted         int  `json:"resumes_deleted" gorm:"not null;default:0"`
+	CandidateDeleted       bool `json:"candidate_deleted" gorm:"not null;default:false"`
+
+	Reason       string    `json:"reason,omitempty" gorm:"type:text"`
+	ErasedBy     string    `json:"erased_by" gorm:"not null"`
+	ErasedAt     time.Time `json:"erased_at" gorm:"not null"`
+	PreviousHash string    `json:"previous_hash" gorm:"type:varchar(64);not null"`
+	Hash         string    `json:"hash" gorm:"type:varchar(64);not null;uniqueIndex"`
+}
+
+// BeforeCreate sets the ID if not already set
+func (r *ErasureReceipt) BeforeCreate(tx *gorm.DB) error {
+	if r.ID == "" {
+		r.ID = uuid.New().String()
+	}
+	return nil
+}
+
+// TableName returns the table name for GORM
+func (ErasureReceipt) TableName() string {
+	return "erasure_receipts"
+}
+
+// HashSubject returns the hash erasure receipts identify a person by. It is keyed
+// with a server-side secret so it cannot be reversed with a list of known addresses.
+func HashSubject(secret []byte, email string) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(NormalizeEmail(email)))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// ComputeHash returns the hash of the receipt's contents and PreviousHash.
+// ErasedAt is hashed at microsecond precision, the precision Postgres stores.
+func (r *ErasureReceipt) ComputeHash() string {
+	fields := []string{
+		fmt.Sprint(r.Sequence),
+		r.SubjectHash,
+		strings.Join(r.ApplicationIDs, ","),
+		fmt.Sprint(r.ApplicationsAnonymized),
+		fmt.Sprint(r.AnswersDeleted),
+		fmt.Sprint(r.CommentsDeleted),
+		fmt.Sprint(r.EmailLogsDeleted),
+		fmt.Sprint(r.ResumesDeleted),
+		fmt.Sprint(r.CandidateDeleted),
+		r.Reason,
+		r.ErasedBy,
+		r.ErasedAt.UTC().Truncate(time.Microsecond).Format(time.RFC3339Nano),
+		r.PreviousHash,
+	}
+	sum := sha256.Sum256([]byte(strings.Join(fields, "\n")))
+	return hex.EncodeToString(sum[:])
+}
+
+// Seal links the receipt to the one before it (nil for the first receipt) and sets its hash
+func (r *ErasureReceipt) Seal(previous *ErasureReceipt) {
+	r.ErasedAt = r.ErasedAt.UTC().Truncate(time.Microsecond)
+	r.Sequence, r.PreviousHash = 1, ""
+	if previous != nil {
+		r.Sequence = previous.Sequence + 1
+		r.PreviousHash = previous.Hash
+	}
+	r.Hash = r.ComputeHash()
+}
+
+// VerifyErasureChain checks a complete chain of receipts in sequence order. It
+// returns the sequence number of the first receipt that was altered, removed or
+// inserted out of order, or 0 when the chain is intact.
+func VerifyErasureChain(receipts []*ErasureReceipt) int64 {
+	var previous *ErasureReceipt
+	for i, receipt := range receipts {
+		expected := int64(i + 1)
+		switch {
+		case receipt.Sequence != expected:
+			return expected
+		case previous == nil && receipt.PreviousHash != "":
+			return receipt.Sequence
+		case previous != nil && receipt.PreviousHash != previous.Hash:
+			return receipt.Sequence
+		case receipt.Hash != receipt.ComputeHash():
+			return receipt.Sequence
+		}
+		previous = receipt
+	}
+	return 0
+}
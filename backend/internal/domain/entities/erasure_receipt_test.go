@@ -0,0 +1,171 @@
+package entities
+
+import (
+	"testing"
+	"time"
+)
+
+// sealedChain returns n receipts sealed into a chain
+func sealedChain(n int) []*ErasureReceipt {
+	erasedAt := time.Date(2025, 4, 1, 12, 0, 0, 123456789, time.UTC)
+	receipts := make([]*ErasureReceipt, 0, n)
+	var previous *ErasureReceipt
+	for i := 0; i < n; i++ {
+		receipt := &ErasureReceipt{
+			SubjectHash:            HashSubject([]byte("secret"), "person@example.com"),
+			ApplicationIDs:         StringList{"app-1", "app-2"},
+			ApplicationsAnonymized: 2,
+			AnswersDeleted:         3,
+			Reason:                 "Ticket 42",
+			ErasedBy:               "admin",
+			ErasedAt:               erasedAt.Add(time.Duration(i) * time.Hour),
+		}
+		receipt.Seal(previous)
+		receipts = append(receipts, receipt)
+		previous = receipt
+	}
+	return receipts
+}
+
+func TestSeal(t *testing.T) {
+	receipts := sealedChain(2)
+
+	first, second := receipts[0], receipts[1]
+	if first.Sequence != 1 || first.PreviousHash != "" {
+		t.Errorf("first receipt: Sequence = %d, PreviousHash = %q", first.Sequence, first.PreviousHash)
+	}
+	if second.Sequence != 2 || second.PreviousHash != first.Hash {
+		t.Errorf("second receipt: Sequence = %d, PreviousHash = %q, want 2, %q", second.Sequence, second.PreviousHash, first.Hash)
+	}
+	if first.ErasedAt.Nanosecond()%1000 != 0 {
+		t.Errorf("ErasedAt = %v, want microsecond precision", first.ErasedAt)
+	}
+	if first.Hash == "" || first.Hash == second.Hash {
+		t.Errorf("hashes %q and %q should be set and differ", first.Hash, second.Hash)
+	}
+}
+
+func TestVerifyErasureChain(t *testing.T) {
+	tests := []struct {
+		name   string
+		tamper func([]*ErasureReceipt) []*ErasureReceipt
+		want   int64
+	}{
+		{
+			name:   "intact",
+			tamper: func(r []*ErasureReceipt) []*ErasureReceipt { return r },
+			want:   0,
+		},
+		{
+			name:   "empty",
+			tamper: func(r []*ErasureReceipt) []*ErasureReceipt { return nil },
+			want:   0,
+		},
+		{
+			name: "altered reason",
+			tamper: func(r []*ErasureReceipt) []*ErasureReceipt {
+				r[1].Reason = "Ticket 43"
+				return r
+			},
+			want: 2,
+		},
+		{
+			name: "altered count",
+			tamper: func(r []*ErasureReceipt) []*ErasureReceipt {
+				r[2].ResumesDeleted = 1
+				return r
+			},
+			want: 3,
+		},
+		{
+			name: "altered timestamp",
+			tamper: func(r []*ErasureReceipt) []*ErasureReceipt {
+				r[0].ErasedAt = r[0].ErasedAt.Add(time.Microsecond)
+				return r
+			},
+			want: 1,
+		},
+		{
+			name: "altered and rehashed",
+			tamper: func(r []*ErasureReceipt) []*ErasureReceipt {
+				r[1].ErasedBy = "someone else"
+				r[1].Hash = r[1].ComputeHash()
+				return r
+			},
+			want: 3,
+		},
+		{
+			name: "removed receipt",
+			tamper: func(r []*ErasureReceipt) []*ErasureReceipt {
+				return append(r[:1], r[2:]...)
+			},
+			want: 2,
+		},
+		{
+			name: "removed and renumbered",
+			tamper: func(r []*ErasureReceipt) []*ErasureReceipt {
+				r[2].Sequence = 2
+				r[2].Hash = r[2].ComputeHash()
+				return append(r[:1], r[2:]...)
+			},
+			want: 2,
+		},
+		{
+			name: "removed last receipt",
+			tamper: func(r []*ErasureReceipt) []*ErasureReceipt {
+				return r[:2]
+			},
+			// Truncating the chain is only detected against a head hash kept elsewhere
+			want: 0,
+		},
+		{
+			name: "inserted receipt",
+			tamper: func(r []*ErasureReceipt) []*ErasureReceipt {
+				inserted := &ErasureReceipt{ErasedBy: "someone else", ErasedAt: r[0].ErasedAt}
+				inserted.Seal(r[0])
+				return []*ErasureReceipt{r[0], inserted, r[1], r[2]}
+			},
+			want: 3,
+		},
+		{
+			name: "reordered receipts",
+			tamper: func(r []*ErasureReceipt) []*ErasureReceipt {
+				return []*ErasureReceipt{r[0], r[2], r[1]}
+			},
+			want: 2,
+		},
+		{
+			name: "first receipt with a previous hash",
+			tamper: func(r []*ErasureReceipt) []*ErasureReceipt {
+				r[0].PreviousHash = r[2].Hash
+				r[0].Hash = r[0].ComputeHash()
+				return r
+			},
+			want: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			receipts := tt.tamper(sealedChain(3))
+			if got := VerifyErasureChain(receipts); got != tt.want {
+				t.Errorf("VerifyErasureChain() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHashSubject(t *testing.T) {
+	secret := []byte("secret")
+	hash := HashSubject(secret, "Person@Example.com ")
+
+	if hash != HashSubject(secret, "person@example.com") {
+		t.Error("hash depends on case or surrounding spaces")
+	}
+	if hash == HashSubject([]byte("other"), "person@example.com") {
+		t.Error("hash does not depend on the secret")
+	}
+	if len(hash) != 64 {
+		t.Errorf("hash has %d characters, want 64", len(hash))
+	}
+}
@@ -0,0 +1,55 @@
+package entities
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+)
+
+// ImageVariant is one resized copy of an uploaded image
+type ImageVariant struct {
+	Width  int    `json:"width"`
+	Height int    `json:"height"`
+	URL    string `json:"url"`
+}
+
+// ImageVariants are the resized copies of an image, narrowest first, stored as a JSON array column
+type ImageVariants []ImageVariant
+
+// Value implements driver.Valuer
+func (v ImageVariants) Value() (driver.Value, error) {
+	if v == nil {
+		return "[]", nil
+	}
+	data, err := json.Marshal([]ImageVariant(v))
+	if err != nil {
+		return nil, err
+	}
+	return string(data), nil
+}
+
+// Scan implements sql.Scanner
+func (v *ImageVariants) Scan(value interface{}) error {
+	var data []byte
+	switch val := value.(type) {
+	case nil:
+		*v = ImageVariants{}
+		return nil
+	case []byte:
+		data = val
+	case string:
+		data = []byte(val)
+	default:
+		return fmt.Errorf("cannot scan %T into ImageVariants", value)
+	}
+	return json.Unmarshal(data, (*[]ImageVariant)(v))
+}
+
+// URLs returns the URLs of all variants
+func (v ImageVariants) URLs() []string {
+	urls := make([]string, len(v))
+	for i, variant := range v {
+		urls[i] = variant.URL
+	}
+	return urls
+}
@@ -0,0 +1,58 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// InquiryStatus represents where a contact inquiry is in follow-up
+type InquiryStatus string
+
+const (
+	InquiryStatusNew       InquiryStatus = "new"
+	InquiryStatusContacted InquiryStatus = "contacted"
+	InquiryStatusClosed    InquiryStatus = "closed"
+)
+
+// IsValid reports whether the status is a known inquiry status
+func (s InquiryStatus) IsValid() bool {
+	switch s {
+	case InquiryStatusNew, InquiryStatusContacted, InquiryStatusClosed:
+		return true
+	}
+	return false
+}
+
+// Inquiry is a message sent through the website contact form
+type Inquiry struct {
+	ID          string        `json:"id" gorm:"type:varchar(50);primaryKey"`
+	Name        string        `json:"name" gorm:"not null"`
+	Email       string        `json:"email" gorm:"not null;index"`
+	Company     string        `json:"company"`
+	Role        string        `json:"role"`
+	ProjectType string        `json:"project_type"`
+	Budget      string        `json:"budget"`
+	Timeline    string        `json:"timeline"`
+	Message     string        `json:"message" gorm:"type:text;not null"`
+	Status      InquiryStatus `json:"status" gorm:"type:varchar(20);not null;default:'new';index"`
+	HandledBy   string        `json:"handled_by,omitempty"`
+	IPAddress   string        `json:"ip_address,omitempty" gorm:"index"`
+	UserAgent   string        `json:"user_agent,omitempty"`
+	CreatedAt   time.Time     `json:"created_at"`
+	UpdatedAt   time.Time     `json:"updated_at"`
+}
+
+// BeforeCreate sets the ID if not already set
+func (i *Inquiry) BeforeCreate(tx *gorm.DB) error {
+	if i.ID == "" {
+		i.ID = uuid.New().String()
+	}
+	return nil
+}
+
+// TableName returns the table name for GORM
+func (Inquiry) TableName() string {
+	return "inquiries"
+}
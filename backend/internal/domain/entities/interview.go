@@ -0,0 +1,72 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// InterviewStatus represents the state of a scheduled interview
+type InterviewStatus string
+
+const (
+	InterviewScheduled InterviewStatus = "scheduled"
+	InterviewCancelled InterviewStatus = "cancelled"
+)
+
+// Interview represents an interview scheduled for an application.
+// Sequence is incremented on every change so calendar clients replace
+// the previous version of the invite instead of adding a new event.
+type Interview struct {
+	ID            string          `json:"id" gorm:"type:varchar(50);primaryKey"`
+	ApplicationID string          `json:"application_id" gorm:"type:varchar(50);not null;index"`
+	Interviewers  StringList      `json:"interviewers" gorm:"type:jsonb;not null"`
+	StartAt       time.Time       `json:"start_at" gorm:"not null;index"`
+	EndAt         time.Time       `json:"end_at" gorm:"not null"`
+	Timezone      string          `json:"timezone" gorm:"type:varchar(64);not null"`
+	Location      string          `json:"location"`
+	VideoLink     string          `json:"video_link"`
+	Notes         string          `json:"notes" gorm:"type:text"`
+	Status        InterviewStatus `json:"status" gorm:"type:varchar(20);default:scheduled;index"`
+	Sequence      int             `json:"sequence" gorm:"not null;default:0"`
+	CreatedBy     string          `json:"created_by"`
+	CancelledBy   string          `json:"cancelled_by,omitempty"`
+	CancelledAt   *time.Time      `json:"cancelled_at,omitempty"`
+	CreatedAt     time.Time       `json:"created_at"`
+	UpdatedAt     time.Time       `json:"updated_at"`
+}
+
+// BeforeCreate sets the ID if not already set
+func (i *Interview) BeforeCreate(tx *gorm.DB) error {
+	if i.ID == "" {
+		i.ID = uuid.New().String()
+	}
+	return nil
+}
+
+// IsCancelled reports whether the interview has been cancelled
+func (i *Interview) IsCancelled() bool {
+	return i.Status == InterviewCancelled
+}
+
+// Reschedule records a change to the interview and bumps its sequence
+func (i *Interview) Reschedule() {
+	i.Sequence++
+	i.UpdatedAt = time.Now()
+}
+
+// Cancel marks the interview as cancelled and bumps its sequence
+func (i *Interview) Cancel(cancelledBy string) {
+	now := time.Now()
+	i.Status = InterviewCancelled
+	i.CancelledBy = cancelledBy
+	i.CancelledAt = &now
+	i.Sequence++
+	i.UpdatedAt = now
+}
+
+// TableName returns the table name for GORM
+func (Interview) TableName() string {
+	return "interviews"
+}
@@ -0,0 +1,68 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// OfferStatus represents the state of a job offer
+type OfferStatus string
+
+const (
+	OfferPending   OfferStatus = "pending"
+	OfferAccepted  OfferStatus = "accepted"
+	OfferDeclined  OfferStatus = "declined"
+	OfferExpired   OfferStatus = "expired"
+	OfferRescinded OfferStatus = "rescinded"
+)
+
+// Offer is a job offer made to the candidate of an application.
+// Only pending offers can be answered; every other status is final.
+type Offer struct {
+	ID            string      `json:"id" gorm:"type:varchar(50);primaryKey"`
+	ApplicationID string      `json:"application_id" gorm:"type:varchar(50);not null;index"`
+	Salary        int64       `json:"salary" gorm:"not null"`
+	Currency      string      `json:"currency" gorm:"type:varchar(3);not null"`
+	StartDate     time.Time   `json:"start_date" gorm:"type:date;not null"`
+	ExpiresAt     time.Time   `json:"expires_at" gorm:"not null;index"`
+	Terms         string      `json:"terms" gorm:"type:text"`
+	Status        OfferStatus `json:"status" gorm:"type:varchar(20);default:pending;index"`
+	CreatedBy     string      `json:"created_by"`
+	RespondedAt   *time.Time  `json:"responded_at,omitempty"`
+	DeclineReason string      `json:"decline_reason,omitempty" gorm:"type:text"`
+	RescindedBy   string      `json:"rescinded_by,omitempty"`
+	CreatedAt     time.Time   `json:"created_at"`
+	UpdatedAt     time.Time   `json:"updated_at"`
+}
+
+// BeforeCreate sets the ID if not already set
+func (o *Offer) BeforeCreate(tx *gorm.DB) error {
+	if o.ID == "" {
+		o.ID = uuid.New().String()
+	}
+	return nil
+}
+
+// IsPending reports whether the offer is still waiting for an answer
+func (o *Offer) IsPending() bool {
+	return o.Status == OfferPending
+}
+
+// IsExpired reports whether the offer's deadline has passed at now
+func (o *Offer) IsExpired(now time.Time) bool {
+	return !now.Before(o.ExpiresAt)
+}
+
+// Resolve moves a pending offer to its final status
+func (o *Offer) Resolve(status OfferStatus, at time.Time) {
+	o.Status = status
+	o.RespondedAt = &at
+	o.UpdatedAt = at
+}
+
+// TableName returns the table name for GORM
+func (Offer) TableName() string {
+	return "offers"
+}
@@ -0,0 +1,35 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// PortalSession is a candidate's passwordless login to the status portal. It starts
+// as a one-time login link and becomes a read-only session once the link is redeemed.
+// Only hashes of the login and session tokens are stored.
+type PortalSession struct {
+	ID               string     `json:"id" gorm:"type:varchar(50);primaryKey"`
+	CandidateID      string     `json:"candidate_id" gorm:"type:varchar(50);not null;index"`
+	LoginTokenHash   string     `json:"-" gorm:"type:varchar(64);not null;uniqueIndex"`
+	LoginExpiresAt   time.Time  `json:"login_expires_at" gorm:"not null"`
+	SessionTokenHash *string    `json:"-" gorm:"type:varchar(64);uniqueIndex"`
+	RedeemedAt       *time.Time `json:"redeemed_at,omitempty"`
+	ExpiresAt        *time.Time `json:"expires_at,omitempty"`
+	CreatedAt        time.Time  `json:"created_at"`
+}
+
+// BeforeCreate sets the ID if not already set
+func (s *PortalSession) BeforeCreate(tx *gorm.DB) error {
+	if s.ID == "" {
+		s.ID = uuid.New().String()
+	}
+	return nil
+}
+
+// TableName returns the table name for GORM
+func (PortalSession) TableName() string {
+	return "portal_sessions"
+}
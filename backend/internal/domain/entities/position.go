@@ -0,0 +1,147 @@
+package entities
+
+import (
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// PositionStatus represents the publication status of a job position
+type PositionStatus string
+
+const (
+	PositionStatusDraft  PositionStatus = "draft"
+	PositionStatusOpen   PositionStatus = "open"
+	PositionStatusClosed PositionStatus = "closed"
+)
+
+// IsValid reports whether the status is a known position status
+func (s PositionStatus) IsValid() bool {
+	switch s {
+	case PositionStatusDraft, PositionStatusOpen, PositionStatusClosed:
+		return true
+	}
+	return false
+}
+
+// EmploymentType represents the contract type of a position
+type EmploymentType string
+
+const (
+	EmploymentFullTime   EmploymentType = "full_time"
+	EmploymentPartTime   EmploymentType = "part_time"
+	EmploymentContract   EmploymentType = "contract"
+	EmploymentInternship EmploymentType = "internship"
+)
+
+// IsValid reports whether the employment type is known
+func (t EmploymentType) IsValid() bool {
+	switch t {
+	case EmploymentFullTime, EmploymentPartTime, EmploymentContract, EmploymentInternship:
+		return true
+	}
+	return false
+}
+
+// Position represents a job opening candidates can apply to.
+// The ID is a URL-friendly slug and is what Application.PositionID refers to.
+type Position struct {
+	ID             string         `json:"id" gorm:"type:varchar(100);primaryKey"`
+	Title          string         `json:"title" gorm:"not null"`
+	Department     string         `json:"department"`
+	Location       string         `json:"location"`
+	EmploymentType EmploymentType `json:"employment_type" gorm:"default:full_time"`
+	Description    string         `json:"description" gorm:"type:text"`
+	Requirements   StringList     `json:"requirements" gorm:"type:jsonb"`
+	Benefits       StringList     `json:"benefits" gorm:"type:jsonb"`
+	Status         PositionStatus `json:"status" gorm:"default:draft;index"`
+
+	// Lifecycle: the scheduler opens the position at OpenAt and closes it at CloseAt
+	OpenAt   *time.Time `json:"open_at,omitempty"`
+	CloseAt  *time.Time `json:"close_at,omitempty"`
+	ClosedAt *time.Time `json:"closed_at,omitempty"`
+
+	// What happens to pending applications when the position closes
+	OnCloseStatus ApplicationStatus `json:"on_close_status,omitempty"`
+	NotifyOnClose bool              `json:"notify_on_close"`
+
+	CreatedAt time.Time      `json:"created_at"`
+	UpdatedAt time.Time      `json:"updated_at"`
+	DeletedAt gorm.DeletedAt `json:"-" gorm:"index"`
+}
+
+var slugInvalidChars = regexp.MustCompile(`[^a-z0-9]+`)
+
+// Slugify converts a title into a position ID such as "senior-ai-engineer"
+func Slugify(title string) string {
+	slug := slugInvalidChars.ReplaceAllString(strings.ToLower(title), "-")
+	return strings.Trim(slug, "-")
+}
+
+// BeforeCreate derives the ID from the title if not already set
+func (p *Position) BeforeCreate(tx *gorm.DB) error {
+	if p.ID == "" {
+		p.ID = Slugify(p.Title)
+	}
+	return nil
+}
+
+// AcceptsApplications reports whether candidates can apply at the given time.
+// A scheduled close takes effect immediately even if the scheduler has not run yet.
+func (p *Position) AcceptsApplications(now time.Time) bool {
+	if p.Status != PositionStatusOpen {
+		return false
+	}
+	return p.CloseAt == nil || now.Before(*p.CloseAt)
+}
+
+// IsDueToOpen reports whether a scheduled draft position should be opened
+func (p *Position) IsDueToOpen(now time.Time) bool {
+	return p.Status == PositionStatusDraft && p.OpenAt != nil && !now.Before(*p.OpenAt)
+}
+
+// IsDueToClose reports whether an open position has passed its scheduled close time
+func (p *Position) IsDueToClose(now time.Time) bool {
+	return p.Status == PositionStatusOpen && p.CloseAt != nil && !now.Before(*p.CloseAt)
+}
+
+// PositionCloseTrigger describes what caused a position to close
+type PositionCloseTrigger string
+
+const (
+	CloseTriggerManual   PositionCloseTrigger = "manual"
+	CloseTriggerSchedule PositionCloseTrigger = "schedule"
+)
+
+// PositionClosure records a position being closed and how its pending applications were handled
+type PositionClosure struct {
+	ID                 string               `json:"id" gorm:"type:varchar(50);primaryKey"`
+	PositionID         string               `json:"position_id" gorm:"type:varchar(100);not null;index"`
+	ClosedBy           string               `json:"closed_by"`
+	Trigger            PositionCloseTrigger `json:"trigger"`
+	ApplicationStatus  ApplicationStatus    `json:"application_status,omitempty"`
+	ApplicationsMoved  int                  `json:"applications_moved"`
+	CandidatesNotified bool                 `json:"candidates_notified"`
+	CreatedAt          time.Time            `json:"created_at"`
+}
+
+// BeforeCreate sets the ID if not already set
+func (c *PositionClosure) BeforeCreate(tx *gorm.DB) error {
+	if c.ID == "" {
+		c.ID = uuid.New().String()
+	}
+	return nil
+}
+
+// TableName returns the table name for GORM
+func (PositionClosure) TableName() string {
+	return "position_closures"
+}
+
+// TableName returns the table name for GORM
+func (Position) TableName() string {
+	return "positions"
+}
@@ -0,0 +1,259 @@
+package entities
+
+import (
+	"fmt"
+	"math"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	domainErrors "super2025-backend/internal/domain/errors"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// QuestionType is the kind of answer a position question expects
+type QuestionType string
+
+const (
+	QuestionTypeText         QuestionType = "text"
+	QuestionTypeSingleChoice QuestionType = "single_choice"
+	QuestionTypeMultiChoice  QuestionType = "multi_choice"
+	QuestionTypeYesNo        QuestionType = "yes_no"
+	QuestionTypeNumber       QuestionType = "number"
+	QuestionTypeURL          QuestionType = "url"
+)
+
+const (
+	// MaxTextAnswerLength limits text answers, in characters
+	MaxTextAnswerLength = 2000
+	// maxURLAnswerLength limits URL answers, in bytes
+	maxURLAnswerLength = 500
+)
+
+// IsValid reports whether the question type is known
+func (t QuestionType) IsValid() bool {
+	switch t {
+	case QuestionTypeText, QuestionTypeSingleChoice, QuestionTypeMultiChoice,
+		QuestionTypeYesNo, QuestionTypeNumber, QuestionTypeURL:
+		return true
+	}
+	return false
+}
+
+// HasOptions reports whether answers are picked from the question's options
+func (t QuestionType) HasOptions() bool {
+	return t == QuestionTypeSingleChoice || t == QuestionTypeMultiChoice
+}
+
+// PositionQuestion is an extra question candidates answer when applying to a position
+type PositionQuestion struct {
+	ID         string       `json:"id" gorm:"type:varchar(50);primaryKey"`
+	PositionID string       `json:"position_id" gorm:"type:varchar(100);not null;index"`
+	Label      string       `json:"label" gorm:"type:varchar(500);not null"`
+	HelpText   string       `json:"help_text,omitempty" gorm:"type:text"`
+	Type       QuestionType `json:"type" gorm:"type:varchar(20);not null"`
+	Required   bool         `json:"required" gorm:"not null;default:false"`
+	Options    StringList   `json:"options" gorm:"type:jsonb;not null"`
+	SortOrder  int          `json:"sort_order" gorm:"not null;default:0"`
+	CreatedAt  time.Time    `json:"created_at"`
+	UpdatedAt  time.Time    `json:"updated_at"`
+}
+
+// BeforeCreate sets the ID if not already set
+func (q *PositionQuestion) BeforeCreate(tx *gorm.DB) error {
+	if q.ID == "" {
+		q.ID = uuid.New().String()
+	}
+	return nil
+}
+
+// TableName returns the table name for GORM
+func (PositionQuestion) TableName() string {
+	return "position_questions"
+}
+
+// NormalizeAnswer checks a candidate's answer against the question and returns
+// it in stored form. The answer is a decoded JSON value; nil means unanswered.
+// Yes/no answers are stored as "yes" or "no" and numbers in their shortest form.
+func (q *PositionQuestion) NormalizeAnswer(answer interface{}) (StringList, error) {
+	values, err := q.answerValues(answer)
+	if err != nil {
+		return nil, err
+	}
+	if len(values) == 0 && q.Required {
+		return nil, fmt.Errorf("an answer is required")
+	}
+	return values, nil
+}
+
+func (q *PositionQuestion) answerValues(answer interface{}) (StringList, error) {
+	if answer == nil {
+		return StringList{}, nil
+	}
+
+	switch q.Type {
+	case QuestionTypeText:
+		text, ok := answer.(string)
+		if !ok {
+			return nil, fmt.Errorf("must be text")
+		}
+		text = strings.TrimSpace(text)
+		if utf8.RuneCountInString(text) > MaxTextAnswerLength {
+			return nil, fmt.Errorf("must be at most %d characters", MaxTextAnswerLength)
+		}
+		if text == "" {
+			return StringList{}, nil
+		}
+		return StringList{text}, nil
+
+	case QuestionTypeSingleChoice:
+		choice, ok := answer.(string)
+		if !ok {
+			return nil, fmt.Errorf("must be one of the options")
+		}
+		if choice == "" {
+			return StringList{}, nil
+		}
+		if !q.Options.Contains(choice) {
+			return nil, fmt.Errorf("must be one of the options")
+		}
+		return StringList{choice}, nil
+
+	case QuestionTypeMultiChoice:
+		items, ok := answer.([]interface{})
+		if !ok {
+			return nil, fmt.Errorf("must be a list of options")
+		}
+		chosen := make(map[string]bool, len(items))
+		for _, item := range items {
+			choice, ok := item.(string)
+			if !ok || !q.Options.Contains(choice) {
+				return nil, fmt.Errorf("must only contain the options")
+			}
+			chosen[choice] = true
+		}
+		// Keep the order the options are listed in
+		values := StringList{}
+		for _, option := range q.Options {
+			if chosen[option] {
+				values = append(values, option)
+			}
+		}
+		return values, nil
+
+	case QuestionTypeYesNo:
+		switch v := answer.(type) {
+		case bool:
+			if v {
+				return StringList{"yes"}, nil
+			}
+			return StringList{"no"}, nil
+		case string:
+			switch strings.ToLower(strings.TrimSpace(v)) {
+			case "yes", "true":
+				return StringList{"yes"}, nil
+			case "no", "false":
+				return StringList{"no"}, nil
+			case "":
+				return StringList{}, nil
+			}
+		}
+		return nil, fmt.Errorf("must be yes or no")
+
+	case QuestionTypeNumber:
+		var number float64
+		switch v := answer.(type) {
+		case float64:
+			number = v
+		case string:
+			v = strings.TrimSpace(v)
+			if v == "" {
+				return StringList{}, nil
+			}
+			parsed, err := strconv.ParseFloat(v, 64)
+			if err != nil {
+				return nil, fmt.Errorf("must be a number")
+			}
+			number = parsed
+		default:
+			return nil, fmt.Errorf("must be a number")
+		}
+		if math.IsNaN(number) || math.IsInf(number, 0) {
+			return nil, fmt.Errorf("must be a number")
+		}
+		return StringList{strconv.FormatFloat(number, 'f', -1, 64)}, nil
+
+	case QuestionTypeURL:
+		link, ok := answer.(string)
+		if !ok {
+			return nil, fmt.Errorf("must be a URL")
+		}
+		link = strings.TrimSpace(link)
+		if link == "" {
+			return StringList{}, nil
+		}
+		parsed, err := url.Parse(link)
+		if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" || len(link) > maxURLAnswerLength {
+			return nil, fmt.Errorf("must be an http or https URL of at most %d characters", maxURLAnswerLength)
+		}
+		return StringList{link}, nil
+	}
+
+	return nil, fmt.Errorf("unsupported question type %q", q.Type)
+}
+
+// ApplicationAnswer is a candidate's answer to a position question. The question's
+// label and type are copied so the answer stays readable if the question changes.
+type ApplicationAnswer struct {
+	ID            string       `json:"id" gorm:"type:varchar(50);primaryKey"`
+	ApplicationID string       `json:"application_id" gorm:"type:varchar(50);not null;index"`
+	QuestionID    string       `json:"question_id" gorm:"type:varchar(50);not null;index"`
+	Label         string       `json:"label" gorm:"type:varchar(500);not null"`
+	Type          QuestionType `json:"type" gorm:"type:varchar(20);not null"`
+	Values        StringList   `json:"values" gorm:"column:answer_values;type:jsonb;not null"`
+	SortOrder     int          `json:"sort_order" gorm:"not null;default:0"`
+	CreatedAt     time.Time    `json:"created_at"`
+}
+
+// BeforeCreate sets the ID if not already set
+func (a *ApplicationAnswer) BeforeCreate(tx *gorm.DB) error {
+	if a.ID == "" {
+		a.ID = uuid.New().String()
+	}
+	return nil
+}
+
+// TableName returns the table name for GORM
+func (ApplicationAnswer) TableName() string {
+	return "application_answers"
+}
+
+// AnswerValidationError lists the problems with a candidate's answers, keyed by question ID
+type AnswerValidationError struct {
+	Fields map[string]string
+}
+
+// Error implements the error interface
+func (e *AnswerValidationError) Error() string {
+	ids := make([]string, 0, len(e.Fields))
+	for id := range e.Fields {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	problems := make([]string, len(ids))
+	for i, id := range ids {
+		problems[i] = fmt.Sprintf("%s: %s", id, e.Fields[id])
+	}
+	return "invalid answers: " + strings.Join(problems, "; ")
+}
+
+// Unwrap allows errors.Is to match ErrInvalidAnswers
+func (e *AnswerValidationError) Unwrap() error {
+	return domainErrors.ErrInvalidAnswers
+}
@@ -0,0 +1,74 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// PostStatus represents the publication status of a blog post
+type PostStatus string
+
+const (
+	PostStatusDraft     PostStatus = "draft"
+	PostStatusPublished PostStatus = "published"
+)
+
+// IsValid reports whether the status is a known post status
+func (s PostStatus) IsValid() bool {
+	switch s {
+	case PostStatusDraft, PostStatusPublished:
+		return true
+	}
+	return false
+}
+
+// Post is a blog article. The body is Markdown; the slug is its public URL segment.
+type Post struct {
+	ID           string     `json:"id" gorm:"type:varchar(50);primaryKey"`
+	Slug         string     `json:"slug" gorm:"type:varchar(200);not null;uniqueIndex"`
+	Title        string     `json:"title" gorm:"not null"`
+	Excerpt      string     `json:"excerpt" gorm:"type:text"`
+	Body         string     `json:"body" gorm:"type:text;not null"`
+	Category     string     `json:"category"`
+	CategorySlug string     `json:"category_slug" gorm:"type:varchar(100);index"`
+	Author       string     `json:"author" gorm:"not null"`
+	CoverImage   string     `json:"cover_image"`
+	Status       PostStatus `json:"status" gorm:"type:varchar(20);not null;default:draft;index"`
+	PublishedAt  *time.Time `json:"published_at,omitempty" gorm:"index"`
+	CreatedAt    time.Time  `json:"created_at"`
+	UpdatedAt    time.Time  `json:"updated_at"`
+}
+
+// BeforeCreate sets the ID if not already set
+func (p *Post) BeforeCreate(tx *gorm.DB) error {
+	if p.ID == "" {
+		p.ID = uuid.New().String()
+	}
+	return nil
+}
+
+// BeforeSave keeps the category slug in step with the category
+func (p *Post) BeforeSave(tx *gorm.DB) error {
+	p.CategorySlug = Slugify(p.Category)
+	return nil
+}
+
+// IsLive reports whether the post is visible to the public at now.
+// A published post with a future PublishedAt goes live at that time.
+func (p *Post) IsLive(now time.Time) bool {
+	return p.Status == PostStatusPublished && p.PublishedAt != nil && !now.Before(*p.PublishedAt)
+}
+
+// TableName returns the table name for GORM
+func (Post) TableName() string {
+	return "posts"
+}
+
+// PostCategory is a blog category with the number of posts in it
+type PostCategory struct {
+	Name  string `json:"name"`
+	Slug  string `json:"slug"`
+	Count int64  `json:"count"`
+}
@@ -0,0 +1,82 @@
+package entities
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// ProjectMetric is a headline figure shown with a project, e.g. "users": "120K+"
+type ProjectMetric struct {
+	Label string `json:"label"`
+	Value string `json:"value"`
+}
+
+// ProjectMetrics is an ordered list of metrics stored as a JSON array column
+type ProjectMetrics []ProjectMetric
+
+// Value implements driver.Valuer
+func (m ProjectMetrics) Value() (driver.Value, error) {
+	if m == nil {
+		return "[]", nil
+	}
+	data, err := json.Marshal([]ProjectMetric(m))
+	if err != nil {
+		return nil, err
+	}
+	return string(data), nil
+}
+
+// Scan implements sql.Scanner
+func (m *ProjectMetrics) Scan(value interface{}) error {
+	var data []byte
+	switch v := value.(type) {
+	case nil:
+		*m = ProjectMetrics{}
+		return nil
+	case []byte:
+		data = v
+	case string:
+		data = []byte(v)
+	default:
+		return fmt.Errorf("cannot scan %T into ProjectMetrics", value)
+	}
+	return json.Unmarshal(data, (*[]ProjectMetric)(m))
+}
+
+// Project is a case study in the project showcase. Projects are listed by SortOrder
+// and only shown to the public once published.
+type Project struct {
+	ID           string         `json:"id" gorm:"type:varchar(50);primaryKey"`
+	Slug         string         `json:"slug" gorm:"type:varchar(200);not null;uniqueIndex"`
+	Title        string         `json:"title" gorm:"not null"`
+	Category     string         `json:"category"`
+	Description  string         `json:"description" gorm:"type:text"`
+	Technologies StringList     `json:"technologies" gorm:"type:jsonb;not null"`
+	Metrics      ProjectMetrics `json:"metrics" gorm:"type:jsonb;not null"`
+	Status       string         `json:"status"`
+	Timeline     string         `json:"timeline"`
+	URL          string         `json:"url"`
+	Image        ImageVariants  `json:"image" gorm:"type:jsonb;not null"`
+	SortOrder    int            `json:"sort_order" gorm:"not null;default:0"`
+	Published    bool           `json:"published" gorm:"not null;default:false;index"`
+	CreatedAt    time.Time      `json:"created_at"`
+	UpdatedAt    time.Time      `json:"updated_at"`
+}
+
+// BeforeCreate sets the ID if not already set
+func (p *Project) BeforeCreate(tx *gorm.DB) error {
+	if p.ID == "" {
+		p.ID = uuid.New().String()
+	}
+	return nil
+}
+
+// TableName returns the table name for GORM
+func (Project) TableName() string {
+	return "projects"
+}
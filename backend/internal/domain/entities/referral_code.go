@@ -0,0 +1,43 @@
+package entities
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// ApplicationSourceReferral is the source recorded on applications submitted with a referral code
+const ApplicationSourceReferral = "referral"
+
+// ReferralCode is issued to an employee to share with people they refer.
+// Applications submitted with the code are attributed to the employee.
+type ReferralCode struct {
+	ID            string    `json:"id" gorm:"type:varchar(50);primaryKey"`
+	Code          string    `json:"code" gorm:"type:varchar(32);not null;uniqueIndex"`
+	EmployeeName  string    `json:"employee_name" gorm:"not null"`
+	EmployeeEmail string    `json:"employee_email" gorm:"type:varchar(255);not null;index"`
+	Active        bool      `json:"active" gorm:"not null;default:true"`
+	CreatedBy     string    `json:"created_by"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+// NormalizeReferralCode returns the canonical form of a code as typed by a candidate
+func NormalizeReferralCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+// BeforeCreate sets the ID if not already set
+func (r *ReferralCode) BeforeCreate(tx *gorm.DB) error {
+	if r.ID == "" {
+		r.ID = uuid.New().String()
+	}
+	return nil
+}
+
+// TableName returns the table name for GORM
+func (ReferralCode) TableName() string {
+	return "referral_codes"
+}
@@ -0,0 +1,39 @@
+package entities
+
+import "time"
+
+// RetentionAction is what happens to an application once a retention rule applies to it
+type RetentionAction string
+
+const (
+	// RetentionAnonymize erases the personal data and keeps the anonymized application for reporting
+	RetentionAnonymize RetentionAction = "anonymize"
+	// RetentionPurge deletes the application and everything recorded about it
+	RetentionPurge RetentionAction = "purge"
+	// RetentionClearMetadata clears the IP address and user agent
+	RetentionClearMetadata RetentionAction = "clear_metadata"
+)
+
+// IsValid reports whether the action is known
+func (a RetentionAction) IsValid() bool {
+	switch a {
+	case RetentionAnonymize, RetentionPurge, RetentionClearMetadata:
+		return true
+	}
+	return false
+}
+
+// RetentionRule says how long data about an application is kept. Rules with a
+// status count the days from when the application entered it (its processed_at);
+// rules without one count from submission. Already anonymized applications are skipped.
+type RetentionRule struct {
+	Name   string
+	Status ApplicationStatus
+	Days   int
+	Action RetentionAction
+}
+
+// Cutoff returns the time before which the rule applies
+func (r RetentionRule) Cutoff(now time.Time) time.Time {
+	return now.AddDate(0, 0, -r.Days)
+}
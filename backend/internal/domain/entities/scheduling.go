@@ -0,0 +1,94 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// SchedulingInvitation is a set of interview slots offered to a candidate,
+// who picks one through a tokenized link. Only a hash of the token is stored.
+type SchedulingInvitation struct {
+	ID            string          `json:"id" gorm:"type:varchar(50);primaryKey"`
+	ApplicationID string          `json:"application_id" gorm:"type:varchar(50);not null;index"`
+	TokenHash     string          `json:"-" gorm:"type:varchar(64);not null;uniqueIndex"`
+	Interviewers  StringList      `json:"interviewers" gorm:"type:jsonb;not null"`
+	Timezone      string          `json:"timezone" gorm:"type:varchar(64);not null"`
+	Location      string          `json:"location"`
+	VideoLink     string          `json:"video_link"`
+	Notes         string          `json:"notes" gorm:"type:text"`
+	ExpiresAt     time.Time       `json:"expires_at" gorm:"not null"`
+	CreatedBy     string          `json:"created_by"`
+	BookedAt      *time.Time      `json:"booked_at,omitempty"`
+	InterviewID   *string         `json:"interview_id,omitempty" gorm:"type:varchar(50)"`
+	Slots         []InterviewSlot `json:"slots" gorm:"foreignKey:InvitationID"`
+	CreatedAt     time.Time       `json:"created_at"`
+	UpdatedAt     time.Time       `json:"updated_at"`
+}
+
+// BeforeCreate sets the ID if not already set
+func (s *SchedulingInvitation) BeforeCreate(tx *gorm.DB) error {
+	if s.ID == "" {
+		s.ID = uuid.New().String()
+	}
+	return nil
+}
+
+// IsExpired reports whether the invitation can no longer be used at now
+func (s *SchedulingInvitation) IsExpired(now time.Time) bool {
+	return !now.Before(s.ExpiresAt)
+}
+
+// IsBooked reports whether the candidate has already picked a slot
+func (s *SchedulingInvitation) IsBooked() bool {
+	return s.BookedAt != nil
+}
+
+// AvailableSlots returns the slots that are unbooked and still in the future
+func (s *SchedulingInvitation) AvailableSlots(now time.Time) []InterviewSlot {
+	available := make([]InterviewSlot, 0, len(s.Slots))
+	for _, slot := range s.Slots {
+		if slot.BookedAt == nil && slot.StartAt.After(now) {
+			available = append(available, slot)
+		}
+	}
+	return available
+}
+
+// Slot returns the slot with the given ID
+func (s *SchedulingInvitation) Slot(id string) (InterviewSlot, bool) {
+	for _, slot := range s.Slots {
+		if slot.ID == id {
+			return slot, true
+		}
+	}
+	return InterviewSlot{}, false
+}
+
+// TableName returns the table name for GORM
+func (SchedulingInvitation) TableName() string {
+	return "scheduling_invitations"
+}
+
+// InterviewSlot is a time offered to a candidate in a scheduling invitation
+type InterviewSlot struct {
+	ID           string     `json:"id" gorm:"type:varchar(50);primaryKey"`
+	InvitationID string     `json:"invitation_id" gorm:"type:varchar(50);not null;index"`
+	StartAt      time.Time  `json:"start_at" gorm:"not null"`
+	EndAt        time.Time  `json:"end_at" gorm:"not null"`
+	BookedAt     *time.Time `json:"booked_at,omitempty"`
+}
+
+// BeforeCreate sets the ID if not already set
+func (s *InterviewSlot) BeforeCreate(tx *gorm.DB) error {
+	if s.ID == "" {
+		s.ID = uuid.New().String()
+	}
+	return nil
+}
+
+// TableName returns the table name for GORM
+func (InterviewSlot) TableName() string {
+	return "interview_slots"
+}
@@ -0,0 +1,101 @@
+package entities
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Rating bounds for a single competency
+const (
+	MinRating = 1
+	MaxRating = 5
+)
+
+// Recommendation is an interviewer's overall hiring recommendation
+type Recommendation string
+
+const (
+	RecommendationStrongNo  Recommendation = "strong_no"
+	RecommendationNo        Recommendation = "no"
+	RecommendationYes       Recommendation = "yes"
+	RecommendationStrongYes Recommendation = "strong_yes"
+)
+
+// AllRecommendations returns every recommendation from strong no to strong yes
+func AllRecommendations() []Recommendation {
+	return []Recommendation{RecommendationStrongNo, RecommendationNo, RecommendationYes, RecommendationStrongYes}
+}
+
+// IsValid reports whether the recommendation is known
+func (r Recommendation) IsValid() bool {
+	for _, known := range AllRecommendations() {
+		if r == known {
+			return true
+		}
+	}
+	return false
+}
+
+// CompetencyRatings maps a competency to its 1-5 rating, stored as a JSON object column
+type CompetencyRatings map[string]int
+
+// Value implements driver.Valuer
+func (r CompetencyRatings) Value() (driver.Value, error) {
+	if r == nil {
+		return "{}", nil
+	}
+	data, err := json.Marshal(map[string]int(r))
+	if err != nil {
+		return nil, err
+	}
+	return string(data), nil
+}
+
+// Scan implements sql.Scanner
+func (r *CompetencyRatings) Scan(value interface{}) error {
+	var data []byte
+	switch v := value.(type) {
+	case nil:
+		*r = CompetencyRatings{}
+		return nil
+	case []byte:
+		data = v
+	case string:
+		data = []byte(v)
+	default:
+		return fmt.Errorf("cannot scan %T into CompetencyRatings", value)
+	}
+	return json.Unmarshal(data, (*map[string]int)(r))
+}
+
+// Scorecard is an interviewer's structured feedback on an application.
+// Each interviewer submits at most one scorecard per application.
+type Scorecard struct {
+	ID             string            `json:"id" gorm:"type:varchar(50);primaryKey"`
+	ApplicationID  string            `json:"application_id" gorm:"type:varchar(50);not null;uniqueIndex:idx_scorecards_application_interviewer"`
+	InterviewID    *string           `json:"interview_id,omitempty" gorm:"type:varchar(50);index"`
+	Interviewer    string            `json:"interviewer" gorm:"not null;uniqueIndex:idx_scorecards_application_interviewer"`
+	Ratings        CompetencyRatings `json:"ratings" gorm:"type:jsonb;not null"`
+	Recommendation Recommendation    `json:"recommendation" gorm:"type:varchar(20);not null"`
+	Feedback       string            `json:"feedback" gorm:"type:text"`
+	CreatedAt      time.Time         `json:"created_at"`
+	UpdatedAt      time.Time         `json:"updated_at"`
+}
+
+// BeforeCreate sets the ID if not already set
+func (s *Scorecard) BeforeCreate(tx *gorm.DB) error {
+	if s.ID == "" {
+		s.ID = uuid.New().String()
+	}
+	return nil
+}
+
+// TableName returns the table name for GORM
+func (Scorecard) TableName() string {
+	return "scorecards"
+}
@@ -0,0 +1,367 @@
+package entities
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// ScreeningField is the part of an application a screening condition looks at
+type ScreeningField string
+
+const (
+	ScreeningFieldAnswer      ScreeningField = "answer"
+	ScreeningFieldSource      ScreeningField = "source"
+	ScreeningFieldEmail       ScreeningField = "email"
+	ScreeningFieldPhone       ScreeningField = "phone"
+	ScreeningFieldCoverLetter ScreeningField = "cover_letter"
+)
+
+// IsValid reports whether the field is known
+func (f ScreeningField) IsValid() bool {
+	switch f {
+	case ScreeningFieldAnswer, ScreeningFieldSource, ScreeningFieldEmail, ScreeningFieldPhone, ScreeningFieldCoverLetter:
+		return true
+	}
+	return false
+}
+
+// ScreeningOperator compares a field with a condition's value
+type ScreeningOperator string
+
+const (
+	OperatorEquals      ScreeningOperator = "equals"
+	OperatorNotEquals   ScreeningOperator = "not_equals"
+	OperatorContains    ScreeningOperator = "contains"
+	OperatorNotContains ScreeningOperator = "not_contains"
+	OperatorLessThan    ScreeningOperator = "lt"
+	OperatorLessOrEqual ScreeningOperator = "lte"
+	OperatorGreaterThan ScreeningOperator = "gt"
+	OperatorGreaterOrEq ScreeningOperator = "gte"
+	OperatorIsEmpty     ScreeningOperator = "is_empty"
+	OperatorIsNotEmpty  ScreeningOperator = "is_not_empty"
+)
+
+// IsValid reports whether the operator is known
+func (o ScreeningOperator) IsValid() bool {
+	switch o {
+	case OperatorEquals, OperatorNotEquals, OperatorContains, OperatorNotContains,
+		OperatorLessThan, OperatorLessOrEqual, OperatorGreaterThan, OperatorGreaterOrEq,
+		OperatorIsEmpty, OperatorIsNotEmpty:
+		return true
+	}
+	return false
+}
+
+// IsNumeric reports whether the operator compares numbers
+func (o ScreeningOperator) IsNumeric() bool {
+	switch o {
+	case OperatorLessThan, OperatorLessOrEqual, OperatorGreaterThan, OperatorGreaterOrEq:
+		return true
+	}
+	return false
+}
+
+// NeedsValue reports whether the operator compares against the condition's value
+func (o ScreeningOperator) NeedsValue() bool {
+	return o != OperatorIsEmpty && o != OperatorIsNotEmpty
+}
+
+// ScreeningAction is what a screening rule does when it matches
+type ScreeningAction string
+
+const (
+	ScreeningActionTag    ScreeningAction = "tag"
+	ScreeningActionStatus ScreeningAction = "status"
+	ScreeningActionNotify ScreeningAction = "notify"
+)
+
+// IsValid reports whether the action is known
+func (a ScreeningAction) IsValid() bool {
+	switch a {
+	case ScreeningActionTag, ScreeningActionStatus, ScreeningActionNotify:
+		return true
+	}
+	return false
+}
+
+// ScreeningCondition is one test of a screening rule. Answer conditions name the question they test.
+type ScreeningCondition struct {
+	Field      ScreeningField    `json:"field"`
+	QuestionID string            `json:"question_id,omitempty"`
+	Operator   ScreeningOperator `json:"operator"`
+	Value      string            `json:"value,omitempty"`
+}
+
+// ScreeningConditions is a list of conditions stored as JSONB
+type ScreeningConditions []ScreeningCondition
+
+// Value implements driver.Valuer
+func (c ScreeningConditions) Value() (driver.Value, error) {
+	if c == nil {
+		return "[]", nil
+	}
+	data, err := json.Marshal([]ScreeningCondition(c))
+	if err != nil {
+		return nil, err
+	}
+	return string(data), nil
+}
+
+// Scan implements sql.Scanner
+func (c *ScreeningConditions) Scan(value interface{}) error {
+	var data []byte
+	switch v := value.(type) {
+	case nil:
+		*c = ScreeningConditions{}
+		return nil
+	case []byte:
+		data = v
+	case string:
+		data = []byte(v)
+	default:
+		return fmt.Errorf("cannot scan %T into ScreeningConditions", value)
+	}
+	return json.Unmarshal(data, (*[]ScreeningCondition)(c))
+}
+
+// ScreeningRule is evaluated against every new application for its position.
+// When all of its conditions hold, its action is taken.
+type ScreeningRule struct {
+	ID         string              `json:"id" gorm:"type:varchar(50);primaryKey"`
+	PositionID string              `json:"position_id" gorm:"type:varchar(100);not null;index"`
+	Name       string              `json:"name" gorm:"type:varchar(200);not null"`
+	Active     bool                `json:"active" gorm:"not null;default:true"`
+	Conditions ScreeningConditions `json:"conditions" gorm:"type:jsonb;not null"`
+	Action     ScreeningAction     `json:"action" gorm:"type:varchar(20);not null"`
+
+	// Action settings: the tag to add, the status to move to (optionally after a
+	// delay) or the address to notify (HR when empty)
+	Tag         string            `json:"tag,omitempty" gorm:"type:varchar(50)"`
+	Status      ApplicationStatus `json:"status,omitempty" gorm:"type:varchar(50)"`
+	DelayHours  int               `json:"delay_hours" gorm:"not null;default:0"`
+	NotifyEmail string            `json:"notify_email,omitempty" gorm:"type:varchar(255)"`
+
+	SortOrder int       `json:"sort_order" gorm:"not null;default:0"`
+	CreatedBy string    `json:"created_by"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// BeforeCreate sets the ID if not already set
+func (r *ScreeningRule) BeforeCreate(tx *gorm.DB) error {
+	if r.ID == "" {
+		r.ID = uuid.New().String()
+	}
+	return nil
+}
+
+// TableName returns the table name for GORM
+func (ScreeningRule) TableName() string {
+	return "screening_rules"
+}
+
+// Matches reports whether every condition of the rule holds for the application,
+// given the current questions of its position. Answers must be loaded on the application.
+func (r *ScreeningRule) Matches(application *Application, questions []*PositionQuestion) bool {
+	if len(r.Conditions) == 0 {
+		return false
+	}
+	for _, condition := range r.Conditions {
+		values, ok := conditionValues(condition, application, questions)
+		if !ok || !condition.holds(values) {
+			return false
+		}
+	}
+	return true
+}
+
+// conditionValues returns the values of the application field a condition tests.
+// It returns false for an answer condition whose question the position no longer has,
+// which must not match: otherwise is_empty and the negated operators would hold for
+// every application.
+func conditionValues(condition ScreeningCondition, application *Application, questions []*PositionQuestion) ([]string, bool) {
+	var value string
+	switch condition.Field {
+	case ScreeningFieldAnswer:
+		if findQuestion(questions, condition.QuestionID) == nil {
+			return nil, false
+		}
+		for _, answer := range application.Answers {
+			if answer.QuestionID == condition.QuestionID {
+				return answer.Values, true
+			}
+		}
+		return nil, true
+	case ScreeningFieldSource:
+		value = application.Source
+	case ScreeningFieldEmail:
+		value = application.Email
+	case ScreeningFieldPhone:
+		value = application.Phone
+	case ScreeningFieldCoverLetter:
+		value = application.CoverLetter
+	}
+	if strings.TrimSpace(value) == "" {
+		return nil, true
+	}
+	return []string{value}, true
+}
+
+func findQuestion(questions []*PositionQuestion, id string) *PositionQuestion {
+	for _, question := range questions {
+		if question.ID == id {
+			return question
+		}
+	}
+	return nil
+}
+
+// CheckQuestion returns why no answer to the question could satisfy the answer
+// condition, or nil if one could
+func (c ScreeningCondition) CheckQuestion(question *PositionQuestion) error {
+	if c.Operator.IsNumeric() && question.Type != QuestionTypeNumber {
+		return fmt.Errorf("operator %s only applies to number questions", c.Operator)
+	}
+	if c.Operator != OperatorEquals && c.Operator != OperatorNotEquals {
+		return nil
+	}
+
+	switch {
+	case question.Type == QuestionTypeYesNo:
+		if c.Value != "yes" && c.Value != "no" {
+			return fmt.Errorf("value must be yes or no")
+		}
+	case question.Type.HasOptions():
+		if !question.Options.Contains(c.Value) {
+			return fmt.Errorf("value must be one of the question's options")
+		}
+	}
+	return nil
+}
+
+// FitsQuestions reports whether every answer condition of the rule tests one of the
+// questions in a way an answer could satisfy. A rule that does not fit must not run:
+// its negated conditions would hold for every application.
+func (r *ScreeningRule) FitsQuestions(questions []*PositionQuestion) bool {
+	for _, condition := range r.Conditions {
+		if condition.Field != ScreeningFieldAnswer {
+			continue
+		}
+		question := findQuestion(questions, condition.QuestionID)
+		if question == nil || condition.CheckQuestion(question) != nil {
+			return false
+		}
+	}
+	return true
+}
+
+// holds applies the operator to the field's values. Text comparisons ignore case;
+// a field with several values (multi-choice answers) equals or contains the value
+// if any of its values does. Numeric comparisons fail on empty or non-numeric fields.
+func (c ScreeningCondition) holds(values []string) bool {
+	switch c.Operator {
+	case OperatorIsEmpty:
+		return len(values) == 0
+	case OperatorIsNotEmpty:
+		return len(values) > 0
+	case OperatorEquals:
+		return anyValue(values, func(v string) bool { return strings.EqualFold(v, c.Value) })
+	case OperatorNotEquals:
+		return !anyValue(values, func(v string) bool { return strings.EqualFold(v, c.Value) })
+	case OperatorContains:
+		return anyValue(values, func(v string) bool { return containsFold(v, c.Value) })
+	case OperatorNotContains:
+		return !anyValue(values, func(v string) bool { return containsFold(v, c.Value) })
+	}
+
+	if !c.Operator.IsNumeric() || len(values) == 0 {
+		return false
+	}
+	actual, err := strconv.ParseFloat(values[0], 64)
+	if err != nil {
+		return false
+	}
+	expected, err := strconv.ParseFloat(c.Value, 64)
+	if err != nil {
+		return false
+	}
+	switch c.Operator {
+	case OperatorLessThan:
+		return actual < expected
+	case OperatorLessOrEqual:
+		return actual <= expected
+	case OperatorGreaterThan:
+		return actual > expected
+	default:
+		return actual >= expected
+	}
+}
+
+func anyValue(values []string, match func(string) bool) bool {
+	for _, value := range values {
+		if match(value) {
+			return true
+		}
+	}
+	return false
+}
+
+func containsFold(s, substr string) bool {
+	return strings.Contains(strings.ToLower(s), strings.ToLower(substr))
+}
+
+// ScreeningOutcome records what happened when a rule fired
+type ScreeningOutcome string
+
+const (
+	// ScreeningOutcomeApplied means the action was taken
+	ScreeningOutcomeApplied ScreeningOutcome = "applied"
+	// ScreeningOutcomeScheduled means a delayed status change is waiting to be applied
+	ScreeningOutcomeScheduled ScreeningOutcome = "scheduled"
+	// ScreeningOutcomeSkipped means a delayed status change was dropped because
+	// the application had moved on in the meantime
+	ScreeningOutcomeSkipped ScreeningOutcome = "skipped"
+	// ScreeningOutcomeFailed means the action could not be taken
+	ScreeningOutcomeFailed ScreeningOutcome = "failed"
+)
+
+// ScreeningResult records that a screening rule fired for an application and what came of it.
+// The rule's name and action are copied so the record survives changes to the rule.
+type ScreeningResult struct {
+	ID            string           `json:"id" gorm:"type:varchar(50);primaryKey"`
+	ApplicationID string           `json:"application_id" gorm:"type:varchar(50);not null;index"`
+	RuleID        string           `json:"rule_id" gorm:"type:varchar(50);not null;index"`
+	RuleName      string           `json:"rule_name" gorm:"type:varchar(200);not null"`
+	Action        ScreeningAction  `json:"action" gorm:"type:varchar(20);not null"`
+	Detail        string           `json:"detail,omitempty"`
+	Outcome       ScreeningOutcome `json:"outcome" gorm:"type:varchar(20);not null;index"`
+	Error         string           `json:"error,omitempty" gorm:"type:text"`
+
+	// Delayed status changes move the application to ToStatus at DueAt if it is still in FromStatus
+	FromStatus  ApplicationStatus `json:"from_status,omitempty" gorm:"type:varchar(50)"`
+	ToStatus    ApplicationStatus `json:"to_status,omitempty" gorm:"type:varchar(50)"`
+	DueAt       *time.Time        `json:"due_at,omitempty" gorm:"index"`
+	ProcessedAt *time.Time        `json:"processed_at,omitempty"`
+
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// BeforeCreate sets the ID if not already set
+func (r *ScreeningResult) BeforeCreate(tx *gorm.DB) error {
+	if r.ID == "" {
+		r.ID = uuid.New().String()
+	}
+	return nil
+}
+
+// TableName returns the table name for GORM
+func (ScreeningResult) TableName() string {
+	return "screening_results"
+}
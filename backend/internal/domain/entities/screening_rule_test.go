@@ -0,0 +1,137 @@
+package entities
+
+import "testing"
+
+func screeningQuestions() []*PositionQuestion {
+	return []*PositionQuestion{
+		{ID: "authorized", Type: QuestionTypeYesNo},
+		{ID: "stack", Type: QuestionTypeMultiChoice, Options: StringList{"Go", "Rust", "Python"}},
+		{ID: "location", Type: QuestionTypeSingleChoice, Options: StringList{"Remote", "Office"}},
+		{ID: "years", Type: QuestionTypeNumber},
+		{ID: "portfolio", Type: QuestionTypeURL},
+	}
+}
+
+func screeningApplication() *Application {
+	return &Application{
+		Email:  "ada@example.com",
+		Source: "referral",
+		Answers: []ApplicationAnswer{
+			{QuestionID: "authorized", Values: StringList{"no"}},
+			{QuestionID: "stack", Values: StringList{"Go", "Rust"}},
+			{QuestionID: "years", Values: StringList{"4.5"}},
+			// An answer kept for a question the position no longer has
+			{QuestionID: "removed", Values: StringList{"anything"}},
+		},
+	}
+}
+
+func answerCondition(questionID string, operator ScreeningOperator, value string) ScreeningCondition {
+	return ScreeningCondition{Field: ScreeningFieldAnswer, QuestionID: questionID, Operator: operator, Value: value}
+}
+
+func TestScreeningRuleMatches(t *testing.T) {
+	tests := []struct {
+		name       string
+		conditions []ScreeningCondition
+		want       bool
+	}{
+		{"no conditions", nil, false},
+
+		{"equals ignores case", []ScreeningCondition{answerCondition("authorized", OperatorEquals, "No")}, true},
+		{"equals other value", []ScreeningCondition{answerCondition("authorized", OperatorEquals, "yes")}, false},
+		{"not equals", []ScreeningCondition{answerCondition("authorized", OperatorNotEquals, "yes")}, true},
+		{"not equals same value", []ScreeningCondition{answerCondition("authorized", OperatorNotEquals, "no")}, false},
+
+		{"multi-value equals any", []ScreeningCondition{answerCondition("stack", OperatorEquals, "rust")}, true},
+		{"multi-value equals none", []ScreeningCondition{answerCondition("stack", OperatorEquals, "Python")}, false},
+		{"multi-value not equals any", []ScreeningCondition{answerCondition("stack", OperatorNotEquals, "Go")}, false},
+		{"multi-value not equals none", []ScreeningCondition{answerCondition("stack", OperatorNotEquals, "Python")}, true},
+		{"multi-value contains", []ScreeningCondition{answerCondition("stack", OperatorContains, "us")}, true},
+		{"multi-value not contains", []ScreeningCondition{answerCondition("stack", OperatorNotContains, "py")}, true},
+		{"multi-value not contains match", []ScreeningCondition{answerCondition("stack", OperatorNotContains, "o")}, false},
+
+		{"lt", []ScreeningCondition{answerCondition("years", OperatorLessThan, "5")}, true},
+		{"lt equal", []ScreeningCondition{answerCondition("years", OperatorLessThan, "4.5")}, false},
+		{"lte equal", []ScreeningCondition{answerCondition("years", OperatorLessOrEqual, "4.5")}, true},
+		{"gt", []ScreeningCondition{answerCondition("years", OperatorGreaterThan, "4")}, true},
+		{"gt equal", []ScreeningCondition{answerCondition("years", OperatorGreaterThan, "4.5")}, false},
+		{"gte equal", []ScreeningCondition{answerCondition("years", OperatorGreaterOrEq, "4.5")}, true},
+		{"numeric on non-numeric value", []ScreeningCondition{answerCondition("years", OperatorGreaterThan, "four")}, false},
+		{"numeric on unanswered", []ScreeningCondition{answerCondition("location", OperatorLessThan, "10")}, false},
+
+		{"unanswered is empty", []ScreeningCondition{answerCondition("location", OperatorIsEmpty, "")}, true},
+		{"unanswered not equals", []ScreeningCondition{answerCondition("location", OperatorNotEquals, "Remote")}, true},
+		{"unanswered is not empty", []ScreeningCondition{answerCondition("portfolio", OperatorIsNotEmpty, "")}, false},
+
+		{"missing question equals", []ScreeningCondition{answerCondition("removed", OperatorEquals, "anything")}, false},
+		{"missing question not equals", []ScreeningCondition{answerCondition("removed", OperatorNotEquals, "x")}, false},
+		{"missing question not contains", []ScreeningCondition{answerCondition("removed", OperatorNotContains, "x")}, false},
+		{"missing question is empty", []ScreeningCondition{answerCondition("gone", OperatorIsEmpty, "")}, false},
+
+		{"source", []ScreeningCondition{{Field: ScreeningFieldSource, Operator: OperatorEquals, Value: "Referral"}}, true},
+		{"email contains", []ScreeningCondition{{Field: ScreeningFieldEmail, Operator: OperatorContains, Value: "@EXAMPLE."}}, true},
+		{"blank phone is empty", []ScreeningCondition{{Field: ScreeningFieldPhone, Operator: OperatorIsEmpty}}, true},
+
+		{
+			name: "all conditions hold",
+			conditions: []ScreeningCondition{
+				answerCondition("authorized", OperatorEquals, "no"),
+				answerCondition("years", OperatorGreaterOrEq, "2"),
+			},
+			want: true,
+		},
+		{
+			name: "one condition fails",
+			conditions: []ScreeningCondition{
+				answerCondition("authorized", OperatorEquals, "no"),
+				answerCondition("years", OperatorGreaterOrEq, "5"),
+			},
+			want: false,
+		},
+		{
+			name: "one condition on a missing question",
+			conditions: []ScreeningCondition{
+				answerCondition("authorized", OperatorEquals, "no"),
+				answerCondition("removed", OperatorNotEquals, "x"),
+			},
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule := &ScreeningRule{Conditions: tt.conditions}
+			if got := rule.Matches(screeningApplication(), screeningQuestions()); got != tt.want {
+				t.Errorf("Matches() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestScreeningRuleFitsQuestions(t *testing.T) {
+	tests := []struct {
+		name      string
+		condition ScreeningCondition
+		want      bool
+	}{
+		{"yes/no value", answerCondition("authorized", OperatorEquals, "yes"), true},
+		{"yes/no other value", answerCondition("authorized", OperatorNotEquals, "Yes"), false},
+		{"option", answerCondition("location", OperatorEquals, "Remote"), true},
+		{"removed option", answerCondition("location", OperatorNotEquals, "Hybrid"), false},
+		{"contains on options", answerCondition("stack", OperatorContains, "anything"), true},
+		{"numeric on number", answerCondition("years", OperatorGreaterThan, "3"), true},
+		{"numeric on non-number", answerCondition("portfolio", OperatorLessThan, "3"), false},
+		{"missing question", answerCondition("removed", OperatorIsEmpty, ""), false},
+		{"other field", ScreeningCondition{Field: ScreeningFieldSource, Operator: OperatorEquals, Value: "x"}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule := &ScreeningRule{Conditions: ScreeningConditions{tt.condition}}
+			if got := rule.FitsQuestions(screeningQuestions()); got != tt.want {
+				t.Errorf("FitsQuestions() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package entities
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+)
+
+// StringList is a list of strings stored as a JSON array column
+type StringList []string
+
+// Value implements driver.Valuer
+func (l StringList) Value() (driver.Value, error) {
+	if l == nil {
+		return "[]", nil
+	}
+	data, err := json.Marshal([]string(l))
+	if err != nil {
+		return nil, err
+	}
+	return string(data), nil
+}
+
+// Scan implements sql.Scanner
+func (l *StringList) Scan(value interface{}) error {
+	var data []byte
+	switch v := value.(type) {
+	case nil:
+		*l = StringList{}
+		return nil
+	case []byte:
+		data = v
+	case string:
+		data = []byte(v)
+	default:
+		return fmt.Errorf("cannot scan %T into StringList", value)
+	}
+	return json.Unmarshal(data, (*[]string)(l))
+}
+
+// Contains reports whether the list contains item
+func (l StringList) Contains(item string) bool {
+	for _, s := range l {
+		if s == item {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,56 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// SubscriberStatus represents where a newsletter subscriber is in the double opt-in
+type SubscriberStatus string
+
+const (
+	SubscriberStatusPending      SubscriberStatus = "pending"
+	SubscriberStatusConfirmed    SubscriberStatus = "confirmed"
+	SubscriberStatusUnsubscribed SubscriberStatus = "unsubscribed"
+)
+
+// Newsletter topics a subscriber can choose from
+const (
+	NewsletterTopicCompany = "company"
+	NewsletterTopicJobs    = "jobs"
+)
+
+// NewsletterTopics lists every newsletter topic
+var NewsletterTopics = []string{NewsletterTopicCompany, NewsletterTopicJobs}
+
+// Subscriber is a newsletter subscription. It only receives newsletters once the
+// address has been confirmed through the emailed link.
+type Subscriber struct {
+	ID                 string           `json:"id" gorm:"type:varchar(50);primaryKey"`
+	Email              string           `json:"email" gorm:"type:varchar(255);not null;uniqueIndex"`
+	Name               string           `json:"name"`
+	Topics             StringList       `json:"topics" gorm:"type:jsonb;not null"`
+	Status             SubscriberStatus `json:"status" gorm:"type:varchar(20);not null;default:pending;index"`
+	Source             string           `json:"source"`
+	IPAddress          string           `json:"ip_address,omitempty"`
+	ConfirmationSentAt time.Time        `json:"confirmation_sent_at"`
+	ConfirmedAt        *time.Time       `json:"confirmed_at,omitempty"`
+	UnsubscribedAt     *time.Time       `json:"unsubscribed_at,omitempty"`
+	CreatedAt          time.Time        `json:"created_at"`
+	UpdatedAt          time.Time        `json:"updated_at"`
+}
+
+// BeforeCreate sets the ID if not already set
+func (s *Subscriber) BeforeCreate(tx *gorm.DB) error {
+	if s.ID == "" {
+		s.ID = uuid.New().String()
+	}
+	return nil
+}
+
+// TableName returns the table name for GORM
+func (Subscriber) TableName() string {
+	return "subscribers"
+}
@@ -0,0 +1,43 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Tag is a recruiter-defined label such as "strong-go" or "visa-needed"
+type Tag struct {
+	ID        string    `json:"id" gorm:"type:varchar(50);primaryKey"`
+	Name      string    `json:"name" gorm:"type:varchar(50);not null;uniqueIndex"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// NormalizeTagName returns the canonical slug form of a tag name
+func NormalizeTagName(name string) string {
+	return Slugify(name)
+}
+
+// BeforeCreate sets the ID if not already set and normalizes the name
+func (t *Tag) BeforeCreate(tx *gorm.DB) error {
+	if t.ID == "" {
+		t.ID = uuid.New().String()
+	}
+	t.Name = NormalizeTagName(t.Name)
+	return nil
+}
+
+// TableName returns the table name for GORM
+func (Tag) TableName() string {
+	return "tags"
+}
+
+// TagNames returns the names of the given tags
+func TagNames(tags []Tag) []string {
+	names := make([]string, len(tags))
+	for i, tag := range tags {
+		names[i] = tag.Name
+	}
+	return names
+}
@@ -0,0 +1,64 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// TeamRole groups team members on the team page
+type TeamRole string
+
+const (
+	TeamRoleLeadership TeamRole = "leadership"
+	TeamRoleTech       TeamRole = "tech"
+	TeamRoleClient     TeamRole = "client"
+)
+
+// IsValid reports whether the role is a known team role
+func (r TeamRole) IsValid() bool {
+	switch r {
+	case TeamRoleLeadership, TeamRoleTech, TeamRoleClient:
+		return true
+	}
+	return false
+}
+
+// TeamMember is a person shown on the team page. Members are listed by SortOrder
+// and only shown to the public once published.
+type TeamMember struct {
+	ID          string        `json:"id" gorm:"type:varchar(50);primaryKey"`
+	Slug        string        `json:"slug" gorm:"type:varchar(200);not null;uniqueIndex"`
+	Name        string        `json:"name" gorm:"not null"`
+	Title       string        `json:"title" gorm:"not null"`
+	Role        TeamRole      `json:"role" gorm:"type:varchar(20);not null;index"`
+	Location    string        `json:"location"`
+	Country     string        `json:"country"`
+	Bio         string        `json:"bio" gorm:"type:text"`
+	Quote       string        `json:"quote" gorm:"type:text"`
+	Skills      StringList    `json:"skills" gorm:"type:jsonb;not null"`
+	Experience  string        `json:"experience"`
+	LinkedInURL string        `json:"linkedin_url" gorm:"column:linkedin_url"`
+	GitHubURL   string        `json:"github_url" gorm:"column:github_url"`
+	TwitterURL  string        `json:"twitter_url"`
+	Email       string        `json:"email"`
+	Photo       ImageVariants `json:"photo" gorm:"type:jsonb;not null"`
+	SortOrder   int           `json:"sort_order" gorm:"not null;default:0"`
+	Published   bool          `json:"published" gorm:"not null;default:false;index"`
+	CreatedAt   time.Time     `json:"created_at"`
+	UpdatedAt   time.Time     `json:"updated_at"`
+}
+
+// BeforeCreate sets the ID if not already set
+func (m *TeamMember) BeforeCreate(tx *gorm.DB) error {
+	if m.ID == "" {
+		m.ID = uuid.New().String()
+	}
+	return nil
+}
+
+// TableName returns the table name for GORM
+func (TeamMember) TableName() string {
+	return "team_members"
+}
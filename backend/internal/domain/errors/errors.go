@@ -8,6 +8,83 @@ var (
 	ErrApplicationAlreadyExists = errors.New("application already exists for this position")
 	ErrInvalidStatusTransition  = errors.New("invalid status transition")
 	ErrInvalidApplicationData   = errors.New("invalid application data")
+	ErrApplicationChanged       = errors.New("application status was changed by someone else")
+
+	// Candidate errors
+	ErrCandidateNotFound = errors.New("candidate not found")
+
+	// Comment errors
+	ErrCommentNotFound = errors.New("comment not found")
+
+	// Tag errors
+	ErrTagNotFound = errors.New("tag not found")
+
+	// Interview errors
+	ErrInterviewNotFound      = errors.New("interview not found")
+	ErrInterviewCancelled     = errors.New("interview has been cancelled")
+	ErrInterviewerUnavailable = errors.New("an interviewer already has an interview at that time")
+
+	// Scorecard errors
+	ErrScorecardNotFound         = errors.New("scorecard not found")
+	ErrScorecardAlreadySubmitted = errors.New("scorecard already submitted")
+
+	// Offer errors
+	ErrOfferNotFound       = errors.New("offer not found")
+	ErrOfferAlreadyPending = errors.New("application already has a pending offer")
+	ErrOfferNotPending     = errors.New("offer is no longer open")
+	ErrOfferExpired        = errors.New("offer has expired")
+
+	// Emailed link errors
+	ErrInvalidLink = errors.New("invalid link")
+	ErrLinkExpired = errors.New("link has expired")
+
+	// Candidate portal errors
+	ErrPortalLoginInvalid   = errors.New("login link is invalid or has already been used")
+	ErrPortalSessionInvalid = errors.New("portal session is invalid or has expired")
+
+	// Inquiry errors
+	ErrInquiryNotFound  = errors.New("inquiry not found")
+	ErrTooManyInquiries = errors.New("too many inquiries from this address")
+
+	// Consultation errors
+	ErrAvailabilityNotConfigured   = errors.New("consultation availability is not configured")
+	ErrConsultationSlotUnavailable = errors.New("consultation slot is no longer available")
+
+	// Blog errors
+	ErrPostNotFound  = errors.New("post not found")
+	ErrPostSlugTaken = errors.New("a post with this slug already exists")
+
+	// Team and project showcase errors
+	ErrTeamMemberNotFound  = errors.New("team member not found")
+	ErrTeamMemberSlugTaken = errors.New("a team member with this slug already exists")
+	ErrProjectNotFound     = errors.New("project not found")
+	ErrProjectSlugTaken    = errors.New("a project with this slug already exists")
+
+	// Newsletter errors
+	ErrSubscriberNotFound   = errors.New("subscriber not found")
+	ErrTooManySubscriptions = errors.New("too many subscription requests from this address")
+
+	// Referral errors
+	ErrReferralCodeNotFound = errors.New("referral code not found")
+	ErrReferralCodeTaken    = errors.New("referral code is already in use")
+	ErrSelfReferral         = errors.New("a referral code cannot be used by the employee it was issued to")
+
+	// Scheduling errors
+	ErrSchedulingLinkNotFound = errors.New("scheduling link not found")
+	ErrSchedulingLinkExpired  = errors.New("scheduling link has expired")
+	ErrSlotUnavailable        = errors.New("interview slot is no longer available")
+
+	// Position errors
+	ErrPositionNotFound      = errors.New("position not found")
+	ErrPositionAlreadyExists = errors.New("position already exists")
+	ErrPositionClosed        = errors.New("position is not accepting applications")
+	ErrInvalidAnswers        = errors.New("invalid answers to position questions")
+
+	// Screening errors
+	ErrScreeningRuleNotFound = errors.New("screening rule not found")
+
+	// Privacy errors
+	ErrErasureNotConfigured = errors.New("erasure receipts need ERASURE_HASH_SECRET to be set")
 	
 	// File upload errors
 	ErrFileNotFound         = errors.New("file not found")
@@ -8,8 +8,9 @@ import (
 
 // ApplicationRepository defines the interface for application data persistence
 type ApplicationRepository interface {
-	// Create creates a new application
-	Create(ctx context.Context, application *entities.Application) error
+	// Create creates or refreshes the candidate, then creates the application linked to them with
+	// its attribution and answers and records its initial status event, all in one transaction
+	Create(ctx context.Context, application *entities.Application, candidate *entities.Candidate) error
 	
 	// GetByID retrieves an application by its ID
 	GetByID(ctx context.Context, id string) (*entities.Application, error)
@@ -17,6 +18,16 @@ type ApplicationRepository interface {
 	// GetByEmailAndPosition retrieves an application by email and position
 	GetByEmailAndPosition(ctx context.Context, email, positionID string) (*entities.Application, error)
 	
+	// ListByEmail retrieves every application submitted with an email (matched case-insensitively),
+	// including deleted ones, oldest first, with tags, attribution and answers
+	ListByEmail(ctx context.Context, email string) ([]*entities.Application, error)
+
+	// ListByCandidate retrieves all applications linked to a candidate, newest first
+	ListByCandidate(ctx context.Context, candidateID string) ([]*entities.Application, error)
+
+	// ListByPositionAndStatus retrieves all applications for a position in the given status
+	ListByPositionAndStatus(ctx context.Context, positionID string, status entities.ApplicationStatus) ([]*entities.Application, error)
+
 	// List retrieves applications with filters and pagination
 	List(ctx context.Context, filter ApplicationFilter) ([]*entities.Application, int64, error)
 	
@@ -26,8 +37,13 @@ type ApplicationRepository interface {
 	// Delete deletes an application by ID
 	Delete(ctx context.Context, id string) error
 	
-	// UpdateStatus updates the status of an application
-	UpdateStatus(ctx context.Context, id string, status entities.ApplicationStatus, processedBy string) error
+	// UpdateWithStatusEvent saves the application's new status and its status event in one
+	// transaction. It returns ErrApplicationChanged, saving nothing, if the application is
+	// no longer in the status the event starts from.
+	UpdateWithStatusEvent(ctx context.Context, application *entities.Application, event *entities.ApplicationStatusEvent) error
+
+	// ListStatusEvents retrieves the status history of an application, oldest first
+	ListStatusEvents(ctx context.Context, applicationID string) ([]*entities.ApplicationStatusEvent, error)
 }
 
 // ApplicationFilter represents filters for listing applications
@@ -38,6 +54,10 @@ type ApplicationFilter struct {
 	DateFrom   string                       `json:"date_from,omitempty"`
 	DateTo     string                       `json:"date_to,omitempty"`
 	
+	// Tags restricts results to applications carrying any (default) or all of the tags
+	Tags     []string `json:"tags,omitempty"`
+	TagMatch string   `json:"tag_match,omitempty" validate:"omitempty,oneof=any all"`
+
 	// Pagination
 	Page     int `json:"page" validate:"min=1"`
 	PageSize int `json:"page_size" validate:"min=1,max=100"`
@@ -47,6 +67,12 @@ type ApplicationFilter struct {
 	SortOrder string `json:"sort_order,omitempty" validate:"omitempty,oneof=asc desc"`
 }
 
+// Tag match modes for ApplicationFilter.TagMatch
+const (
+	TagMatchAny = "any"
+	TagMatchAll = "all"
+)
+
 // DefaultFilter returns a default application filter
 func DefaultFilter() ApplicationFilter {
 	return ApplicationFilter{
@@ -54,5 +80,6 @@ func DefaultFilter() ApplicationFilter {
 		PageSize:  20,
 		SortBy:    "created_at",
 		SortOrder: "desc",
+		TagMatch:  TagMatchAny,
 	}
 } 
\ No newline at end of file
@@ -0,0 +1,16 @@
+package repositories
+
+import (
+	"context"
+
+	"super2025-backend/internal/domain/entities"
+)
+
+// CandidateRepository defines the interface for candidate data persistence
+type CandidateRepository interface {
+	// GetByID retrieves a candidate by its ID
+	GetByID(ctx context.Context, id string) (*entities.Candidate, error)
+
+	// GetByEmail retrieves a candidate by email (normalized before lookup)
+	GetByEmail(ctx context.Context, email string) (*entities.Candidate, error)
+}
@@ -0,0 +1,25 @@
+package repositories
+
+import (
+	"context"
+
+	"super2025-backend/internal/domain/entities"
+)
+
+// CommentRepository defines the interface for reviewer comment persistence
+type CommentRepository interface {
+	// Create creates a new comment
+	Create(ctx context.Context, comment *entities.ApplicationComment) error
+
+	// GetByID retrieves a comment of an application by its ID
+	GetByID(ctx context.Context, applicationID, id string) (*entities.ApplicationComment, error)
+
+	// ListByApplication retrieves all comments of an application, oldest first
+	ListByApplication(ctx context.Context, applicationID string) ([]*entities.ApplicationComment, error)
+
+	// Update updates an existing comment
+	Update(ctx context.Context, comment *entities.ApplicationComment) error
+
+	// Delete deletes a comment and its replies
+	Delete(ctx context.Context, applicationID, id string) error
+}
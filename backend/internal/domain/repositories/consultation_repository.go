@@ -0,0 +1,24 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"super2025-backend/internal/domain/entities"
+)
+
+// ConsultationRepository defines the interface for consultation booking persistence
+type ConsultationRepository interface {
+	// GetAvailability retrieves the weekly availability, or ErrAvailabilityNotConfigured
+	GetAvailability(ctx context.Context) (*entities.ConsultationAvailability, error)
+
+	// SaveAvailability creates or replaces the weekly availability
+	SaveAvailability(ctx context.Context, availability *entities.ConsultationAvailability) error
+
+	// ListBetween retrieves the consultations overlapping [from, to), earliest first
+	ListBetween(ctx context.Context, from, to time.Time) ([]*entities.Consultation, error)
+
+	// Book atomically stores a consultation. It returns ErrConsultationSlotUnavailable
+	// if another consultation overlaps it.
+	Book(ctx context.Context, consultation *entities.Consultation) error
+}
@@ -0,0 +1,16 @@
+package repositories
+
+import (
+	"context"
+
+	"super2025-backend/internal/domain/entities"
+)
+
+// EmailLogRepository defines the interface for the log of sent emails
+type EmailLogRepository interface {
+	// Create records a sent email
+	Create(ctx context.Context, entry *entities.EmailLog) error
+
+	// ListByRecipient retrieves the emails sent to an address (normalized before lookup), oldest first
+	ListByRecipient(ctx context.Context, email string) ([]*entities.EmailLog, error)
+}
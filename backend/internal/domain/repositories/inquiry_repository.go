@@ -0,0 +1,33 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"super2025-backend/internal/domain/entities"
+)
+
+// InquiryRepository defines the interface for contact inquiry persistence
+type InquiryRepository interface {
+	// Create stores a new inquiry
+	Create(ctx context.Context, inquiry *entities.Inquiry) error
+
+	// GetByID retrieves an inquiry by its ID
+	GetByID(ctx context.Context, id string) (*entities.Inquiry, error)
+
+	// List retrieves inquiries, newest first, optionally filtered by status
+	List(ctx context.Context, filter InquiryFilter) ([]*entities.Inquiry, int64, error)
+
+	// CountByIPSince counts the inquiries sent from an IP address since the given time
+	CountByIPSince(ctx context.Context, ipAddress string, since time.Time) (int64, error)
+
+	// UpdateStatus sets the status of an inquiry and who changed it
+	UpdateStatus(ctx context.Context, id string, status entities.InquiryStatus, handledBy string) error
+}
+
+// InquiryFilter represents filters for listing inquiries
+type InquiryFilter struct {
+	Status   entities.InquiryStatus
+	Page     int
+	PageSize int
+}
@@ -0,0 +1,24 @@
+package repositories
+
+import (
+	"context"
+
+	"super2025-backend/internal/domain/entities"
+)
+
+// InterviewRepository defines the interface for interview persistence
+type InterviewRepository interface {
+	// Create creates a new interview. It returns ErrInterviewerUnavailable if an
+	// interviewer has another scheduled interview overlapping it.
+	Create(ctx context.Context, interview *entities.Interview) error
+
+	// GetByID retrieves an interview by its ID
+	GetByID(ctx context.Context, id string) (*entities.Interview, error)
+
+	// ListByApplication retrieves the interviews of an application, earliest first
+	ListByApplication(ctx context.Context, applicationID string) ([]*entities.Interview, error)
+
+	// Update updates an existing interview. It returns ErrInterviewerUnavailable if the
+	// interview is scheduled and an interviewer has another one overlapping it.
+	Update(ctx context.Context, interview *entities.Interview) error
+}
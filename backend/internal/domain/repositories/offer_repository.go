@@ -0,0 +1,31 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"super2025-backend/internal/domain/entities"
+)
+
+// OfferRepository defines the interface for job offer persistence
+type OfferRepository interface {
+	// Create creates a new offer and, if move is not nil, saves the application's status move
+	// in the same transaction. It returns false, saving nothing, if the application is no
+	// longer in the status the move starts from.
+	Create(ctx context.Context, offer *entities.Offer, move *StatusMove) (bool, error)
+
+	// GetByID retrieves an offer by its ID
+	GetByID(ctx context.Context, id string) (*entities.Offer, error)
+
+	// ListByApplication retrieves the offers of an application, newest first
+	ListByApplication(ctx context.Context, applicationID string) ([]*entities.Offer, error)
+
+	// ListExpired retrieves pending offers whose deadline has passed at now
+	ListExpired(ctx context.Context, now time.Time) ([]*entities.Offer, error)
+
+	// Resolve saves a resolved offer if it is still pending and, if move is not nil, saves
+	// the application's status move in the same transaction. It returns false, saving
+	// nothing, if the offer was resolved concurrently (e.g. accepted while expiring) or the
+	// application is no longer in the status the move starts from.
+	Resolve(ctx context.Context, offer *entities.Offer, move *StatusMove) (bool, error)
+}
@@ -0,0 +1,24 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"super2025-backend/internal/domain/entities"
+)
+
+// PortalRepository defines the interface for candidate portal session persistence
+type PortalRepository interface {
+	// Create stores a new, unredeemed login
+	Create(ctx context.Context, session *entities.PortalSession) error
+
+	// CountLoginsSince counts the logins requested for a candidate since the given time
+	CountLoginsSince(ctx context.Context, candidateID string, since time.Time) (int64, error)
+
+	// Redeem atomically turns an unused, unexpired login into a session valid until
+	// expiresAt. It returns ErrPortalLoginInvalid if the login cannot be redeemed.
+	Redeem(ctx context.Context, loginTokenHash, sessionTokenHash string, expiresAt time.Time) (*entities.PortalSession, error)
+
+	// GetActiveSession retrieves an unexpired session by token hash
+	GetActiveSession(ctx context.Context, sessionTokenHash string) (*entities.PortalSession, error)
+}
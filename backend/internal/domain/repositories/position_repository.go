@@ -0,0 +1,64 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"super2025-backend/internal/domain/entities"
+)
+
+// StatusMove is an application already moved to its new status, with the event recording the change
+type StatusMove struct {
+	Application *entities.Application
+	Event       *entities.ApplicationStatusEvent
+}
+
+// PositionRepository defines the interface for position data persistence
+type PositionRepository interface {
+	// Create creates a new position
+	Create(ctx context.Context, position *entities.Position) error
+
+	// GetByID retrieves a position by its ID
+	GetByID(ctx context.Context, id string) (*entities.Position, error)
+
+	// List retrieves positions with filters and pagination
+	List(ctx context.Context, filter PositionFilter) ([]*entities.Position, int64, error)
+
+	// Update updates an existing position
+	Update(ctx context.Context, position *entities.Position) error
+
+	// Delete deletes a position by ID
+	Delete(ctx context.Context, id string) error
+
+	// ListDueForSchedule retrieves draft positions due to open and open positions due to close
+	ListDueForSchedule(ctx context.Context, now time.Time) ([]*entities.Position, error)
+
+	// Close closes an open position, saves the status moves of its applications and records
+	// the closure in one transaction. A move is skipped if its application is no longer in
+	// the status the move starts from. It returns the moves saved, and false if the
+	// position was already closed, in which case nothing is saved.
+	Close(ctx context.Context, closure *entities.PositionClosure, moves []StatusMove) ([]StatusMove, bool, error)
+
+	// ListClosures retrieves the closures recorded for a position, newest first
+	ListClosures(ctx context.Context, positionID string) ([]*entities.PositionClosure, error)
+
+	// ListQuestions retrieves the custom application questions of a position in display order
+	ListQuestions(ctx context.Context, positionID string) ([]*entities.PositionQuestion, error)
+
+	// ReplaceQuestions saves the given questions as the position's complete question set.
+	// Questions of the position that are not in the set are deleted, and active screening
+	// rules that no longer fit the saved questions are deactivated. It returns the number
+	// of rules deactivated.
+	ReplaceQuestions(ctx context.Context, positionID string, questions []*entities.PositionQuestion) (int, error)
+}
+
+// PositionFilter represents filters for listing positions
+type PositionFilter struct {
+	Status         entities.PositionStatus `json:"status,omitempty"`
+	Department     string                  `json:"department,omitempty"`
+	EmploymentType entities.EmploymentType `json:"employment_type,omitempty"`
+
+	// Pagination
+	Page     int `json:"page" validate:"min=1"`
+	PageSize int `json:"page_size" validate:"min=1,max=100"`
+}
@@ -0,0 +1,48 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"super2025-backend/internal/domain/entities"
+)
+
+// PostRepository defines the interface for blog post persistence
+type PostRepository interface {
+	// Create creates a new post
+	Create(ctx context.Context, post *entities.Post) error
+
+	// GetByID retrieves a post by its ID
+	GetByID(ctx context.Context, id string) (*entities.Post, error)
+
+	// GetBySlug retrieves a post by its slug
+	GetBySlug(ctx context.Context, slug string) (*entities.Post, error)
+
+	// SlugExists reports whether a post other than excludeID uses the slug
+	SlugExists(ctx context.Context, slug, excludeID string) (bool, error)
+
+	// List retrieves posts with filters and pagination, newest first
+	List(ctx context.Context, filter PostFilter) ([]*entities.Post, int64, error)
+
+	// ListCategories retrieves the categories of the posts matching the filter with their post counts
+	ListCategories(ctx context.Context, filter PostFilter) ([]*entities.PostCategory, error)
+
+	// Update updates an existing post
+	Update(ctx context.Context, post *entities.Post) error
+
+	// Delete deletes a post by ID
+	Delete(ctx context.Context, id string) error
+}
+
+// PostFilter represents filters for listing posts
+type PostFilter struct {
+	Status       entities.PostStatus
+	CategorySlug string
+
+	// LiveAt restricts the results to posts published at or before this time
+	LiveAt *time.Time
+
+	// Pagination
+	Page     int
+	PageSize int
+}
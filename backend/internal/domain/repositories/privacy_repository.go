@@ -0,0 +1,36 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"super2025-backend/internal/domain/entities"
+)
+
+// PrivacyRepository defines the interface for erasing personal data and keeping erasure receipts
+type PrivacyRepository interface {
+	// Erase anonymizes the given applications, deletes the candidate, the personal data
+	// attached to them and the emails logged for the address, and appends the receipt
+	// to the chain, all in one transaction. The receipt's counts and chain fields are filled in.
+	Erase(ctx context.Context, email string, candidateID *string, applicationIDs []string, receipt *entities.ErasureReceipt) error
+
+	// ListReceipts retrieves erasure receipts in sequence order, optionally only those for a subject hash
+	ListReceipts(ctx context.Context, subjectHash string) ([]*entities.ErasureReceipt, error)
+
+	// ListRetentionDue retrieves the applications a retention rule applies to as of before,
+	// deleted ones included, oldest first. A limit of 0 returns them all.
+	ListRetentionDue(ctx context.Context, rule entities.RetentionRule, before time.Time, limit int) ([]*entities.Application, error)
+
+	// AnonymizeApplications erases the personal data of applications as Erase does, and
+	// deletes candidates and email log entries no other application refers to.
+	// Returns the number of applications anonymized.
+	AnonymizeApplications(ctx context.Context, applicationIDs []string, erasedAt time.Time) (int, error)
+
+	// PurgeApplications permanently deletes applications and everything recorded about them,
+	// and candidates and email log entries no other application refers to.
+	// Returns the number of applications deleted.
+	PurgeApplications(ctx context.Context, applicationIDs []string) (int, error)
+
+	// ClearApplicationMetadata clears the IP address and user agent of applications
+	ClearApplicationMetadata(ctx context.Context, applicationIDs []string) (int, error)
+}
@@ -0,0 +1,40 @@
+package repositories
+
+import (
+	"context"
+
+	"super2025-backend/internal/domain/entities"
+)
+
+// ProjectRepository defines the interface for showcase project persistence
+type ProjectRepository interface {
+	// Create creates a new project
+	Create(ctx context.Context, project *entities.Project) error
+
+	// GetByID retrieves a project by its ID
+	GetByID(ctx context.Context, id string) (*entities.Project, error)
+
+	// GetBySlug retrieves a project by its slug
+	GetBySlug(ctx context.Context, slug string) (*entities.Project, error)
+
+	// SlugExists reports whether a project other than excludeID uses the slug
+	SlugExists(ctx context.Context, slug, excludeID string) (bool, error)
+
+	// List retrieves projects in display order
+	List(ctx context.Context, filter ProjectFilter) ([]*entities.Project, error)
+
+	// Update updates an existing project
+	Update(ctx context.Context, project *entities.Project) error
+
+	// Reorder sets the sort order of the given projects to their position in ids
+	Reorder(ctx context.Context, ids []string) error
+
+	// Delete deletes a project by ID
+	Delete(ctx context.Context, id string) error
+}
+
+// ProjectFilter represents filters for listing projects
+type ProjectFilter struct {
+	Category      string
+	PublishedOnly bool
+}
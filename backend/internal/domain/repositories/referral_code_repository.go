@@ -0,0 +1,28 @@
+package repositories
+
+import (
+	"context"
+
+	"super2025-backend/internal/domain/entities"
+)
+
+// ReferralCodeRepository defines the interface for employee referral code persistence
+type ReferralCodeRepository interface {
+	// Create creates a new referral code
+	Create(ctx context.Context, code *entities.ReferralCode) error
+
+	// GetByID retrieves a referral code by its ID
+	GetByID(ctx context.Context, id string) (*entities.ReferralCode, error)
+
+	// GetByCode retrieves a referral code by its normalized code
+	GetByCode(ctx context.Context, code string) (*entities.ReferralCode, error)
+
+	// CodeExists reports whether a code has already been issued
+	CodeExists(ctx context.Context, code string) (bool, error)
+
+	// List retrieves referral codes, optionally only active ones, ordered by employee name
+	List(ctx context.Context, activeOnly bool) ([]*entities.ReferralCode, error)
+
+	// Update updates an existing referral code
+	Update(ctx context.Context, code *entities.ReferralCode) error
+}
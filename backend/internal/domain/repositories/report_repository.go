@@ -0,0 +1,95 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"super2025-backend/internal/domain/entities"
+)
+
+// ReportRepository defines the interface for reporting queries
+type ReportRepository interface {
+	// TimeInStage aggregates how long applications spend in each status
+	TimeInStage(ctx context.Context, filter ReportFilter) ([]*StageStats, error)
+
+	// ReferralBonuses lists referred applications that reached the offered stage.
+	// The date range applies to when the application was first offered.
+	ReferralBonuses(ctx context.Context, filter ReportFilter) ([]*ReferralBonus, error)
+
+	// SourceEffectiveness counts applications per source and how many of them
+	// reached the interview, offered and hired stages
+	SourceEffectiveness(ctx context.Context, filter ReportFilter, groupBy SourceGrouping) ([]*SourceStats, error)
+}
+
+// SourceGrouping selects what the source-effectiveness report groups applications by
+type SourceGrouping string
+
+const (
+	// SourceGroupingSource groups by the application's source (UTM source, referring site, referral or direct)
+	SourceGroupingSource SourceGrouping = "source"
+	// SourceGroupingMedium groups by UTM medium
+	SourceGroupingMedium SourceGrouping = "medium"
+	// SourceGroupingCampaign groups by UTM campaign
+	SourceGroupingCampaign SourceGrouping = "campaign"
+)
+
+// IsValid reports whether the grouping is supported
+func (g SourceGrouping) IsValid() bool {
+	switch g {
+	case SourceGroupingSource, SourceGroupingMedium, SourceGroupingCampaign:
+		return true
+	}
+	return false
+}
+
+// ReportFilter restricts the applications included in a report
+type ReportFilter struct {
+	PositionID string `json:"position_id,omitempty"`
+	DateFrom   string `json:"date_from,omitempty"`
+	DateTo     string `json:"date_to,omitempty"`
+}
+
+// StageStats holds aggregated time-in-stage figures for one status
+type StageStats struct {
+	Status entities.ApplicationStatus `json:"status"`
+
+	// Completed counts stages that have been left; Open counts applications still in the stage
+	Completed int64 `json:"completed"`
+	Open      int64 `json:"open"`
+
+	// Durations of completed stages, in seconds
+	AvgSeconds float64 `json:"avg_seconds"`
+	MinSeconds float64 `json:"min_seconds"`
+	MaxSeconds float64 `json:"max_seconds"`
+}
+
+// ReferralBonus is one referred application that reached the offered stage
+type ReferralBonus struct {
+	ReferralCodeID string `json:"referral_code_id"`
+	Code           string `json:"code"`
+	EmployeeName   string `json:"employee_name"`
+	EmployeeEmail  string `json:"employee_email"`
+
+	ApplicationID string                     `json:"application_id"`
+	CandidateName string                     `json:"candidate_name"`
+	PositionID    string                     `json:"position_id"`
+	PositionTitle string                     `json:"position_title"`
+	Status        entities.ApplicationStatus `json:"status"`
+	OfferedAt     time.Time                  `json:"offered_at"`
+}
+
+// SourceStats holds the funnel of applications attributed to one source
+type SourceStats struct {
+	Source       string `json:"source"`
+	Applications int64  `json:"applications"`
+
+	// Applications that reached each stage, at any point
+	Interviews int64 `json:"interviews"`
+	Offers     int64 `json:"offers"`
+	Hires      int64 `json:"hires"`
+
+	// Share of applications that reached the stage, between 0 and 1
+	InterviewRate float64 `json:"interview_rate" gorm:"-"`
+	OfferRate     float64 `json:"offer_rate" gorm:"-"`
+	HireRate      float64 `json:"hire_rate" gorm:"-"`
+}
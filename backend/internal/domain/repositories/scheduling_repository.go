@@ -0,0 +1,24 @@
+package repositories
+
+import (
+	"context"
+
+	"super2025-backend/internal/domain/entities"
+)
+
+// SchedulingRepository defines the interface for self-scheduling persistence
+type SchedulingRepository interface {
+	// CreateInvitation creates an invitation together with its slots
+	CreateInvitation(ctx context.Context, invitation *entities.SchedulingInvitation) error
+
+	// GetInvitationByTokenHash retrieves an invitation and its slots by token hash
+	GetInvitationByTokenHash(ctx context.Context, tokenHash string) (*entities.SchedulingInvitation, error)
+
+	// ListInvitationsByApplication retrieves the invitations of an application, newest first
+	ListInvitationsByApplication(ctx context.Context, applicationID string) ([]*entities.SchedulingInvitation, error)
+
+	// BookSlot atomically claims a slot of an invitation and creates the interview for it.
+	// It returns ErrSlotUnavailable if the invitation was already used, the slot was
+	// taken, or an interviewer already has an interview at that time.
+	BookSlot(ctx context.Context, invitationID, slotID string, interview *entities.Interview) error
+}
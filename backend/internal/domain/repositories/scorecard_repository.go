@@ -0,0 +1,22 @@
+package repositories
+
+import (
+	"context"
+
+	"super2025-backend/internal/domain/entities"
+)
+
+// ScorecardRepository defines the interface for interview scorecard persistence
+type ScorecardRepository interface {
+	// Create creates a new scorecard
+	Create(ctx context.Context, scorecard *entities.Scorecard) error
+
+	// GetByID retrieves a scorecard of an application by its ID
+	GetByID(ctx context.Context, applicationID, id string) (*entities.Scorecard, error)
+
+	// ListByApplication retrieves all scorecards of an application, oldest first
+	ListByApplication(ctx context.Context, applicationID string) ([]*entities.Scorecard, error)
+
+	// Update updates an existing scorecard
+	Update(ctx context.Context, scorecard *entities.Scorecard) error
+}
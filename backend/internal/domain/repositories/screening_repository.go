@@ -0,0 +1,38 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"super2025-backend/internal/domain/entities"
+)
+
+// ScreeningRepository defines the interface for screening rule and result persistence
+type ScreeningRepository interface {
+	// CreateRule creates a new screening rule
+	CreateRule(ctx context.Context, rule *entities.ScreeningRule) error
+
+	// GetRule retrieves a screening rule by its ID
+	GetRule(ctx context.Context, id string) (*entities.ScreeningRule, error)
+
+	// ListRules retrieves the rules of a position in evaluation order, optionally only active ones
+	ListRules(ctx context.Context, positionID string, activeOnly bool) ([]*entities.ScreeningRule, error)
+
+	// UpdateRule updates an existing screening rule
+	UpdateRule(ctx context.Context, rule *entities.ScreeningRule) error
+
+	// DeleteRule deletes a screening rule; results it produced are kept
+	DeleteRule(ctx context.Context, id string) error
+
+	// CreateResults records the rules that fired for an application
+	CreateResults(ctx context.Context, results []*entities.ScreeningResult) error
+
+	// ListResults retrieves the screening results of an application, oldest first
+	ListResults(ctx context.Context, applicationID string) ([]*entities.ScreeningResult, error)
+
+	// ListDueResults retrieves scheduled status changes that are due at now
+	ListDueResults(ctx context.Context, now time.Time) ([]*entities.ScreeningResult, error)
+
+	// UpdateResult updates a screening result
+	UpdateResult(ctx context.Context, result *entities.ScreeningResult) error
+}
@@ -0,0 +1,29 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"super2025-backend/internal/domain/entities"
+)
+
+// SubscriberRepository defines the interface for newsletter subscriber persistence
+type SubscriberRepository interface {
+	// Create creates a new subscriber
+	Create(ctx context.Context, subscriber *entities.Subscriber) error
+
+	// GetByID retrieves a subscriber by its ID
+	GetByID(ctx context.Context, id string) (*entities.Subscriber, error)
+
+	// GetByEmail retrieves a subscriber by email address
+	GetByEmail(ctx context.Context, email string) (*entities.Subscriber, error)
+
+	// ListConfirmed retrieves confirmed subscribers, optionally only those who chose topic
+	ListConfirmed(ctx context.Context, topic string) ([]*entities.Subscriber, error)
+
+	// CountConfirmationsByIPSince counts confirmation emails requested from an IP address since the given time
+	CountConfirmationsByIPSince(ctx context.Context, ipAddress string, since time.Time) (int64, error)
+
+	// Update updates an existing subscriber
+	Update(ctx context.Context, subscriber *entities.Subscriber) error
+}
@@ -0,0 +1,35 @@
+package repositories
+
+import (
+	"context"
+
+	"super2025-backend/internal/domain/entities"
+)
+
+// TagRepository defines the interface for tag persistence
+type TagRepository interface {
+	// FindOrCreate returns the tags with the given normalized names, creating missing ones
+	FindOrCreate(ctx context.Context, names []string) ([]*entities.Tag, error)
+
+	// GetByName retrieves a tag by its normalized name
+	GetByName(ctx context.Context, name string) (*entities.Tag, error)
+
+	// List retrieves all tags with the number of applications using each
+	List(ctx context.Context) ([]*TagUsage, error)
+
+	// AddToApplication links the tags to an application; existing links are kept
+	AddToApplication(ctx context.Context, applicationID string, tags []*entities.Tag) error
+
+	// RemoveFromApplication unlinks a tag from an application
+	RemoveFromApplication(ctx context.Context, applicationID string, tag *entities.Tag) error
+
+	// ListByApplication retrieves the tags of an application
+	ListByApplication(ctx context.Context, applicationID string) ([]*entities.Tag, error)
+}
+
+// TagUsage is a tag together with the number of applications carrying it
+type TagUsage struct {
+	ID               string `json:"id"`
+	Name             string `json:"name"`
+	ApplicationCount int64  `json:"application_count"`
+}
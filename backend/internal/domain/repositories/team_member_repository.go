@@ -0,0 +1,40 @@
+package repositories
+
+import (
+	"context"
+
+	"super2025-backend/internal/domain/entities"
+)
+
+// TeamMemberRepository defines the interface for team member persistence
+type TeamMemberRepository interface {
+	// Create creates a new team member
+	Create(ctx context.Context, member *entities.TeamMember) error
+
+	// GetByID retrieves a team member by its ID
+	GetByID(ctx context.Context, id string) (*entities.TeamMember, error)
+
+	// GetBySlug retrieves a team member by its slug
+	GetBySlug(ctx context.Context, slug string) (*entities.TeamMember, error)
+
+	// SlugExists reports whether a team member other than excludeID uses the slug
+	SlugExists(ctx context.Context, slug, excludeID string) (bool, error)
+
+	// List retrieves team members in display order
+	List(ctx context.Context, filter TeamMemberFilter) ([]*entities.TeamMember, error)
+
+	// Update updates an existing team member
+	Update(ctx context.Context, member *entities.TeamMember) error
+
+	// Reorder sets the sort order of the given team members to their position in ids
+	Reorder(ctx context.Context, ids []string) error
+
+	// Delete deletes a team member by ID
+	Delete(ctx context.Context, id string) error
+}
+
+// TeamMemberFilter represents filters for listing team members
+type TeamMemberFilter struct {
+	Role          entities.TeamRole
+	PublishedOnly bool
+}
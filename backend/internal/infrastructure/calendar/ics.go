@@ -0,0 +1,130 @@
+package calendar
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Method is the iTIP method of a calendar message (RFC 5546)
+type Method string
+
+const (
+	MethodRequest Method = "REQUEST"
+	MethodCancel  Method = "CANCEL"
+)
+
+// Attendee is a participant invited to an event
+type Attendee struct {
+	Name  string
+	Email string
+}
+
+// Event describes a single calendar event to be rendered as an iCalendar object.
+// The same UID with an increasing Sequence updates or cancels a previously sent event.
+type Event struct {
+	UID         string
+	Sequence    int
+	Method      Method
+	Summary     string
+	Description string
+	Location    string
+	URL         string
+	Start       time.Time
+	End         time.Time
+	Organizer   Attendee
+	Attendees   []Attendee
+}
+
+// icsTimeFormat is the UTC DATE-TIME form from RFC 5545 section 3.3.5
+const icsTimeFormat = "20060102T150405Z"
+
+// Render produces an RFC 5545 iCalendar object for the event
+func (e Event) Render() []byte {
+	method := e.Method
+	if method == "" {
+		method = MethodRequest
+	}
+	status := "CONFIRMED"
+	if method == MethodCancel {
+		status = "CANCELLED"
+	}
+
+	var buf bytes.Buffer
+	w := &lineWriter{buf: &buf}
+
+	w.line("BEGIN:VCALENDAR")
+	w.line("VERSION:2.0")
+	w.line("PRODID:-//Super 2025//Careers//EN")
+	w.line("CALSCALE:GREGORIAN")
+	w.line("METHOD:" + string(method))
+	w.line("BEGIN:VEVENT")
+	w.line("UID:" + e.UID)
+	w.line(fmt.Sprintf("SEQUENCE:%d", e.Sequence))
+	w.line("DTSTAMP:" + time.Now().UTC().Format(icsTimeFormat))
+	w.line("DTSTART:" + e.Start.UTC().Format(icsTimeFormat))
+	w.line("DTEND:" + e.End.UTC().Format(icsTimeFormat))
+	w.line("SUMMARY:" + escapeText(e.Summary))
+	if e.Description != "" {
+		w.line("DESCRIPTION:" + escapeText(e.Description))
+	}
+	if e.Location != "" {
+		w.line("LOCATION:" + escapeText(e.Location))
+	}
+	if e.URL != "" {
+		w.line("URL:" + e.URL)
+	}
+	w.line("STATUS:" + status)
+	w.line(fmt.Sprintf("ORGANIZER;CN=%s:mailto:%s", quoteParam(e.Organizer.Name), e.Organizer.Email))
+	for _, attendee := range e.Attendees {
+		w.line(fmt.Sprintf("ATTENDEE;CN=%s;ROLE=REQ-PARTICIPANT;PARTSTAT=NEEDS-ACTION;RSVP=TRUE:mailto:%s",
+			quoteParam(attendee.Name), attendee.Email))
+	}
+	w.line("END:VEVENT")
+	w.line("END:VCALENDAR")
+
+	return buf.Bytes()
+}
+
+// lineWriter writes content lines folded at 75 octets and terminated by CRLF
+type lineWriter struct {
+	buf *bytes.Buffer
+}
+
+// line writes a single content line, folding it as required by RFC 5545 section 3.1
+func (w *lineWriter) line(content string) {
+	// Continuation lines start with a space, which counts towards the limit
+	limit := 75
+	for len(content) > limit {
+		cut := limit
+		// Do not split a multi-byte UTF-8 sequence
+		for cut > 0 && content[cut]&0xC0 == 0x80 {
+			cut--
+		}
+		w.buf.WriteString(content[:cut])
+		w.buf.WriteString("\r\n ")
+		content = content[cut:]
+		limit = 74
+	}
+	w.buf.WriteString(content)
+	w.buf.WriteString("\r\n")
+}
+
+// escapeText escapes a TEXT value (RFC 5545 section 3.3.11)
+func escapeText(value string) string {
+	replacer := strings.NewReplacer(
+		"\\", "\\\\",
+		";", "\\;",
+		",", "\\,",
+		"\r\n", "\\n",
+		"\n", "\\n",
+	)
+	return replacer.Replace(value)
+}
+
+// quoteParam quotes a parameter value so names with commas or colons stay intact
+func quoteParam(value string) string {
+	value = strings.ReplaceAll(value, "\"", "'")
+	return "\"" + value + "\""
+}
@@ -0,0 +1,193 @@
+package calendar
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+)
+
+func testEvent() Event {
+	start := time.Date(2025, 6, 2, 15, 0, 0, 0, time.FixedZone("CEST", 2*60*60))
+	return Event{
+		UID:       "interview-1@super2025",
+		Sequence:  0,
+		Summary:   "Interview",
+		Start:     start,
+		End:       start.Add(time.Hour),
+		Organizer: Attendee{Name: "Recruiting", Email: "hr@example.com"},
+		Attendees: []Attendee{{Name: "Ada Lovelace", Email: "ada@example.com"}},
+	}
+}
+
+// unfold joins folded lines back into content lines
+func unfold(ics []byte) []string {
+	text := strings.ReplaceAll(string(ics), "\r\n ", "")
+	return strings.Split(strings.TrimSuffix(text, "\r\n"), "\r\n")
+}
+
+func hasLine(lines []string, want string) bool {
+	for _, line := range lines {
+		if line == want {
+			return true
+		}
+	}
+	return false
+}
+
+func TestLineWriterFolding(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    []string
+	}{
+		{
+			name:    "short line",
+			content: "SUMMARY:Interview",
+			want:    []string{"SUMMARY:Interview"},
+		},
+		{
+			name:    "exactly 75 octets",
+			content: strings.Repeat("a", 75),
+			want:    []string{strings.Repeat("a", 75)},
+		},
+		{
+			name:    "76 octets",
+			content: strings.Repeat("a", 76),
+			want:    []string{strings.Repeat("a", 75), " a"},
+		},
+		{
+			name:    "continuation lines hold 74 octets",
+			content: strings.Repeat("a", 75+74+1),
+			want:    []string{strings.Repeat("a", 75), " " + strings.Repeat("a", 74), " a"},
+		},
+		{
+			// "€" is three octets and would straddle the fold at octet 75
+			name:    "multibyte character across octet 75",
+			content: strings.Repeat("a", 74) + "€b",
+			want:    []string{strings.Repeat("a", 74), " €b"},
+		},
+		{
+			name:    "multibyte character ending at octet 75",
+			content: strings.Repeat("a", 72) + "€b",
+			want:    []string{strings.Repeat("a", 72) + "€", " b"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			w := &lineWriter{buf: &buf}
+			w.line(tt.content)
+
+			want := strings.Join(tt.want, "\r\n") + "\r\n"
+			if got := buf.String(); got != want {
+				t.Errorf("line(%q) = %q, want %q", tt.content, got, want)
+			}
+		})
+	}
+}
+
+func TestRenderFoldsLongLines(t *testing.T) {
+	event := testEvent()
+	event.Description = strings.Repeat("Zoom link and dial-in details für alle — ", 10)
+
+	ics := event.Render()
+	for _, line := range strings.Split(strings.TrimSuffix(string(ics), "\r\n"), "\r\n") {
+		if len(line) > 75 {
+			t.Errorf("line of %d octets: %q", len(line), line)
+		}
+		if !utf8.ValidString(line) {
+			t.Errorf("line splits a UTF-8 sequence: %q", line)
+		}
+	}
+	if !hasLine(unfold(ics), "DESCRIPTION:"+escapeText(event.Description)) {
+		t.Error("unfolded output does not contain the description")
+	}
+}
+
+func TestEscapeText(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		want  string
+	}{
+		{"plain", "Room 4", "Room 4"},
+		{"comma", "Berlin, Germany", `Berlin\, Germany`},
+		{"semicolon", "a;b", `a\;b`},
+		{"backslash", `C:\calls`, `C:\\calls`},
+		{"newline", "line one\nline two", `line one\nline two`},
+		{"crlf", "line one\r\nline two", `line one\nline two`},
+		{"escaped sequence stays literal", `\n,`, `\\n\,`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := escapeText(tt.value); got != tt.want {
+				t.Errorf("escapeText(%q) = %q, want %q", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRenderEscapesText(t *testing.T) {
+	event := testEvent()
+	event.Summary = "Interview; round 2"
+	event.Location = "Office, 3rd floor\nRoom \\4"
+
+	lines := unfold(event.Render())
+	for _, want := range []string{`SUMMARY:Interview\; round 2`, `LOCATION:Office\, 3rd floor\nRoom \\4`} {
+		if !hasLine(lines, want) {
+			t.Errorf("missing %q in %q", want, lines)
+		}
+	}
+}
+
+func TestRenderMethod(t *testing.T) {
+	tests := []struct {
+		name     string
+		method   Method
+		sequence int
+		want     []string
+	}{
+		{
+			name: "default request",
+			want: []string{"METHOD:REQUEST", "SEQUENCE:0", "STATUS:CONFIRMED"},
+		},
+		{
+			name:     "updated request",
+			method:   MethodRequest,
+			sequence: 1,
+			want:     []string{"METHOD:REQUEST", "SEQUENCE:1", "STATUS:CONFIRMED"},
+		},
+		{
+			name:     "cancel",
+			method:   MethodCancel,
+			sequence: 2,
+			want:     []string{"METHOD:CANCEL", "SEQUENCE:2", "STATUS:CANCELLED"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := testEvent()
+			event.Method = tt.method
+			event.Sequence = tt.sequence
+
+			lines := unfold(event.Render())
+			want := append(tt.want,
+				"UID:interview-1@super2025",
+				"DTSTART:20250602T130000Z",
+				"DTEND:20250602T140000Z",
+				`ORGANIZER;CN="Recruiting":mailto:hr@example.com`,
+				`ATTENDEE;CN="Ada Lovelace";ROLE=REQ-PARTICIPANT;PARTSTAT=NEEDS-ACTION;RSVP=TRUE:mailto:ada@example.com`,
+			)
+			for _, line := range want {
+				if !hasLine(lines, line) {
+					t.Errorf("missing %q in %q", line, lines)
+				}
+			}
+		})
+	}
+}
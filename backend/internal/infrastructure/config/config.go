@@ -5,6 +5,8 @@ import (
 	"log"
 	"os"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/joho/godotenv"
 )
@@ -16,6 +18,9 @@ type Config struct {
 	FileStorage FileStorageConfig
 	Email       EmailConfig
 	Application ApplicationConfig
+	Security    SecurityConfig
+	Scheduler   SchedulerConfig
+	Retention   RetentionConfig
 }
 
 // DatabaseConfig holds database configuration
@@ -32,6 +37,10 @@ type DatabaseConfig struct {
 type ServerConfig struct {
 	Port    string
 	GinMode string
+
+	// TrustedProxies are the proxies whose X-Forwarded-For header is used for the
+	// client IP. With none, the client IP is always the connection's address.
+	TrustedProxies []string
 }
 
 // FileStorageConfig holds file storage configuration
@@ -39,6 +48,9 @@ type FileStorageConfig struct {
 	Type        string // "local" or "s3"
 	UploadDir   string
 	MaxFileSize int64
+
+	// ImageUploadDir holds team and project images, which are served publicly
+	ImageUploadDir string
 	
 	// S3 specific
 	AWSRegion          string
@@ -55,6 +67,7 @@ type EmailConfig struct {
 	SMTPPassword string
 	FromEmail    string
 	HREmail      string
+	SalesEmail   string
 }
 
 // ApplicationConfig holds application configuration
@@ -63,6 +76,34 @@ type ApplicationConfig struct {
 	FrontendURL string
 }
 
+// SecurityConfig holds security configuration
+type SecurityConfig struct {
+	AdminAPIKey       string
+	LinkSigningSecret string
+
+	// ErasureHashSecret keys the hash erasure receipts identify a person by. It must
+	// not change, or receipts can no longer be found by email address.
+	ErasureHashSecret string
+}
+
+// SchedulerConfig holds background job configuration
+type SchedulerConfig struct {
+	PositionInterval  time.Duration
+	OfferInterval     time.Duration
+	ScreeningInterval time.Duration
+	RetentionInterval time.Duration
+}
+
+// RetentionConfig holds data retention periods in days; 0 keeps data indefinitely
+type RetentionConfig struct {
+	RejectedDays  int
+	WithdrawnDays int
+	MetadataDays  int
+
+	// Action taken on rejected and withdrawn applications: "anonymize" or "purge"
+	Action string
+}
+
 // Load loads configuration from environment variables
 func Load() (*Config, error) {
 	if err := godotenv.Load(); err != nil {
@@ -75,6 +116,53 @@ func Load() (*Config, error) {
 		return nil, fmt.Errorf("invalid MAX_FILE_SIZE: %w", err)
 	}
 
+	positionInterval, err := time.ParseDuration(getEnv("POSITION_SCHEDULE_INTERVAL", "1m"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid POSITION_SCHEDULE_INTERVAL: %w", err)
+	}
+
+	offerInterval, err := time.ParseDuration(getEnv("OFFER_EXPIRY_INTERVAL", "5m"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid OFFER_EXPIRY_INTERVAL: %w", err)
+	}
+
+	screeningInterval, err := time.ParseDuration(getEnv("SCREENING_INTERVAL", "5m"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid SCREENING_INTERVAL: %w", err)
+	}
+
+	retentionInterval, err := time.ParseDuration(getEnv("RETENTION_INTERVAL", "24h"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid RETENTION_INTERVAL: %w", err)
+	}
+
+	retentionRejectedDays, err := strconv.Atoi(getEnv("RETENTION_REJECTED_DAYS", "0"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid RETENTION_REJECTED_DAYS: %w", err)
+	}
+
+	retentionWithdrawnDays, err := strconv.Atoi(getEnv("RETENTION_WITHDRAWN_DAYS", "0"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid RETENTION_WITHDRAWN_DAYS: %w", err)
+	}
+
+	retentionMetadataDays, err := strconv.Atoi(getEnv("RETENTION_METADATA_DAYS", "0"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid RETENTION_METADATA_DAYS: %w", err)
+	}
+
+	retentionAction := getEnv("RETENTION_ACTION", "anonymize")
+	if retentionAction != "anonymize" && retentionAction != "purge" {
+		return nil, fmt.Errorf("invalid RETENTION_ACTION %q: must be anonymize or purge", retentionAction)
+	}
+
+	var trustedProxies []string
+	for _, proxy := range strings.Split(getEnv("TRUSTED_PROXIES", ""), ",") {
+		if proxy = strings.TrimSpace(proxy); proxy != "" {
+			trustedProxies = append(trustedProxies, proxy)
+		}
+	}
+
 	return &Config{
 		Database: DatabaseConfig{
 			Host:     getEnv("DB_HOST", "localhost"),
@@ -85,13 +173,15 @@ func Load() (*Config, error) {
 			SSLMode:  getEnv("DB_SSL_MODE", "disable"),
 		},
 		Server: ServerConfig{
-			Port:    getEnv("PORT", "8081"),
-			GinMode: getEnv("GIN_MODE", "debug"),
+			Port:           getEnv("PORT", "8081"),
+			GinMode:        getEnv("GIN_MODE", "debug"),
+			TrustedProxies: trustedProxies,
 		},
 		FileStorage: FileStorageConfig{
 			Type:               getEnv("STORAGE_TYPE", "local"),
 			UploadDir:          getEnv("UPLOAD_DIR", "./uploads/resumes"),
 			MaxFileSize:        maxFileSize,
+			ImageUploadDir:     getEnv("IMAGE_UPLOAD_DIR", "./uploads/images"),
 			AWSRegion:          getEnv("AWS_REGION", ""),
 			AWSAccessKeyID:     getEnv("AWS_ACCESS_KEY_ID", ""),
 			AWSSecretAccessKey: getEnv("AWS_SECRET_ACCESS_KEY", ""),
@@ -104,11 +194,29 @@ func Load() (*Config, error) {
 			SMTPPassword: getEnv("SMTP_PASSWORD", ""),
 			FromEmail:    getEnv("FROM_EMAIL", "careers@super2025.com"),
 			HREmail:      getEnv("HR_EMAIL", "hr@super2025.com"),
+			SalesEmail:   getEnv("SALES_EMAIL", getEnv("HR_EMAIL", "hr@super2025.com")),
 		},
 		Application: ApplicationConfig{
 			BaseURL:     getEnv("API_BASE_URL", "http://localhost:8080"),
 			FrontendURL: getEnv("FRONTEND_URL", "http://localhost:3000"),
 		},
+		Security: SecurityConfig{
+			AdminAPIKey:       getEnv("ADMIN_API_KEY", ""),
+			LinkSigningSecret: getEnv("LINK_SIGNING_SECRET", ""),
+			ErasureHashSecret: getEnv("ERASURE_HASH_SECRET", ""),
+		},
+		Scheduler: SchedulerConfig{
+			PositionInterval:  positionInterval,
+			OfferInterval:     offerInterval,
+			ScreeningInterval: screeningInterval,
+			RetentionInterval: retentionInterval,
+		},
+		Retention: RetentionConfig{
+			RejectedDays:  retentionRejectedDays,
+			WithdrawnDays: retentionWithdrawnDays,
+			MetadataDays:  retentionMetadataDays,
+			Action:        retentionAction,
+		},
 	}, nil
 }
 
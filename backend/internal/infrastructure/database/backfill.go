@@ -0,0 +1,136 @@
+package database
+
+import (
+	"fmt"
+	"time"
+
+	"super2025-backend/internal/domain/entities"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Backfill brings the data of a database created by AutoMigrate up to date, doing what
+// the data steps of the SQL migrations do. It runs on every start after AutoMigrate and
+// only touches what still needs it.
+func Backfill(db *gorm.DB) error {
+	if err := seedPositions(db); err != nil {
+		return fmt.Errorf("failed to seed positions: %w", err)
+	}
+	if err := backfillCandidates(db); err != nil {
+		return fmt.Errorf("failed to backfill candidates: %w", err)
+	}
+	return nil
+}
+
+// seedPositions adds the positions advertised on the careers page to a database that
+// has never had any, so their IDs accept applications. Positions removed later are
+// not brought back.
+func seedPositions(db *gorm.DB) error {
+	var count int64
+	if err := db.Unscoped().Model(&entities.Position{}).Count(&count).Error; err != nil {
+		return err
+	}
+	if count > 0 {
+		return nil
+	}
+	return db.Create(initialPositions()).Error
+}
+
+// backfillCandidates links applications submitted before candidates existed to a candidate
+// per normalized email, as migration 005 does. Each new candidate takes the name and phone
+// of the latest application and the date of the earliest. Erased applications stay unlinked.
+func backfillCandidates(db *gorm.DB) error {
+	return db.Transaction(func(tx *gorm.DB) error {
+		var candidates []*entities.Candidate
+		err := tx.Raw(`SELECT DISTINCT ON (LOWER(TRIM(email)))
+				LOWER(TRIM(email)) AS email,
+				name,
+				COALESCE(phone, '') AS phone,
+				MIN(created_at) OVER (PARTITION BY LOWER(TRIM(email))) AS created_at
+			FROM applications
+			WHERE candidate_id IS NULL AND erased_at IS NULL
+			ORDER BY LOWER(TRIM(email)), created_at DESC`).
+			Scan(&candidates).Error
+		if err != nil || len(candidates) == 0 {
+			return err
+		}
+
+		now := time.Now()
+		for _, candidate := range candidates {
+			candidate.UpdatedAt = now
+		}
+		// Addresses that already have a candidate keep it
+		if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&candidates).Error; err != nil {
+			return err
+		}
+
+		return tx.Exec(`UPDATE applications
+			SET candidate_id = candidates.id
+			FROM candidates
+			WHERE applications.candidate_id IS NULL
+				AND applications.erased_at IS NULL
+				AND candidates.email = LOWER(TRIM(applications.email))`).Error
+	})
+}
+
+// initialPositions returns the positions seeded by migration 003
+func initialPositions() []*entities.Position {
+	return []*entities.Position{
+		{
+			ID:             "junior-developer-us",
+			Title:          "Junior Developer / Intern / Graduate",
+			Department:     "Engineering",
+			Location:       "Remote (U.S.)",
+			EmploymentType: entities.EmploymentInternship,
+			Description:    "We pay you to learn software development while building real products for U.S. clients. No degree required.",
+			Requirements:   entities.StringList{"U.S.-based and work authorized", "Clear communication and growth mindset", "Willingness to complete a short paid practical task (45–60 mins)", "Basic understanding of programming concepts preferred"},
+			Benefits:       entities.StringList{"Paid hands-on mentorship and real impact", "Path to Junior Developer with promotion tracks", "Work-life balance and remote flexibility", "Learn modern stacks (React/Node/Python/TypeScript)", "Contribute to code reviews, sprints, and updates"},
+			Status:         entities.PositionStatusOpen,
+		},
+		{
+			ID:             "senior-ai-engineer",
+			Title:          "Senior AI Engineer",
+			Department:     "Engineering",
+			Location:       "Remote",
+			EmploymentType: entities.EmploymentFullTime,
+			Description:    "Join our AI team to build cutting-edge machine learning solutions for enterprise clients.",
+			Requirements:   entities.StringList{"5+ years in AI/ML development", "Experience with TensorFlow, PyTorch", "Strong Python programming skills", "Experience with LLMs and NLP", "PhD or Masters in relevant field preferred"},
+			Benefits:       entities.StringList{"Competitive salary + equity", "Remote-first culture", "Learning & development budget", "Top-tier equipment", "Flexible working hours"},
+			Status:         entities.PositionStatusOpen,
+		},
+		{
+			ID:             "blockchain-developer",
+			Title:          "Blockchain Developer",
+			Department:     "Engineering",
+			Location:       "Remote",
+			EmploymentType: entities.EmploymentFullTime,
+			Description:    "Build secure, scalable blockchain solutions and smart contracts for DeFi applications.",
+			Requirements:   entities.StringList{"3+ years blockchain development", "Proficiency in Solidity and Rust", "Experience with DeFi protocols", "Smart contract security knowledge", "Understanding of tokenomics"},
+			Benefits:       entities.StringList{"Competitive salary + equity", "Remote-first culture", "Conference attendance", "Crypto bonuses", "Flexible working hours"},
+			Status:         entities.PositionStatusOpen,
+		},
+		{
+			ID:             "us-client-manager",
+			Title:          "US Client Manager",
+			Department:     "Client Services",
+			Location:       "Chicago, Austin, or San Francisco",
+			EmploymentType: entities.EmploymentFullTime,
+			Description:    "Manage client relationships and ensure project success for our US market expansion.",
+			Requirements:   entities.StringList{"3+ years client management", "Technical background preferred", "Excellent communication skills", "US work authorization", "Business development experience"},
+			Benefits:       entities.StringList{"Competitive salary + commission", "Health insurance", "Flexible working arrangements", "Professional development", "Travel opportunities"},
+			Status:         entities.PositionStatusOpen,
+		},
+		{
+			ID:             "uk-client-manager",
+			Title:          "UK Client Manager",
+			Department:     "Client Services",
+			Location:       "London or Manchester",
+			EmploymentType: entities.EmploymentFullTime,
+			Description:    "Drive business growth and client satisfaction in the UK market.",
+			Requirements:   entities.StringList{"3+ years client management", "Technical background preferred", "Excellent communication skills", "UK work authorization", "Business development experience"},
+			Benefits:       entities.StringList{"Competitive salary + commission", "Health insurance", "Flexible working arrangements", "Professional development", "Travel opportunities"},
+			Status:         entities.PositionStatusOpen,
+		},
+	}
+}
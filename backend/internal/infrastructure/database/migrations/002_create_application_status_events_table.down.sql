@@ -0,0 +1,6 @@
+-- Drop indexes
+DROP INDEX IF EXISTS idx_application_status_events_created_at;
+DROP INDEX IF EXISTS idx_application_status_events_application_id;
+
+-- Drop table
+DROP TABLE IF EXISTS application_status_events;
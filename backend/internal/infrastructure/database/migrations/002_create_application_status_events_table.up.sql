@@ -0,0 +1,27 @@
+-- Create application status events table
+CREATE TABLE application_status_events (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    application_id UUID NOT NULL REFERENCES applications(id) ON DELETE CASCADE,
+    from_status VARCHAR(50),
+    to_status VARCHAR(50) NOT NULL
+        CHECK (to_status IN ('pending', 'reviewing', 'interview', 'offered', 'rejected', 'withdrawn')),
+    actor VARCHAR(255),
+    notes TEXT,
+    override BOOLEAN DEFAULT FALSE NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW() NOT NULL
+);
+
+-- Create indexes for better performance
+CREATE INDEX idx_application_status_events_application_id ON application_status_events(application_id);
+CREATE INDEX idx_application_status_events_created_at ON application_status_events(created_at);
+
+-- Backfill the submission event for existing applications
+INSERT INTO application_status_events (application_id, from_status, to_status, actor, created_at)
+SELECT id, NULL, 'pending', 'candidate', created_at
+FROM applications;
+
+-- Backfill the last known status change for processed applications
+INSERT INTO application_status_events (application_id, from_status, to_status, actor, notes, created_at)
+SELECT id, 'pending', status, processed_by, 'Backfilled from last processed status', COALESCE(processed_at, updated_at)
+FROM applications
+WHERE status <> 'pending';
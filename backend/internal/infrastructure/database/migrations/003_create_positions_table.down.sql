@@ -0,0 +1,9 @@
+-- Drop trigger
+DROP TRIGGER IF EXISTS update_positions_updated_at ON positions;
+
+-- Drop indexes
+DROP INDEX IF EXISTS idx_positions_deleted_at;
+DROP INDEX IF EXISTS idx_positions_status;
+
+-- Drop table
+DROP TABLE IF EXISTS positions;
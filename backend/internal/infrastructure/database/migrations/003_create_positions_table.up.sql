@@ -0,0 +1,88 @@
+-- Create positions table
+CREATE TABLE positions (
+    id VARCHAR(100) PRIMARY KEY,
+    title VARCHAR(200) NOT NULL,
+    department VARCHAR(100),
+    location VARCHAR(255),
+    employment_type VARCHAR(50) DEFAULT 'full_time' NOT NULL
+        CHECK (employment_type IN ('full_time', 'part_time', 'contract', 'internship')),
+    description TEXT,
+    requirements JSONB DEFAULT '[]'::jsonb NOT NULL,
+    benefits JSONB DEFAULT '[]'::jsonb NOT NULL,
+    status VARCHAR(50) DEFAULT 'draft' NOT NULL
+        CHECK (status IN ('draft', 'open', 'closed')),
+
+    -- Timestamps
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW() NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW() NOT NULL,
+    deleted_at TIMESTAMP WITH TIME ZONE
+);
+
+-- Create indexes for better performance
+CREATE INDEX idx_positions_status ON positions(status);
+CREATE INDEX idx_positions_deleted_at ON positions(deleted_at);
+
+-- Create trigger to automatically update updated_at
+CREATE TRIGGER update_positions_updated_at
+    BEFORE UPDATE ON positions
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+-- Seed the positions currently advertised on the careers page so existing
+-- position IDs keep accepting applications
+INSERT INTO positions (id, title, department, location, employment_type, description, requirements, benefits, status) VALUES
+(
+    'junior-developer-us',
+    'Junior Developer / Intern / Graduate',
+    'Engineering',
+    'Remote (U.S.)',
+    'internship',
+    'We pay you to learn software development while building real products for U.S. clients. No degree required.',
+    '["U.S.-based and work authorized", "Clear communication and growth mindset", "Willingness to complete a short paid practical task (45–60 mins)", "Basic understanding of programming concepts preferred"]',
+    '["Paid hands-on mentorship and real impact", "Path to Junior Developer with promotion tracks", "Work-life balance and remote flexibility", "Learn modern stacks (React/Node/Python/TypeScript)", "Contribute to code reviews, sprints, and updates"]',
+    'open'
+),
+(
+    'senior-ai-engineer',
+    'Senior AI Engineer',
+    'Engineering',
+    'Remote',
+    'full_time',
+    'Join our AI team to build cutting-edge machine learning solutions for enterprise clients.',
+    '["5+ years in AI/ML development", "Experience with TensorFlow, PyTorch", "Strong Python programming skills", "Experience with LLMs and NLP", "PhD or Masters in relevant field preferred"]',
+    '["Competitive salary + equity", "Remote-first culture", "Learning & development budget", "Top-tier equipment", "Flexible working hours"]',
+    'open'
+),
+(
+    'blockchain-developer',
+    'Blockchain Developer',
+    'Engineering',
+    'Remote',
+    'full_time',
+    'Build secure, scalable blockchain solutions and smart contracts for DeFi applications.',
+    '["3+ years blockchain development", "Proficiency in Solidity and Rust", "Experience with DeFi protocols", "Smart contract security knowledge", "Understanding of tokenomics"]',
+    '["Competitive salary + equity", "Remote-first culture", "Conference attendance", "Crypto bonuses", "Flexible working hours"]',
+    'open'
+),
+(
+    'us-client-manager',
+    'US Client Manager',
+    'Client Services',
+    'Chicago, Austin, or San Francisco',
+    'full_time',
+    'Manage client relationships and ensure project success for our US market expansion.',
+    '["3+ years client management", "Technical background preferred", "Excellent communication skills", "US work authorization", "Business development experience"]',
+    '["Competitive salary + commission", "Health insurance", "Flexible working arrangements", "Professional development", "Travel opportunities"]',
+    'open'
+),
+(
+    'uk-client-manager',
+    'UK Client Manager',
+    'Client Services',
+    'London or Manchester',
+    'full_time',
+    'Drive business growth and client satisfaction in the UK market.',
+    '["3+ years client management", "Technical background preferred", "Excellent communication skills", "UK work authorization", "Business development experience"]',
+    '["Competitive salary + commission", "Health insurance", "Flexible working arrangements", "Professional development", "Travel opportunities"]',
+    'open'
+);
@@ -0,0 +1,14 @@
+-- Drop position closures table
+DROP INDEX IF EXISTS idx_position_closures_position_id;
+DROP TABLE IF EXISTS position_closures;
+
+-- Drop lifecycle columns
+DROP INDEX IF EXISTS idx_positions_close_at;
+DROP INDEX IF EXISTS idx_positions_open_at;
+
+ALTER TABLE positions
+    DROP COLUMN IF EXISTS notify_on_close,
+    DROP COLUMN IF EXISTS on_close_status,
+    DROP COLUMN IF EXISTS closed_at,
+    DROP COLUMN IF EXISTS close_at,
+    DROP COLUMN IF EXISTS open_at;
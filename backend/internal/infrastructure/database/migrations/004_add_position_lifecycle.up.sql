@@ -0,0 +1,25 @@
+-- Add scheduling and close handling to positions
+ALTER TABLE positions
+    ADD COLUMN open_at TIMESTAMP WITH TIME ZONE,
+    ADD COLUMN close_at TIMESTAMP WITH TIME ZONE,
+    ADD COLUMN closed_at TIMESTAMP WITH TIME ZONE,
+    ADD COLUMN on_close_status VARCHAR(50)
+        CHECK (on_close_status IN ('reviewing', 'rejected', 'withdrawn')),
+    ADD COLUMN notify_on_close BOOLEAN DEFAULT FALSE NOT NULL;
+
+CREATE INDEX idx_positions_open_at ON positions(open_at) WHERE open_at IS NOT NULL;
+CREATE INDEX idx_positions_close_at ON positions(close_at) WHERE close_at IS NOT NULL;
+
+-- Create position closures table
+CREATE TABLE position_closures (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    position_id VARCHAR(100) NOT NULL REFERENCES positions(id) ON DELETE CASCADE,
+    closed_by VARCHAR(255),
+    trigger VARCHAR(50) NOT NULL CHECK (trigger IN ('manual', 'schedule')),
+    application_status VARCHAR(50),
+    applications_moved INTEGER DEFAULT 0 NOT NULL,
+    candidates_notified BOOLEAN DEFAULT FALSE NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW() NOT NULL
+);
+
+CREATE INDEX idx_position_closures_position_id ON position_closures(position_id);
@@ -0,0 +1,13 @@
+-- Unlink applications
+DROP INDEX IF EXISTS idx_applications_candidate_id;
+ALTER TABLE applications DROP COLUMN IF EXISTS candidate_id;
+
+-- Drop trigger
+DROP TRIGGER IF EXISTS update_candidates_updated_at ON candidates;
+
+-- Drop indexes
+DROP INDEX IF EXISTS idx_candidates_deleted_at;
+DROP INDEX IF EXISTS idx_candidates_email;
+
+-- Drop table
+DROP TABLE IF EXISTS candidates;
@@ -0,0 +1,43 @@
+-- Create candidates table
+CREATE TABLE candidates (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    email VARCHAR(255) NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    phone VARCHAR(20),
+
+    -- Timestamps
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW() NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW() NOT NULL,
+    deleted_at TIMESTAMP WITH TIME ZONE
+);
+
+-- Emails are stored normalized (trimmed, lower-case)
+CREATE UNIQUE INDEX idx_candidates_email ON candidates(email);
+CREATE INDEX idx_candidates_deleted_at ON candidates(deleted_at);
+
+-- Create trigger to automatically update updated_at
+CREATE TRIGGER update_candidates_updated_at
+    BEFORE UPDATE ON candidates
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+-- Link applications to candidates
+ALTER TABLE applications ADD COLUMN candidate_id UUID REFERENCES candidates(id);
+CREATE INDEX idx_applications_candidate_id ON applications(candidate_id);
+
+-- Backfill one candidate per normalized email, using the most recent
+-- application's name and phone and the earliest application date
+INSERT INTO candidates (email, name, phone, created_at, updated_at)
+SELECT DISTINCT ON (LOWER(TRIM(email)))
+    LOWER(TRIM(email)),
+    name,
+    phone,
+    MIN(created_at) OVER (PARTITION BY LOWER(TRIM(email))),
+    created_at
+FROM applications
+ORDER BY LOWER(TRIM(email)), created_at DESC;
+
+UPDATE applications
+SET candidate_id = candidates.id
+FROM candidates
+WHERE candidates.email = LOWER(TRIM(applications.email));
@@ -0,0 +1,7 @@
+-- Drop indexes
+DROP INDEX IF EXISTS idx_application_comments_deleted_at;
+DROP INDEX IF EXISTS idx_application_comments_parent_id;
+DROP INDEX IF EXISTS idx_application_comments_application_id;
+
+-- Drop table
+DROP TABLE IF EXISTS application_comments;
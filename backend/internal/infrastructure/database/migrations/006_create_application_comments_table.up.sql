@@ -0,0 +1,27 @@
+-- Create application comments table
+CREATE TABLE application_comments (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    application_id UUID NOT NULL REFERENCES applications(id) ON DELETE CASCADE,
+    parent_id UUID REFERENCES application_comments(id) ON DELETE CASCADE,
+    author VARCHAR(255) NOT NULL,
+    body TEXT NOT NULL,
+    visibility VARCHAR(20) DEFAULT 'team' NOT NULL
+        CHECK (visibility IN ('private', 'team')),
+
+    -- Timestamps
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW() NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW() NOT NULL,
+    edited_at TIMESTAMP WITH TIME ZONE,
+    deleted_at TIMESTAMP WITH TIME ZONE
+);
+
+-- Create indexes for better performance
+CREATE INDEX idx_application_comments_application_id ON application_comments(application_id);
+CREATE INDEX idx_application_comments_parent_id ON application_comments(parent_id);
+CREATE INDEX idx_application_comments_deleted_at ON application_comments(deleted_at);
+
+-- Keep the legacy single notes column as the first team comment
+INSERT INTO application_comments (application_id, author, body, visibility, created_at, updated_at)
+SELECT id, COALESCE(NULLIF(processed_by, ''), 'unknown'), notes, 'team', COALESCE(processed_at, updated_at), COALESCE(processed_at, updated_at)
+FROM applications
+WHERE notes IS NOT NULL AND notes <> '';
@@ -0,0 +1,7 @@
+-- Drop link table
+DROP INDEX IF EXISTS idx_application_tags_tag_id;
+DROP TABLE IF EXISTS application_tags;
+
+-- Drop tags table
+DROP INDEX IF EXISTS idx_tags_name;
+DROP TABLE IF EXISTS tags;
@@ -0,0 +1,18 @@
+-- Create tags table
+CREATE TABLE tags (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    name VARCHAR(50) NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW() NOT NULL
+);
+
+-- Tag names are stored as lower-case slugs
+CREATE UNIQUE INDEX idx_tags_name ON tags(name);
+
+-- Create application/tag link table
+CREATE TABLE application_tags (
+    application_id UUID NOT NULL REFERENCES applications(id) ON DELETE CASCADE,
+    tag_id UUID NOT NULL REFERENCES tags(id) ON DELETE CASCADE,
+    PRIMARY KEY (application_id, tag_id)
+);
+
+CREATE INDEX idx_application_tags_tag_id ON application_tags(tag_id);
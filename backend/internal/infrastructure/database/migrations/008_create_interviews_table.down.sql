@@ -0,0 +1,7 @@
+-- Drop indexes
+DROP INDEX IF EXISTS idx_interviews_status;
+DROP INDEX IF EXISTS idx_interviews_start_at;
+DROP INDEX IF EXISTS idx_interviews_application_id;
+
+-- Drop table
+DROP TABLE IF EXISTS interviews;
@@ -0,0 +1,31 @@
+-- Create interviews table
+CREATE TABLE interviews (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    application_id UUID NOT NULL REFERENCES applications(id) ON DELETE CASCADE,
+    interviewers JSONB NOT NULL DEFAULT '[]',
+    start_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    end_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    timezone VARCHAR(64) NOT NULL,
+    location VARCHAR(255),
+    video_link VARCHAR(500),
+    notes TEXT,
+    status VARCHAR(20) DEFAULT 'scheduled' NOT NULL
+        CHECK (status IN ('scheduled', 'cancelled')),
+    sequence INTEGER DEFAULT 0 NOT NULL,
+
+    -- Audit
+    created_by VARCHAR(255),
+    cancelled_by VARCHAR(255),
+    cancelled_at TIMESTAMP WITH TIME ZONE,
+
+    -- Timestamps
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW() NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW() NOT NULL,
+
+    CHECK (end_at > start_at)
+);
+
+-- Create indexes for better performance
+CREATE INDEX idx_interviews_application_id ON interviews(application_id);
+CREATE INDEX idx_interviews_start_at ON interviews(start_at);
+CREATE INDEX idx_interviews_status ON interviews(status);
@@ -0,0 +1,8 @@
+-- Drop indexes
+DROP INDEX IF EXISTS idx_interview_slots_one_booking;
+DROP INDEX IF EXISTS idx_interview_slots_invitation_id;
+DROP INDEX IF EXISTS idx_scheduling_invitations_application_id;
+
+-- Drop tables
+DROP TABLE IF EXISTS interview_slots;
+DROP TABLE IF EXISTS scheduling_invitations;
@@ -0,0 +1,39 @@
+-- Create scheduling invitations table
+CREATE TABLE scheduling_invitations (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    application_id UUID NOT NULL REFERENCES applications(id) ON DELETE CASCADE,
+    token_hash VARCHAR(64) NOT NULL UNIQUE,
+    interviewers JSONB NOT NULL DEFAULT '[]',
+    timezone VARCHAR(64) NOT NULL,
+    location VARCHAR(255),
+    video_link VARCHAR(500),
+    notes TEXT,
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    created_by VARCHAR(255),
+
+    -- Booking
+    booked_at TIMESTAMP WITH TIME ZONE,
+    interview_id UUID REFERENCES interviews(id) ON DELETE SET NULL,
+
+    -- Timestamps
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW() NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW() NOT NULL
+);
+
+-- Create interview slots table
+CREATE TABLE interview_slots (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    invitation_id UUID NOT NULL REFERENCES scheduling_invitations(id) ON DELETE CASCADE,
+    start_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    end_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    booked_at TIMESTAMP WITH TIME ZONE,
+
+    CHECK (end_at > start_at)
+);
+
+-- Create indexes for better performance
+CREATE INDEX idx_scheduling_invitations_application_id ON scheduling_invitations(application_id);
+CREATE INDEX idx_interview_slots_invitation_id ON interview_slots(invitation_id);
+
+-- At most one booked slot per invitation
+CREATE UNIQUE INDEX idx_interview_slots_one_booking ON interview_slots(invitation_id) WHERE booked_at IS NOT NULL;
@@ -0,0 +1,6 @@
+-- Drop indexes
+DROP INDEX IF EXISTS idx_scorecards_interview_id;
+DROP INDEX IF EXISTS idx_scorecards_application_interviewer;
+
+-- Drop table
+DROP TABLE IF EXISTS scorecards;
@@ -0,0 +1,21 @@
+-- Create scorecards table
+CREATE TABLE scorecards (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    application_id UUID NOT NULL REFERENCES applications(id) ON DELETE CASCADE,
+    interview_id UUID REFERENCES interviews(id) ON DELETE SET NULL,
+    interviewer VARCHAR(255) NOT NULL,
+    ratings JSONB NOT NULL DEFAULT '{}',
+    recommendation VARCHAR(20) NOT NULL
+        CHECK (recommendation IN ('strong_no', 'no', 'yes', 'strong_yes')),
+    feedback TEXT,
+
+    -- Timestamps
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW() NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW() NOT NULL
+);
+
+-- One scorecard per interviewer and application
+CREATE UNIQUE INDEX idx_scorecards_application_interviewer ON scorecards(application_id, interviewer);
+
+-- Create indexes for better performance
+CREATE INDEX idx_scorecards_interview_id ON scorecards(interview_id);
@@ -0,0 +1,20 @@
+-- Drop indexes
+DROP INDEX IF EXISTS idx_offers_one_pending;
+DROP INDEX IF EXISTS idx_offers_expires_at;
+DROP INDEX IF EXISTS idx_offers_status;
+DROP INDEX IF EXISTS idx_offers_application_id;
+
+-- Drop table
+DROP TABLE IF EXISTS offers;
+
+-- Hired applications go back to offered
+UPDATE applications SET status = 'offered' WHERE status = 'hired';
+UPDATE application_status_events SET to_status = 'offered' WHERE to_status = 'hired';
+
+ALTER TABLE application_status_events DROP CONSTRAINT IF EXISTS application_status_events_to_status_check;
+ALTER TABLE application_status_events ADD CONSTRAINT application_status_events_to_status_check
+    CHECK (to_status IN ('pending', 'reviewing', 'interview', 'offered', 'rejected', 'withdrawn'));
+
+ALTER TABLE applications DROP CONSTRAINT IF EXISTS applications_status_check;
+ALTER TABLE applications ADD CONSTRAINT applications_status_check
+    CHECK (status IN ('pending', 'reviewing', 'interview', 'offered', 'rejected', 'withdrawn'));
@@ -0,0 +1,39 @@
+-- Allow the hired status
+ALTER TABLE applications DROP CONSTRAINT IF EXISTS applications_status_check;
+ALTER TABLE applications ADD CONSTRAINT applications_status_check
+    CHECK (status IN ('pending', 'reviewing', 'interview', 'offered', 'hired', 'rejected', 'withdrawn'));
+
+ALTER TABLE application_status_events DROP CONSTRAINT IF EXISTS application_status_events_to_status_check;
+ALTER TABLE application_status_events ADD CONSTRAINT application_status_events_to_status_check
+    CHECK (to_status IN ('pending', 'reviewing', 'interview', 'offered', 'hired', 'rejected', 'withdrawn'));
+
+-- Create offers table
+CREATE TABLE offers (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    application_id UUID NOT NULL REFERENCES applications(id) ON DELETE CASCADE,
+    salary BIGINT NOT NULL CHECK (salary > 0),
+    currency CHAR(3) NOT NULL,
+    start_date DATE NOT NULL,
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    terms TEXT,
+    status VARCHAR(20) DEFAULT 'pending' NOT NULL
+        CHECK (status IN ('pending', 'accepted', 'declined', 'expired', 'rescinded')),
+    created_by VARCHAR(255),
+
+    -- Outcome
+    responded_at TIMESTAMP WITH TIME ZONE,
+    decline_reason TEXT,
+    rescinded_by VARCHAR(255),
+
+    -- Timestamps
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW() NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW() NOT NULL
+);
+
+-- Create indexes for better performance
+CREATE INDEX idx_offers_application_id ON offers(application_id);
+CREATE INDEX idx_offers_status ON offers(status);
+CREATE INDEX idx_offers_expires_at ON offers(expires_at);
+
+-- At most one pending offer per application
+CREATE UNIQUE INDEX idx_offers_one_pending ON offers(application_id) WHERE status = 'pending';
@@ -0,0 +1,5 @@
+-- Drop indexes
+DROP INDEX IF EXISTS idx_portal_sessions_candidate_id;
+
+-- Drop tables
+DROP TABLE IF EXISTS portal_sessions;
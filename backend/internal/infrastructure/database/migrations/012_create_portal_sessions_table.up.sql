@@ -0,0 +1,20 @@
+-- Create candidate portal sessions table
+CREATE TABLE portal_sessions (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    candidate_id UUID NOT NULL REFERENCES candidates(id) ON DELETE CASCADE,
+
+    -- One-time login link
+    login_token_hash VARCHAR(64) NOT NULL UNIQUE,
+    login_expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+
+    -- Session, set when the login link is redeemed
+    session_token_hash VARCHAR(64) UNIQUE,
+    redeemed_at TIMESTAMP WITH TIME ZONE,
+    expires_at TIMESTAMP WITH TIME ZONE,
+
+    -- Timestamps
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW() NOT NULL
+);
+
+-- Create indexes for better performance
+CREATE INDEX idx_portal_sessions_candidate_id ON portal_sessions(candidate_id, created_at);
@@ -0,0 +1,10 @@
+-- Drop trigger
+DROP TRIGGER IF EXISTS update_inquiries_updated_at ON inquiries;
+
+-- Drop indexes
+DROP INDEX IF EXISTS idx_inquiries_ip_address;
+DROP INDEX IF EXISTS idx_inquiries_email;
+DROP INDEX IF EXISTS idx_inquiries_status;
+
+-- Drop tables
+DROP TABLE IF EXISTS inquiries;
@@ -0,0 +1,33 @@
+-- Create inquiries table
+CREATE TABLE inquiries (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    name VARCHAR(100) NOT NULL,
+    email VARCHAR(255) NOT NULL,
+    company VARCHAR(200),
+    role VARCHAR(100),
+    project_type VARCHAR(50),
+    budget VARCHAR(50),
+    timeline VARCHAR(50),
+    message TEXT NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'new' CHECK (status IN ('new', 'contacted', 'closed')),
+    handled_by VARCHAR(255),
+
+    -- Metadata
+    ip_address INET,
+    user_agent TEXT,
+
+    -- Timestamps
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW() NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW() NOT NULL
+);
+
+-- Create indexes for better performance
+CREATE INDEX idx_inquiries_status ON inquiries(status, created_at DESC);
+CREATE INDEX idx_inquiries_email ON inquiries(email);
+CREATE INDEX idx_inquiries_ip_address ON inquiries(ip_address, created_at);
+
+-- Create trigger to automatically update updated_at
+CREATE TRIGGER update_inquiries_updated_at
+    BEFORE UPDATE ON inquiries
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
@@ -0,0 +1,7 @@
+-- Drop indexes
+DROP INDEX IF EXISTS idx_consultations_email;
+DROP INDEX IF EXISTS idx_consultations_start_at;
+
+-- Drop tables
+DROP TABLE IF EXISTS consultations;
+DROP TABLE IF EXISTS consultation_availability;
@@ -0,0 +1,33 @@
+-- Create consultation availability table (a single row)
+CREATE TABLE consultation_availability (
+    id INTEGER PRIMARY KEY CHECK (id = 1),
+    timezone VARCHAR(64) NOT NULL,
+    slot_minutes INTEGER NOT NULL CHECK (slot_minutes > 0),
+    min_notice_hours INTEGER NOT NULL DEFAULT 0,
+    max_days_ahead INTEGER NOT NULL,
+    windows JSONB NOT NULL DEFAULT '[]',
+    host_name VARCHAR(100),
+    host_email VARCHAR(255),
+    updated_by VARCHAR(255),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW() NOT NULL
+);
+
+-- Create consultations table
+CREATE TABLE consultations (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    name VARCHAR(100) NOT NULL,
+    email VARCHAR(255) NOT NULL,
+    topic TEXT,
+    start_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    end_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    timezone VARCHAR(64) NOT NULL,
+
+    -- Timestamps
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW() NOT NULL,
+
+    CHECK (end_at > start_at)
+);
+
+-- Create indexes for better performance
+CREATE INDEX idx_consultations_start_at ON consultations(start_at);
+CREATE INDEX idx_consultations_email ON consultations(email);
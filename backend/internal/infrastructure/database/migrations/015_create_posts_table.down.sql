@@ -0,0 +1,9 @@
+-- Drop trigger
+DROP TRIGGER IF EXISTS update_posts_updated_at ON posts;
+
+-- Drop indexes
+DROP INDEX IF EXISTS idx_posts_category_slug;
+DROP INDEX IF EXISTS idx_posts_status_published_at;
+
+-- Drop tables
+DROP TABLE IF EXISTS posts;
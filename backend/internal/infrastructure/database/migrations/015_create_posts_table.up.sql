@@ -0,0 +1,28 @@
+-- Create blog posts table
+CREATE TABLE posts (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    slug VARCHAR(200) NOT NULL UNIQUE,
+    title VARCHAR(200) NOT NULL,
+    excerpt TEXT,
+    body TEXT NOT NULL,
+    category VARCHAR(100),
+    category_slug VARCHAR(100),
+    author VARCHAR(100) NOT NULL,
+    cover_image VARCHAR(500),
+    status VARCHAR(20) NOT NULL DEFAULT 'draft' CHECK (status IN ('draft', 'published')),
+    published_at TIMESTAMP WITH TIME ZONE,
+
+    -- Timestamps
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW() NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW() NOT NULL
+);
+
+-- Create indexes for better performance
+CREATE INDEX idx_posts_status_published_at ON posts(status, published_at DESC);
+CREATE INDEX idx_posts_category_slug ON posts(category_slug);
+
+-- Create trigger to automatically update updated_at
+CREATE TRIGGER update_posts_updated_at
+    BEFORE UPDATE ON posts
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
@@ -0,0 +1,11 @@
+-- Drop triggers
+DROP TRIGGER IF EXISTS update_projects_updated_at ON projects;
+DROP TRIGGER IF EXISTS update_team_members_updated_at ON team_members;
+
+-- Drop indexes
+DROP INDEX IF EXISTS idx_projects_published_sort_order;
+DROP INDEX IF EXISTS idx_team_members_published_sort_order;
+
+-- Drop tables
+DROP TABLE IF EXISTS projects;
+DROP TABLE IF EXISTS team_members;
@@ -0,0 +1,61 @@
+-- Create team members table
+CREATE TABLE team_members (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    slug VARCHAR(200) NOT NULL UNIQUE,
+    name VARCHAR(100) NOT NULL,
+    title VARCHAR(100) NOT NULL,
+    role VARCHAR(20) NOT NULL CHECK (role IN ('leadership', 'tech', 'client')),
+    location VARCHAR(100),
+    country VARCHAR(100),
+    bio TEXT,
+    quote TEXT,
+    skills JSONB NOT NULL DEFAULT '[]',
+    experience VARCHAR(50),
+    linkedin_url VARCHAR(500),
+    github_url VARCHAR(500),
+    twitter_url VARCHAR(500),
+    email VARCHAR(255),
+    photo JSONB NOT NULL DEFAULT '[]',
+    sort_order INTEGER NOT NULL DEFAULT 0,
+    published BOOLEAN NOT NULL DEFAULT FALSE,
+
+    -- Timestamps
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW() NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW() NOT NULL
+);
+
+-- Create projects table
+CREATE TABLE projects (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    slug VARCHAR(200) NOT NULL UNIQUE,
+    title VARCHAR(200) NOT NULL,
+    category VARCHAR(100),
+    description TEXT,
+    technologies JSONB NOT NULL DEFAULT '[]',
+    metrics JSONB NOT NULL DEFAULT '[]',
+    status VARCHAR(50),
+    timeline VARCHAR(50),
+    url VARCHAR(500),
+    image JSONB NOT NULL DEFAULT '[]',
+    sort_order INTEGER NOT NULL DEFAULT 0,
+    published BOOLEAN NOT NULL DEFAULT FALSE,
+
+    -- Timestamps
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW() NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW() NOT NULL
+);
+
+-- Create indexes for better performance
+CREATE INDEX idx_team_members_published_sort_order ON team_members(published, sort_order);
+CREATE INDEX idx_projects_published_sort_order ON projects(published, sort_order);
+
+-- Create triggers to automatically update updated_at
+CREATE TRIGGER update_team_members_updated_at
+    BEFORE UPDATE ON team_members
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+CREATE TRIGGER update_projects_updated_at
+    BEFORE UPDATE ON projects
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
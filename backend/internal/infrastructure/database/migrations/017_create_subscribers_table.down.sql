@@ -0,0 +1,9 @@
+-- Drop trigger
+DROP TRIGGER IF EXISTS update_subscribers_updated_at ON subscribers;
+
+-- Drop indexes
+DROP INDEX IF EXISTS idx_subscribers_ip_address;
+DROP INDEX IF EXISTS idx_subscribers_status;
+
+-- Drop tables
+DROP TABLE IF EXISTS subscribers;
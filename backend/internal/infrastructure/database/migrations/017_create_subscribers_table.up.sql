@@ -0,0 +1,29 @@
+-- Create newsletter subscribers table
+CREATE TABLE subscribers (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    email VARCHAR(255) NOT NULL UNIQUE,
+    name VARCHAR(100),
+    topics JSONB NOT NULL DEFAULT '[]',
+    status VARCHAR(20) NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'confirmed', 'unsubscribed')),
+    source VARCHAR(50),
+    confirmation_sent_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    confirmed_at TIMESTAMP WITH TIME ZONE,
+    unsubscribed_at TIMESTAMP WITH TIME ZONE,
+
+    -- Metadata
+    ip_address INET,
+
+    -- Timestamps
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW() NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW() NOT NULL
+);
+
+-- Create indexes for better performance
+CREATE INDEX idx_subscribers_status ON subscribers(status, confirmed_at);
+CREATE INDEX idx_subscribers_ip_address ON subscribers(ip_address, confirmation_sent_at);
+
+-- Create trigger to automatically update updated_at
+CREATE TRIGGER update_subscribers_updated_at
+    BEFORE UPDATE ON subscribers
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
@@ -0,0 +1,12 @@
+-- Unlink applications
+DROP INDEX IF EXISTS idx_applications_referral_code_id;
+ALTER TABLE applications DROP COLUMN IF EXISTS referral_code_id;
+
+-- Drop trigger
+DROP TRIGGER IF EXISTS update_referral_codes_updated_at ON referral_codes;
+
+-- Drop indexes
+DROP INDEX IF EXISTS idx_referral_codes_employee_email;
+
+-- Drop table
+DROP TABLE IF EXISTS referral_codes;
@@ -0,0 +1,26 @@
+-- Create employee referral codes table
+CREATE TABLE referral_codes (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    code VARCHAR(32) NOT NULL UNIQUE,
+    employee_name VARCHAR(100) NOT NULL,
+    employee_email VARCHAR(255) NOT NULL,
+    active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_by VARCHAR(255),
+
+    -- Timestamps
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW() NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW() NOT NULL
+);
+
+-- Create indexes for better performance
+CREATE INDEX idx_referral_codes_employee_email ON referral_codes(employee_email);
+
+-- Create trigger to automatically update updated_at
+CREATE TRIGGER update_referral_codes_updated_at
+    BEFORE UPDATE ON referral_codes
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+-- Attribute applications to the referral code they were submitted with
+ALTER TABLE applications ADD COLUMN referral_code_id UUID REFERENCES referral_codes(id);
+CREATE INDEX idx_applications_referral_code_id ON applications(referral_code_id);
@@ -0,0 +1,6 @@
+-- Drop indexes
+DROP INDEX IF EXISTS idx_applications_source;
+DROP INDEX IF EXISTS idx_application_attributions_utm_source;
+
+-- Drop table
+DROP TABLE IF EXISTS application_attributions;
@@ -0,0 +1,18 @@
+-- Create application attributions table
+CREATE TABLE application_attributions (
+    application_id UUID PRIMARY KEY REFERENCES applications(id) ON DELETE CASCADE,
+    utm_source VARCHAR(100),
+    utm_medium VARCHAR(100),
+    utm_campaign VARCHAR(100),
+    utm_term VARCHAR(100),
+    utm_content VARCHAR(100),
+    referrer VARCHAR(500),
+    landing_page VARCHAR(500),
+
+    -- Timestamps
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW() NOT NULL
+);
+
+-- Create indexes for better performance
+CREATE INDEX idx_application_attributions_utm_source ON application_attributions(utm_source);
+CREATE INDEX idx_applications_source ON applications(source);
@@ -0,0 +1,11 @@
+-- Drop trigger
+DROP TRIGGER IF EXISTS update_position_questions_updated_at ON position_questions;
+
+-- Drop indexes
+DROP INDEX IF EXISTS idx_application_answers_question_id;
+DROP INDEX IF EXISTS idx_application_answers_application_id;
+DROP INDEX IF EXISTS idx_position_questions_position_id;
+
+-- Drop tables
+DROP TABLE IF EXISTS application_answers;
+DROP TABLE IF EXISTS position_questions;
@@ -0,0 +1,41 @@
+-- Create position questions table
+CREATE TABLE position_questions (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    position_id VARCHAR(100) NOT NULL REFERENCES positions(id) ON DELETE CASCADE,
+    label VARCHAR(500) NOT NULL,
+    help_text TEXT,
+    type VARCHAR(20) NOT NULL CHECK (type IN ('text', 'single_choice', 'multi_choice', 'yes_no', 'number', 'url')),
+    required BOOLEAN NOT NULL DEFAULT FALSE,
+    options JSONB NOT NULL DEFAULT '[]',
+    sort_order INTEGER NOT NULL DEFAULT 0,
+
+    -- Timestamps
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW() NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW() NOT NULL
+);
+
+-- Create application answers table. Answers keep a copy of the question's
+-- label and type, so they are not tied to the question's lifetime.
+CREATE TABLE application_answers (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    application_id UUID NOT NULL REFERENCES applications(id) ON DELETE CASCADE,
+    question_id UUID NOT NULL,
+    label VARCHAR(500) NOT NULL,
+    type VARCHAR(20) NOT NULL,
+    answer_values JSONB NOT NULL DEFAULT '[]',
+    sort_order INTEGER NOT NULL DEFAULT 0,
+
+    -- Timestamps
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW() NOT NULL
+);
+
+-- Create indexes for better performance
+CREATE INDEX idx_position_questions_position_id ON position_questions(position_id, sort_order);
+CREATE INDEX idx_application_answers_application_id ON application_answers(application_id);
+CREATE INDEX idx_application_answers_question_id ON application_answers(question_id);
+
+-- Create trigger to automatically update updated_at
+CREATE TRIGGER update_position_questions_updated_at
+    BEFORE UPDATE ON position_questions
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
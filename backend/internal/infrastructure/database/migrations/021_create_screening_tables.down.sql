@@ -0,0 +1,12 @@
+-- Drop trigger
+DROP TRIGGER IF EXISTS update_screening_rules_updated_at ON screening_rules;
+
+-- Drop indexes
+DROP INDEX IF EXISTS idx_screening_results_due_at;
+DROP INDEX IF EXISTS idx_screening_results_rule_id;
+DROP INDEX IF EXISTS idx_screening_results_application_id;
+DROP INDEX IF EXISTS idx_screening_rules_position_id;
+
+-- Drop tables
+DROP TABLE IF EXISTS screening_results;
+DROP TABLE IF EXISTS screening_rules;
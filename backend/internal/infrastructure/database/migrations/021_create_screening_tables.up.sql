@@ -0,0 +1,57 @@
+-- Create screening rules table. A rule fires when all of its conditions
+-- (a JSON list of {field, question_id, operator, value}) hold for a new application.
+CREATE TABLE screening_rules (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    position_id VARCHAR(100) NOT NULL REFERENCES positions(id) ON DELETE CASCADE,
+    name VARCHAR(200) NOT NULL,
+    active BOOLEAN NOT NULL DEFAULT TRUE,
+    conditions JSONB NOT NULL DEFAULT '[]',
+    action VARCHAR(20) NOT NULL CHECK (action IN ('tag', 'status', 'notify')),
+
+    -- Action settings
+    tag VARCHAR(50),
+    status VARCHAR(50),
+    delay_hours INTEGER NOT NULL DEFAULT 0 CHECK (delay_hours >= 0),
+    notify_email VARCHAR(255),
+
+    sort_order INTEGER NOT NULL DEFAULT 0,
+    created_by VARCHAR(255),
+
+    -- Timestamps
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW() NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW() NOT NULL
+);
+
+-- Create screening results table. Results keep a copy of the rule's name and
+-- action, so they are not tied to the rule's lifetime.
+CREATE TABLE screening_results (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    application_id UUID NOT NULL REFERENCES applications(id) ON DELETE CASCADE,
+    rule_id UUID NOT NULL,
+    rule_name VARCHAR(200) NOT NULL,
+    action VARCHAR(20) NOT NULL,
+    detail TEXT,
+    outcome VARCHAR(20) NOT NULL CHECK (outcome IN ('applied', 'scheduled', 'skipped', 'failed')),
+    error TEXT,
+
+    -- Delayed status changes
+    from_status VARCHAR(50),
+    to_status VARCHAR(50),
+    due_at TIMESTAMP WITH TIME ZONE,
+    processed_at TIMESTAMP WITH TIME ZONE,
+
+    -- Timestamps
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW() NOT NULL
+);
+
+-- Create indexes for better performance
+CREATE INDEX idx_screening_rules_position_id ON screening_rules(position_id, sort_order);
+CREATE INDEX idx_screening_results_application_id ON screening_results(application_id);
+CREATE INDEX idx_screening_results_rule_id ON screening_results(rule_id);
+CREATE INDEX idx_screening_results_due_at ON screening_results(due_at) WHERE outcome = 'scheduled';
+
+-- Create trigger to automatically update updated_at
+CREATE TRIGGER update_screening_rules_updated_at
+    BEFORE UPDATE ON screening_rules
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
@@ -0,0 +1,6 @@
+-- Drop indexes
+DROP INDEX IF EXISTS idx_email_logs_created_at;
+DROP INDEX IF EXISTS idx_email_logs_recipient;
+
+-- Drop table
+DROP TABLE IF EXISTS email_logs;
@@ -0,0 +1,16 @@
+-- Create email log table. Every email the system sends is recorded with its
+-- recipient and subject; bodies are not kept.
+CREATE TABLE email_logs (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    recipient VARCHAR(255) NOT NULL,
+    subject TEXT NOT NULL,
+    status VARCHAR(20) NOT NULL CHECK (status IN ('sent', 'failed')),
+    error TEXT,
+
+    -- Timestamps
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW() NOT NULL
+);
+
+-- Create indexes for better performance
+CREATE INDEX idx_email_logs_recipient ON email_logs(recipient);
+CREATE INDEX idx_email_logs_created_at ON email_logs(created_at);
@@ -0,0 +1,9 @@
+-- Unmark applications
+DROP INDEX IF EXISTS idx_applications_erased_at;
+ALTER TABLE applications DROP COLUMN IF EXISTS erased_at;
+
+-- Drop indexes
+DROP INDEX IF EXISTS idx_erasure_receipts_subject_hash;
+
+-- Drop table
+DROP TABLE IF EXISTS erasure_receipts;
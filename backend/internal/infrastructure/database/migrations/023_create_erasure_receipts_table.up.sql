@@ -0,0 +1,30 @@
+-- Create erasure receipts table. Each receipt records an erasure without any
+-- personal data: the person is identified by the SHA-256 of their normalized
+-- email. Receipts are hash-chained through previous_hash.
+CREATE TABLE erasure_receipts (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    sequence BIGINT NOT NULL UNIQUE,
+    subject_hash VARCHAR(64) NOT NULL,
+    application_ids JSONB NOT NULL DEFAULT '[]',
+
+    -- What was erased
+    applications_anonymized INTEGER NOT NULL DEFAULT 0,
+    answers_deleted INTEGER NOT NULL DEFAULT 0,
+    comments_deleted INTEGER NOT NULL DEFAULT 0,
+    email_logs_deleted INTEGER NOT NULL DEFAULT 0,
+    resumes_deleted INTEGER NOT NULL DEFAULT 0,
+    candidate_deleted BOOLEAN NOT NULL DEFAULT FALSE,
+
+    reason TEXT,
+    erased_by VARCHAR(255) NOT NULL,
+    erased_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    previous_hash VARCHAR(64) NOT NULL,
+    hash VARCHAR(64) NOT NULL UNIQUE
+);
+
+-- Create indexes for better performance
+CREATE INDEX idx_erasure_receipts_subject_hash ON erasure_receipts(subject_hash);
+
+-- Mark applications whose personal data was erased
+ALTER TABLE applications ADD COLUMN erased_at TIMESTAMP WITH TIME ZONE;
+CREATE INDEX idx_applications_erased_at ON applications(erased_at);
@@ -0,0 +1,2 @@
+-- Redacted names cannot be restored
+SELECT 1;
@@ -0,0 +1,19 @@
+-- Redact the names of candidates and inquirers from email log subjects. New log
+-- entries leave them out, so that erasing a candidate leaves no name behind.
+UPDATE email_logs SET subject = regexp_replace(subject, '^New Job Application: .*? for ', 'New Job Application: [redacted] for ')
+    WHERE subject LIKE 'New Job Application: %';
+UPDATE email_logs SET subject = regexp_replace(subject, '^Application withdrawn: .*? - ', 'Application withdrawn: [redacted] - ')
+    WHERE subject LIKE 'Application withdrawn: %';
+UPDATE email_logs SET subject = regexp_replace(subject, '^Offer (accepted|declined|expired): .*? - ', 'Offer \1: [redacted] - ')
+    WHERE subject LIKE 'Offer %';
+UPDATE email_logs SET subject = regexp_replace(subject, '^Referral update: .*? - ', 'Referral update: [redacted] - ')
+    WHERE subject LIKE 'Referral update: %';
+UPDATE email_logs SET subject = regexp_replace(subject, '^New inquiry: .*$', 'New inquiry: [redacted]')
+    WHERE subject LIKE 'New inquiry: %';
+
+-- These subjects end with the name, after the last " - "
+UPDATE email_logs SET subject = regexp_replace(subject, '^(.*) - .*$', '\1 - [redacted]')
+    WHERE subject LIKE 'Interview: %'
+       OR subject LIKE 'Updated Interview: %'
+       OR subject LIKE 'Cancelled interview: %'
+       OR subject LIKE 'Screening rule matched: %';
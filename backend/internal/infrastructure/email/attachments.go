@@ -0,0 +1,88 @@
+package email
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/smtp"
+	"net/textproto"
+)
+
+// Attachment is a file attached to an outgoing email
+type Attachment struct {
+	Filename    string
+	ContentType string
+	Content     []byte
+}
+
+// sendEmailWithAttachments sends an HTML email with file attachments as multipart/mixed.
+// Names in redact are left out of the email log as with sendEmail.
+func (es *EmailService) sendEmailWithAttachments(to, subject, htmlBody string, attachments []Attachment, redact ...string) error {
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+
+	// HTML part
+	htmlHeader := textproto.MIMEHeader{}
+	htmlHeader.Set("Content-Type", "text/html; charset=UTF-8")
+	htmlHeader.Set("Content-Transfer-Encoding", "base64")
+	part, err := writer.CreatePart(htmlHeader)
+	if err != nil {
+		return fmt.Errorf("failed to create email body: %w", err)
+	}
+	if err := writeBase64(part, []byte(htmlBody)); err != nil {
+		return fmt.Errorf("failed to write email body: %w", err)
+	}
+
+	// Attachment parts
+	for _, attachment := range attachments {
+		header := textproto.MIMEHeader{}
+		header.Set("Content-Type", attachment.ContentType)
+		header.Set("Content-Transfer-Encoding", "base64")
+		header.Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": attachment.Filename}))
+		part, err := writer.CreatePart(header)
+		if err != nil {
+			return fmt.Errorf("failed to create attachment %s: %w", attachment.Filename, err)
+		}
+		if err := writeBase64(part, attachment.Content); err != nil {
+			return fmt.Errorf("failed to write attachment %s: %w", attachment.Filename, err)
+		}
+	}
+
+	if err := writer.Close(); err != nil {
+		return fmt.Errorf("failed to finish email: %w", err)
+	}
+
+	msg := []byte("To: " + to + "\r\n" +
+		"From: " + es.config.FromEmail + "\r\n" +
+		"Subject: " + mime.QEncoding.Encode("UTF-8", subject) + "\r\n" +
+		"MIME-Version: 1.0\r\n" +
+		"Content-Type: multipart/mixed; boundary=" + writer.Boundary() + "\r\n" +
+		"\r\n")
+	msg = append(msg, body.Bytes()...)
+
+	auth := smtp.PlainAuth("", es.config.SMTPUsername, es.config.SMTPPassword, es.config.SMTPHost)
+	smtpAddr := es.config.SMTPHost + ":" + es.config.SMTPPort
+	err = smtp.SendMail(smtpAddr, auth, es.config.FromEmail, []string{to}, msg)
+	es.logEmail(to, redactSubject(subject, redact), err)
+	if err != nil {
+		return fmt.Errorf("failed to send email: %w", err)
+	}
+
+	return nil
+}
+
+// writeBase64 writes content base64-encoded in 76 character lines (RFC 2045)
+func writeBase64(w io.Writer, content []byte) error {
+	encoded := base64.StdEncoding.EncodeToString(content)
+	for len(encoded) > 76 {
+		if _, err := w.Write([]byte(encoded[:76] + "\r\n")); err != nil {
+			return err
+		}
+		encoded = encoded[76:]
+	}
+	_, err := w.Write([]byte(encoded + "\r\n"))
+	return err
+}
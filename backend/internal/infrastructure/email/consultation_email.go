@@ -0,0 +1,162 @@
+package email
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"super2025-backend/internal/domain/entities"
+	"super2025-backend/internal/infrastructure/calendar"
+
+	"go.uber.org/zap"
+)
+
+// SendConsultationConfirmation sends a calendar invite for a booked consultation to
+// the visitor and the host, each with the time shown in their own time zone.
+// The host is the availability's host email, or SALES_EMAIL if none is set.
+func (es *EmailService) SendConsultationConfirmation(consultation *entities.Consultation, availability *entities.ConsultationAvailability) error {
+	// Validate configuration before sending
+	if err := es.validateConfig(); err != nil {
+		es.logger.Error("Email configuration invalid, skipping consultation email", zap.Error(err))
+		return fmt.Errorf("email configuration invalid: %w", err)
+	}
+
+	host := calendar.Attendee{Name: availability.HostName, Email: availability.HostEmail}
+	if host.Email == "" {
+		host.Email = es.config.SalesEmail
+	}
+	if host.Name == "" {
+		host.Name = "Super 2025"
+	}
+	visitor := calendar.Attendee{Name: consultation.Name, Email: consultation.Email}
+
+	summary := fmt.Sprintf("Consultation: %s and %s", consultation.Name, host.Name)
+	event := calendar.Event{
+		UID:         es.eventUID("consultation", consultation.ID),
+		Method:      calendar.MethodRequest,
+		Summary:     summary,
+		Description: consultation.Topic,
+		Start:       consultation.StartAt,
+		End:         consultation.EndAt,
+		Organizer:   calendar.Attendee{Name: "Super 2025", Email: es.config.FromEmail},
+		Attendees:   []calendar.Attendee{visitor, host},
+	}
+	invite := Attachment{
+		Filename:    "invite.ics",
+		ContentType: fmt.Sprintf("text/calendar; charset=UTF-8; method=%s", calendar.MethodRequest),
+		Content:     event.Render(),
+	}
+
+	recipients := []struct {
+		attendee calendar.Attendee
+		timezone string
+		isHost   bool
+	}{
+		{visitor, consultation.Timezone, false},
+		{host, availability.Timezone, true},
+	}
+
+	// Each party gets their own copy; one failed recipient does not stop the other
+	var failed []string
+	for _, recipient := range recipients {
+		loc, err := time.LoadLocation(recipient.timezone)
+		if err != nil {
+			loc = time.UTC
+		}
+		start := consultation.StartAt.In(loc)
+		end := consultation.EndAt.In(loc)
+
+		data := struct {
+			CompanyName  string
+			IsHost       bool
+			VisitorName  string
+			VisitorEmail string
+			HostName     string
+			Topic        string
+			Date         string
+			Time         string
+		}{
+			CompanyName:  "Super 2025",
+			IsHost:       recipient.isHost,
+			VisitorName:  consultation.Name,
+			VisitorEmail: consultation.Email,
+			HostName:     host.Name,
+			Topic:        consultation.Topic,
+			Date:         start.Format("Monday, January 2, 2006"),
+			Time:         fmt.Sprintf("%s - %s %s", start.Format("15:04"), end.Format("15:04"), loc.String()),
+		}
+
+		htmlBody, err := renderTemplate("consultation", consultationTemplate, data)
+		if err != nil {
+			es.logger.Error("Failed to generate consultation template", zap.Error(err))
+			return fmt.Errorf("failed to generate email template: %w", err)
+		}
+
+		subject := fmt.Sprintf("Your consultation with %s is confirmed", host.Name)
+		if recipient.isHost {
+			subject = fmt.Sprintf("New consultation booked: %s", consultation.Name)
+		}
+
+		if err := es.sendEmailWithAttachments(recipient.attendee.Email, subject, htmlBody, []Attachment{invite}); err != nil {
+			es.logger.Error("Failed to send consultation email",
+				zap.String("consultation_id", consultation.ID),
+				zap.String("recipient", recipient.attendee.Email),
+				zap.Error(err))
+			failed = append(failed, recipient.attendee.Email)
+		}
+	}
+	if len(failed) > 0 {
+		return fmt.Errorf("failed to send consultation email to %s", strings.Join(failed, ", "))
+	}
+
+	es.logger.Info("Consultation email sent successfully",
+		zap.String("consultation_id", consultation.ID))
+
+	return nil
+}
+
+const consultationTemplate = `
+<!DOCTYPE html>
+<html>
+<head>
+    <meta charset="UTF-8">
+    <title>Consultation Confirmed</title>
+    <style>
+        body { font-family: Arial, sans-serif; line-height: 1.6; color: #333; }
+        .container { max-width: 600px; margin: 0 auto; padding: 20px; }
+        .header { background-color: #4f46e5; color: white; padding: 20px; text-align: center; }
+        .content { padding: 20px; background-color: #f9f9f9; }
+        .details { background-color: white; padding: 15px; border-left: 4px solid #4f46e5; margin: 15px 0; }
+        .footer { padding: 20px; text-align: center; color: #666; font-size: 12px; }
+        .logo { font-size: 24px; font-weight: bold; }
+    </style>
+</head>
+<body>
+    <div class="container">
+        <div class="header">
+            <div class="logo">{{.CompanyName}}</div>
+            <h1>{{if .IsHost}}New Consultation{{else}}Consultation Confirmed{{end}}</h1>
+        </div>
+        <div class="content">
+            {{if .IsHost}}
+            <p><strong>{{.VisitorName}}</strong> (<a href="mailto:{{.VisitorEmail}}">{{.VisitorEmail}}</a>) has booked a consultation.</p>
+            {{else}}
+            <h2>Dear {{.VisitorName}},</h2>
+            <p>Thank you for booking a consultation with {{.HostName}}. We look forward to speaking with you.</p>
+            {{end}}
+            <div class="details">
+                <p><strong>Date:</strong> {{.Date}}</p>
+                <p><strong>Time:</strong> {{.Time}}</p>
+                {{if .Topic}}<p><strong>Topic:</strong> {{.Topic}}</p>{{end}}
+            </div>
+            <p>The attached calendar invite can be added to your calendar.</p>
+            {{if not .IsHost}}<p>Best regards,<br>
+            The {{.CompanyName}} Team</p>{{end}}
+        </div>
+        <div class="footer">
+            <p>© {{.CompanyName}}. All rights reserved.</p>
+            <p>This is an automated message. Please do not reply to this email.</p>
+        </div>
+    </div>
+</body>
+</html>`
@@ -2,11 +2,16 @@ package email
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"html/template"
+	"mime"
 	"net/smtp"
+	"strings"
 	"time"
 
+	"super2025-backend/internal/domain/entities"
+	"super2025-backend/internal/domain/repositories"
 	"super2025-backend/internal/infrastructure/config"
 
 	"go.uber.org/zap"
@@ -14,15 +19,18 @@ import (
 
 // EmailService handles sending emails
 type EmailService struct {
-	config *config.EmailConfig
-	logger *zap.Logger
+	config   *config.EmailConfig
+	emailLog repositories.EmailLogRepository
+	logger   *zap.Logger
 }
 
-// NewEmailService creates a new email service instance
-func NewEmailService(cfg *config.Config, logger *zap.Logger) *EmailService {
+// NewEmailService creates a new email service instance. Every email sent is
+// recorded in emailLog.
+func NewEmailService(cfg *config.Config, emailLog repositories.EmailLogRepository, logger *zap.Logger) *EmailService {
 	emailService := &EmailService{
-		config: &cfg.Email,
-		logger: logger,
+		config:   &cfg.Email,
+		emailLog: emailLog,
+		logger:   logger,
 	}
 
 	// Validate email configuration
@@ -60,8 +68,9 @@ func (es *EmailService) validateConfig() error {
 	return nil
 }
 
-// SendApplicationConfirmation sends a thank you email to the candidate
-func (es *EmailService) SendApplicationConfirmation(candidateEmail, candidateName, position string) error {
+// SendApplicationConfirmation sends a thank you email to the candidate, with a
+// link to withdraw the application if withdrawLink is set
+func (es *EmailService) SendApplicationConfirmation(candidateEmail, candidateName, position, withdrawLink string) error {
 	// Validate configuration before sending
 	if err := es.validateConfig(); err != nil {
 		es.logger.Error("Email configuration invalid, skipping confirmation email", zap.Error(err))
@@ -75,10 +84,12 @@ func (es *EmailService) SendApplicationConfirmation(candidateEmail, candidateNam
 		CandidateName string
 		Position      string
 		CompanyName   string
+		WithdrawLink  string
 	}{
 		CandidateName: candidateName,
 		Position:      position,
 		CompanyName:   "Super 2025",
+		WithdrawLink:  withdrawLink,
 	}
 
 	// Generate HTML email body
@@ -159,7 +170,7 @@ func (es *EmailService) SendHRNotification(candidateEmail, candidateName, positi
 	}
 
 	// Send email
-	if err := es.sendEmail(hrEmail, subject, htmlBody); err != nil {
+	if err := es.sendEmail(hrEmail, subject, htmlBody, candidateName); err != nil {
 		es.logger.Error("Failed to send HR notification email", 
 			zap.String("hr_email", hrEmail),
 			zap.String("candidate_email", candidateEmail),
@@ -177,15 +188,16 @@ func (es *EmailService) SendHRNotification(candidateEmail, candidateName, positi
 	return nil
 }
 
-// sendEmail sends an email using SMTP
-func (es *EmailService) sendEmail(to, subject, htmlBody string) error {
+// sendEmail sends an email using SMTP. Names in redact are replaced in the copy of
+// the subject kept in the email log, which must not hold a candidate's name.
+func (es *EmailService) sendEmail(to, subject, htmlBody string, redact ...string) error {
 	// SMTP authentication
 	auth := smtp.PlainAuth("", es.config.SMTPUsername, es.config.SMTPPassword, es.config.SMTPHost)
 
 	// Email headers and body
 	msg := []byte("To: " + to + "\r\n" +
 		"From: " + es.config.FromEmail + "\r\n" +
-		"Subject: " + subject + "\r\n" +
+		"Subject: " + mime.QEncoding.Encode("UTF-8", subject) + "\r\n" +
 		"MIME-Version: 1.0\r\n" +
 		"Content-Type: text/html; charset=UTF-8\r\n" +
 		"\r\n" + htmlBody)
@@ -193,6 +205,7 @@ func (es *EmailService) sendEmail(to, subject, htmlBody string) error {
 	// Send email
 	smtpAddr := es.config.SMTPHost + ":" + es.config.SMTPPort
 	err := smtp.SendMail(smtpAddr, auth, es.config.FromEmail, []string{to}, msg)
+	es.logEmail(to, redactSubject(subject, redact), err)
 	if err != nil {
 		return fmt.Errorf("failed to send email: %w", err)
 	}
@@ -200,11 +213,42 @@ func (es *EmailService) sendEmail(to, subject, htmlBody string) error {
 	return nil
 }
 
+// logEmail records an attempt to send an email. Failing to record it does not fail the send.
+func (es *EmailService) logEmail(to, subject string, sendErr error) {
+	entry := &entities.EmailLog{
+		Recipient: to,
+		Subject:   subject,
+		Status:    entities.EmailLogSent,
+	}
+	if sendErr != nil {
+		entry.Status = entities.EmailLogFailed
+		entry.Error = sendErr.Error()
+	}
+
+	if err := es.emailLog.Create(context.Background(), entry); err != nil {
+		es.logger.Error("Failed to record sent email", zap.String("to", to), zap.Error(err))
+	}
+}
+
+// redactedName stands in for the names left out of the email log
+const redactedName = "[redacted]"
+
+// redactSubject replaces each of the names in a subject with a placeholder
+func redactSubject(subject string, names []string) string {
+	for _, name := range names {
+		if name = strings.TrimSpace(name); name != "" {
+			subject = strings.ReplaceAll(subject, name, redactedName)
+		}
+	}
+	return subject
+}
+
 // generateHTMLTemplate generates HTML email template for candidate confirmation
 func (es *EmailService) generateHTMLTemplate(data struct {
 	CandidateName string
 	Position      string
 	CompanyName   string
+	WithdrawLink  string
 }) (string, error) {
 	tmpl := `
 <!DOCTYPE html>
@@ -243,6 +287,7 @@ func (es *EmailService) generateHTMLTemplate(data struct {
         </div>
         <div class="footer">
             <p>© {{.CompanyName}}. All rights reserved.</p>
+            {{if .WithdrawLink}}<p>Changed your mind? You can <a href="{{.WithdrawLink}}">withdraw your application</a> at any time.</p>{{end}}
             <p>This is an automated message. Please do not reply to this email.</p>
         </div>
     </div>
@@ -324,4 +369,180 @@ func (es *EmailService) generateHRNotificationTemplate(data struct {
 	}
 
 	return buf.String(), nil
-} 
\ No newline at end of file
+}
+
+// SendPositionClosedNotification tells a candidate that the position they applied for has closed
+func (es *EmailService) SendPositionClosedNotification(candidateEmail, candidateName, position string) error {
+	// Validate configuration before sending
+	if err := es.validateConfig(); err != nil {
+		es.logger.Error("Email configuration invalid, skipping position closed email", zap.Error(err))
+		return fmt.Errorf("email configuration invalid: %w", err)
+	}
+
+	subject := fmt.Sprintf("Update on your application for %s", position)
+
+	data := struct {
+		CandidateName string
+		Position      string
+		CompanyName   string
+	}{
+		CandidateName: candidateName,
+		Position:      position,
+		CompanyName:   "Super 2025",
+	}
+
+	htmlBody, err := renderTemplate("position_closed", positionClosedTemplate, data)
+	if err != nil {
+		es.logger.Error("Failed to generate position closed template", zap.Error(err))
+		return fmt.Errorf("failed to generate email template: %w", err)
+	}
+
+	if err := es.sendEmail(candidateEmail, subject, htmlBody); err != nil {
+		es.logger.Error("Failed to send position closed email",
+			zap.String("candidate_email", candidateEmail),
+			zap.String("position", position),
+			zap.Error(err))
+		return fmt.Errorf("failed to send position closed email: %w", err)
+	}
+
+	es.logger.Info("Position closed email sent successfully",
+		zap.String("candidate_email", candidateEmail),
+		zap.String("position", position))
+
+	return nil
+}
+
+// renderTemplate executes an HTML email template with the given data
+func renderTemplate(name, tmpl string, data interface{}) (string, error) {
+	t, err := template.New(name).Parse(tmpl)
+	if err != nil {
+		return "", err
+	}
+
+	var buf bytes.Buffer
+	if err := t.Execute(&buf, data); err != nil {
+		return "", err
+	}
+
+	return buf.String(), nil
+}
+
+const positionClosedTemplate = `
+<!DOCTYPE html>
+<html>
+<head>
+    <meta charset="UTF-8">
+    <title>Update on your application</title>
+    <style>
+        body { font-family: Arial, sans-serif; line-height: 1.6; color: #333; }
+        .container { max-width: 600px; margin: 0 auto; padding: 20px; }
+        .header { background-color: #4f46e5; color: white; padding: 20px; text-align: center; }
+        .content { padding: 20px; background-color: #f9f9f9; }
+        .footer { padding: 20px; text-align: center; color: #666; font-size: 12px; }
+        .logo { font-size: 24px; font-weight: bold; }
+    </style>
+</head>
+<body>
+    <div class="container">
+        <div class="header">
+            <div class="logo">{{.CompanyName}}</div>
+            <h1>Update on Your Application</h1>
+        </div>
+        <div class="content">
+            <h2>Dear {{.CandidateName}},</h2>
+            <p>Thank you for your interest in the <strong>{{.Position}}</strong> position at {{.CompanyName}}.</p>
+            <p>This position has now been closed and we will not be moving forward with further applications for it.</p>
+            <p>We encourage you to keep an eye on our careers page for future openings that match your experience.</p>
+            <p>Best regards,<br>
+            The {{.CompanyName}} Careers Team</p>
+        </div>
+        <div class="footer">
+            <p>© {{.CompanyName}}. All rights reserved.</p>
+            <p>This is an automated message. Please do not reply to this email.</p>
+        </div>
+    </div>
+</body>
+</html>`
+
+// SendWithdrawalNotification tells HR that a candidate withdrew their application
+func (es *EmailService) SendWithdrawalNotification(candidateEmail, candidateName, position, reason string) error {
+	// Validate configuration before sending
+	if err := es.validateConfig(); err != nil {
+		es.logger.Error("Email configuration invalid, skipping withdrawal notification", zap.Error(err))
+		return fmt.Errorf("email configuration invalid: %w", err)
+	}
+
+	subject := fmt.Sprintf("Application withdrawn: %s - %s", candidateName, position)
+
+	data := struct {
+		CandidateName  string
+		CandidateEmail string
+		Position       string
+		CompanyName    string
+		Reason         string
+	}{
+		CandidateName:  candidateName,
+		CandidateEmail: candidateEmail,
+		Position:       position,
+		CompanyName:    "Super 2025",
+		Reason:         reason,
+	}
+
+	htmlBody, err := renderTemplate("withdrawal", withdrawalTemplate, data)
+	if err != nil {
+		es.logger.Error("Failed to generate withdrawal template", zap.Error(err))
+		return fmt.Errorf("failed to generate email template: %w", err)
+	}
+
+	if err := es.sendEmail(es.config.HREmail, subject, htmlBody, candidateName); err != nil {
+		es.logger.Error("Failed to send withdrawal notification",
+			zap.String("hr_email", es.config.HREmail),
+			zap.String("candidate_email", candidateEmail),
+			zap.Error(err))
+		return fmt.Errorf("failed to send withdrawal notification: %w", err)
+	}
+
+	es.logger.Info("Withdrawal notification sent successfully",
+		zap.String("hr_email", es.config.HREmail),
+		zap.String("candidate_email", candidateEmail),
+		zap.String("position", position))
+
+	return nil
+}
+
+const withdrawalTemplate = `
+<!DOCTYPE html>
+<html>
+<head>
+    <meta charset="UTF-8">
+    <title>Application withdrawn</title>
+    <style>
+        body { font-family: Arial, sans-serif; line-height: 1.6; color: #333; }
+        .container { max-width: 600px; margin: 0 auto; padding: 20px; }
+        .header { background-color: #4f46e5; color: white; padding: 20px; text-align: center; }
+        .content { padding: 20px; background-color: #f9f9f9; }
+        .details { background-color: white; padding: 15px; border-left: 4px solid #4f46e5; margin: 15px 0; }
+        .footer { padding: 20px; text-align: center; color: #666; font-size: 12px; }
+        .logo { font-size: 24px; font-weight: bold; }
+    </style>
+</head>
+<body>
+    <div class="container">
+        <div class="header">
+            <div class="logo">{{.CompanyName}}</div>
+            <h1>Application Withdrawn</h1>
+        </div>
+        <div class="content">
+            <p><strong>{{.CandidateName}}</strong> has withdrawn their application for the <strong>{{.Position}}</strong> position.</p>
+            <div class="details">
+                <p><strong>Email:</strong> {{.CandidateEmail}}</p>
+                {{if .Reason}}<p><strong>Reason given:</strong> {{.Reason}}</p>{{end}}
+            </div>
+        </div>
+        <div class="footer">
+            <p>© {{.CompanyName}}. All rights reserved.</p>
+            <p>This is an automated message from the careers system.</p>
+        </div>
+    </div>
+</body>
+</html>`
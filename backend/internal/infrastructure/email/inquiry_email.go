@@ -0,0 +1,97 @@
+package email
+
+import (
+	"fmt"
+
+	"super2025-backend/internal/domain/entities"
+
+	"go.uber.org/zap"
+)
+
+// SendInquiryNotification notifies sales about a new contact form inquiry
+func (es *EmailService) SendInquiryNotification(inquiry *entities.Inquiry) error {
+	// Validate configuration before sending
+	if err := es.validateConfig(); err != nil {
+		es.logger.Error("Email configuration invalid, skipping inquiry notification", zap.Error(err))
+		return fmt.Errorf("email configuration invalid: %w", err)
+	}
+
+	subject := fmt.Sprintf("New inquiry: %s", inquiry.Name)
+	if inquiry.Company != "" {
+		subject += fmt.Sprintf(" (%s)", inquiry.Company)
+	}
+
+	data := struct {
+		CompanyName string
+		Inquiry     *entities.Inquiry
+		ReceivedAt  string
+	}{
+		CompanyName: "Super 2025",
+		Inquiry:     inquiry,
+		ReceivedAt:  inquiry.CreatedAt.Format("January 2, 2006 at 15:04 MST"),
+	}
+
+	htmlBody, err := renderTemplate("inquiry_notification", inquiryNotificationTemplate, data)
+	if err != nil {
+		es.logger.Error("Failed to generate inquiry template", zap.Error(err))
+		return fmt.Errorf("failed to generate email template: %w", err)
+	}
+
+	if err := es.sendEmail(es.config.SalesEmail, subject, htmlBody, inquiry.Name); err != nil {
+		es.logger.Error("Failed to send inquiry notification",
+			zap.String("sales_email", es.config.SalesEmail),
+			zap.String("inquiry_id", inquiry.ID),
+			zap.Error(err))
+		return fmt.Errorf("failed to send inquiry notification: %w", err)
+	}
+
+	es.logger.Info("Inquiry notification sent successfully",
+		zap.String("sales_email", es.config.SalesEmail),
+		zap.String("inquiry_id", inquiry.ID))
+
+	return nil
+}
+
+const inquiryNotificationTemplate = `
+<!DOCTYPE html>
+<html>
+<head>
+    <meta charset="UTF-8">
+    <title>New Inquiry</title>
+    <style>
+        body { font-family: Arial, sans-serif; line-height: 1.6; color: #333; }
+        .container { max-width: 600px; margin: 0 auto; padding: 20px; }
+        .header { background-color: #4f46e5; color: white; padding: 20px; text-align: center; }
+        .content { padding: 20px; background-color: #f9f9f9; }
+        .details { background-color: white; padding: 15px; border-left: 4px solid #4f46e5; margin: 15px 0; }
+        .message { background-color: white; padding: 15px; white-space: pre-wrap; }
+        .footer { padding: 20px; text-align: center; color: #666; font-size: 12px; }
+        .logo { font-size: 24px; font-weight: bold; }
+    </style>
+</head>
+<body>
+    <div class="container">
+        <div class="header">
+            <div class="logo">{{.CompanyName}}</div>
+            <h1>New Inquiry</h1>
+        </div>
+        <div class="content">
+            <p>A new inquiry was sent through the contact form on {{.ReceivedAt}}.</p>
+            <div class="details">
+                <p><strong>Name:</strong> {{.Inquiry.Name}}</p>
+                <p><strong>Email:</strong> <a href="mailto:{{.Inquiry.Email}}">{{.Inquiry.Email}}</a></p>
+                {{if .Inquiry.Company}}<p><strong>Company:</strong> {{.Inquiry.Company}}</p>{{end}}
+                {{if .Inquiry.Role}}<p><strong>Role:</strong> {{.Inquiry.Role}}</p>{{end}}
+                {{if .Inquiry.ProjectType}}<p><strong>Project type:</strong> {{.Inquiry.ProjectType}}</p>{{end}}
+                {{if .Inquiry.Budget}}<p><strong>Budget:</strong> {{.Inquiry.Budget}}</p>{{end}}
+                {{if .Inquiry.Timeline}}<p><strong>Timeline:</strong> {{.Inquiry.Timeline}}</p>{{end}}
+            </div>
+            <div class="message">{{.Inquiry.Message}}</div>
+        </div>
+        <div class="footer">
+            <p>© {{.CompanyName}}. All rights reserved.</p>
+            <p>This is an automated message from the website contact form.</p>
+        </div>
+    </div>
+</body>
+</html>`
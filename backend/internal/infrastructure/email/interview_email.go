@@ -0,0 +1,185 @@
+package email
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"super2025-backend/internal/domain/entities"
+	"super2025-backend/internal/infrastructure/calendar"
+
+	"go.uber.org/zap"
+)
+
+// SendInterviewInvitation sends a calendar invite for a new or rescheduled interview
+// to the candidate and every interviewer
+func (es *EmailService) SendInterviewInvitation(interview *entities.Interview, candidateEmail, candidateName, position string) error {
+	subject := fmt.Sprintf("Interview: %s - %s", position, candidateName)
+	if interview.Sequence > 0 {
+		subject = "Updated " + subject
+	}
+	return es.sendInterviewEmail(interview, calendar.MethodRequest, subject, candidateEmail, candidateName, position)
+}
+
+// SendInterviewCancellation sends a calendar cancellation for an interview
+// to the candidate and every interviewer
+func (es *EmailService) SendInterviewCancellation(interview *entities.Interview, candidateEmail, candidateName, position string) error {
+	subject := fmt.Sprintf("Cancelled interview: %s - %s", position, candidateName)
+	return es.sendInterviewEmail(interview, calendar.MethodCancel, subject, candidateEmail, candidateName, position)
+}
+
+func (es *EmailService) sendInterviewEmail(interview *entities.Interview, method calendar.Method, subject, candidateEmail, candidateName, position string) error {
+	// Validate configuration before sending
+	if err := es.validateConfig(); err != nil {
+		es.logger.Error("Email configuration invalid, skipping interview email", zap.Error(err))
+		return fmt.Errorf("email configuration invalid: %w", err)
+	}
+
+	loc, err := time.LoadLocation(interview.Timezone)
+	if err != nil {
+		loc = time.UTC
+	}
+	start := interview.StartAt.In(loc)
+	end := interview.EndAt.In(loc)
+
+	data := struct {
+		CandidateName string
+		Position      string
+		CompanyName   string
+		Date          string
+		Time          string
+		Location      string
+		VideoLink     string
+		Notes         string
+		Cancelled     bool
+	}{
+		CandidateName: candidateName,
+		Position:      position,
+		CompanyName:   "Super 2025",
+		Date:          start.Format("Monday, January 2, 2006"),
+		Time:          fmt.Sprintf("%s - %s %s", start.Format("15:04"), end.Format("15:04"), interview.Timezone),
+		Location:      interview.Location,
+		VideoLink:     interview.VideoLink,
+		Notes:         interview.Notes,
+		Cancelled:     method == calendar.MethodCancel,
+	}
+
+	htmlBody, err := renderTemplate("interview", interviewTemplate, data)
+	if err != nil {
+		es.logger.Error("Failed to generate interview template", zap.Error(err))
+		return fmt.Errorf("failed to generate email template: %w", err)
+	}
+
+	attendees := []calendar.Attendee{{Name: candidateName, Email: candidateEmail}}
+	for _, interviewer := range interview.Interviewers {
+		attendees = append(attendees, calendar.Attendee{Email: interviewer})
+	}
+
+	location := interview.Location
+	if location == "" {
+		location = interview.VideoLink
+	}
+
+	event := calendar.Event{
+		UID:         es.interviewUID(interview),
+		Sequence:    interview.Sequence,
+		Method:      method,
+		Summary:     fmt.Sprintf("Interview: %s - %s", position, candidateName),
+		Description: interview.Notes,
+		Location:    location,
+		URL:         interview.VideoLink,
+		Start:       interview.StartAt,
+		End:         interview.EndAt,
+		Organizer:   calendar.Attendee{Name: "Super 2025 Careers", Email: es.config.FromEmail},
+		Attendees:   attendees,
+	}
+	invite := Attachment{
+		Filename:    "invite.ics",
+		ContentType: fmt.Sprintf("text/calendar; charset=UTF-8; method=%s", method),
+		Content:     event.Render(),
+	}
+
+	// Every attendee gets their own copy; one failed recipient does not stop the rest
+	var failed []string
+	for _, attendee := range attendees {
+		if err := es.sendEmailWithAttachments(attendee.Email, subject, htmlBody, []Attachment{invite}, candidateName); err != nil {
+			es.logger.Error("Failed to send interview email",
+				zap.String("interview_id", interview.ID),
+				zap.String("recipient", attendee.Email),
+				zap.Error(err))
+			failed = append(failed, attendee.Email)
+		}
+	}
+	if len(failed) > 0 {
+		return fmt.Errorf("failed to send interview email to %s", strings.Join(failed, ", "))
+	}
+
+	es.logger.Info("Interview email sent successfully",
+		zap.String("interview_id", interview.ID),
+		zap.String("method", string(method)),
+		zap.Int("sequence", interview.Sequence),
+		zap.Int("recipients", len(attendees)))
+
+	return nil
+}
+
+// interviewUID builds a globally unique, stable calendar UID for an interview
+func (es *EmailService) interviewUID(interview *entities.Interview) string {
+	return es.eventUID("interview", interview.ID)
+}
+
+// eventUID returns a globally unique calendar UID for an event, scoped to the sender's domain
+func (es *EmailService) eventUID(kind, id string) string {
+	domain := "super2025.com"
+	if at := strings.LastIndex(es.config.FromEmail, "@"); at >= 0 && at < len(es.config.FromEmail)-1 {
+		domain = es.config.FromEmail[at+1:]
+	}
+	return fmt.Sprintf("%s-%s@%s", kind, id, domain)
+}
+
+const interviewTemplate = `
+<!DOCTYPE html>
+<html>
+<head>
+    <meta charset="UTF-8">
+    <title>Interview</title>
+    <style>
+        body { font-family: Arial, sans-serif; line-height: 1.6; color: #333; }
+        .container { max-width: 600px; margin: 0 auto; padding: 20px; }
+        .header { background-color: #4f46e5; color: white; padding: 20px; text-align: center; }
+        .content { padding: 20px; background-color: #f9f9f9; }
+        .details { background-color: white; padding: 15px; border-left: 4px solid #4f46e5; margin: 15px 0; }
+        .footer { padding: 20px; text-align: center; color: #666; font-size: 12px; }
+        .logo { font-size: 24px; font-weight: bold; }
+    </style>
+</head>
+<body>
+    <div class="container">
+        <div class="header">
+            <div class="logo">{{.CompanyName}}</div>
+            <h1>{{if .Cancelled}}Interview Cancelled{{else}}Interview Invitation{{end}}</h1>
+        </div>
+        <div class="content">
+            {{if .Cancelled}}
+            <p>The interview with <strong>{{.CandidateName}}</strong> for the <strong>{{.Position}}</strong> position has been cancelled.</p>
+            {{else}}
+            <p>An interview with <strong>{{.CandidateName}}</strong> for the <strong>{{.Position}}</strong> position has been scheduled.</p>
+            {{end}}
+            <div class="details">
+                <p><strong>Date:</strong> {{.Date}}</p>
+                <p><strong>Time:</strong> {{.Time}}</p>
+                {{if .Location}}<p><strong>Location:</strong> {{.Location}}</p>{{end}}
+                {{if .VideoLink}}<p><strong>Video call:</strong> <a href="{{.VideoLink}}">{{.VideoLink}}</a></p>{{end}}
+                {{if .Notes}}<p><strong>Notes:</strong> {{.Notes}}</p>{{end}}
+            </div>
+            {{if not .Cancelled}}<p>The attached calendar invite can be added to your calendar.</p>{{end}}
+            <p>Best regards,<br>
+            The {{.CompanyName}} Careers Team</p>
+        </div>
+        <div class="footer">
+            <p>© {{.CompanyName}}. All rights reserved.</p>
+            <p>This is an automated message. Please do not reply to this email.</p>
+        </div>
+    </div>
+</body>
+</html>`
@@ -0,0 +1,182 @@
+package email
+
+import (
+	"fmt"
+	"mime"
+	"net/smtp"
+
+	"go.uber.org/zap"
+)
+
+// SendSubscriptionConfirmation sends the double opt-in link to a new newsletter subscriber
+func (es *EmailService) SendSubscriptionConfirmation(email, name, confirmLink string) error {
+	// Validate configuration before sending
+	if err := es.validateConfig(); err != nil {
+		es.logger.Error("Email configuration invalid, skipping subscription confirmation", zap.Error(err))
+		return fmt.Errorf("email configuration invalid: %w", err)
+	}
+
+	subject := "Please confirm your subscription"
+
+	data := struct {
+		Name        string
+		CompanyName string
+		Link        string
+	}{
+		Name:        name,
+		CompanyName: "Super 2025",
+		Link:        confirmLink,
+	}
+
+	htmlBody, err := renderTemplate("subscription_confirmation", subscriptionConfirmationTemplate, data)
+	if err != nil {
+		es.logger.Error("Failed to generate subscription confirmation template", zap.Error(err))
+		return fmt.Errorf("failed to generate email template: %w", err)
+	}
+
+	if err := es.sendEmail(email, subject, htmlBody); err != nil {
+		es.logger.Error("Failed to send subscription confirmation",
+			zap.String("email", email),
+			zap.Error(err))
+		return fmt.Errorf("failed to send subscription confirmation: %w", err)
+	}
+
+	es.logger.Info("Subscription confirmation sent successfully", zap.String("email", email))
+
+	return nil
+}
+
+// SendSubscriptionWelcome welcomes a confirmed subscriber. Like every newsletter, it
+// carries RFC 8058 headers so mail clients can offer one-click unsubscribe.
+func (es *EmailService) SendSubscriptionWelcome(email, name, unsubscribeLink, oneClickUnsubscribeURL string) error {
+	// Validate configuration before sending
+	if err := es.validateConfig(); err != nil {
+		es.logger.Error("Email configuration invalid, skipping subscription welcome", zap.Error(err))
+		return fmt.Errorf("email configuration invalid: %w", err)
+	}
+
+	subject := "Welcome to the Super 2025 newsletter"
+
+	data := struct {
+		Name            string
+		CompanyName     string
+		UnsubscribeLink string
+	}{
+		Name:            name,
+		CompanyName:     "Super 2025",
+		UnsubscribeLink: unsubscribeLink,
+	}
+
+	htmlBody, err := renderTemplate("subscription_welcome", subscriptionWelcomeTemplate, data)
+	if err != nil {
+		es.logger.Error("Failed to generate subscription welcome template", zap.Error(err))
+		return fmt.Errorf("failed to generate email template: %w", err)
+	}
+
+	if err := es.sendListEmail(email, subject, htmlBody, oneClickUnsubscribeURL); err != nil {
+		es.logger.Error("Failed to send subscription welcome",
+			zap.String("email", email),
+			zap.Error(err))
+		return fmt.Errorf("failed to send subscription welcome: %w", err)
+	}
+
+	es.logger.Info("Subscription welcome sent successfully", zap.String("email", email))
+
+	return nil
+}
+
+// sendListEmail sends a mailing list email with List-Unsubscribe and List-Unsubscribe-Post
+// headers (RFC 2369, RFC 8058). Mail clients POST "List-Unsubscribe=One-Click" to the URL.
+func (es *EmailService) sendListEmail(to, subject, htmlBody, unsubscribeURL string) error {
+	msg := []byte("To: " + to + "\r\n" +
+		"From: " + es.config.FromEmail + "\r\n" +
+		"Subject: " + mime.QEncoding.Encode("UTF-8", subject) + "\r\n" +
+		"List-Unsubscribe: <" + unsubscribeURL + ">\r\n" +
+		"List-Unsubscribe-Post: List-Unsubscribe=One-Click\r\n" +
+		"MIME-Version: 1.0\r\n" +
+		"Content-Type: text/html; charset=UTF-8\r\n" +
+		"\r\n" + htmlBody)
+
+	auth := smtp.PlainAuth("", es.config.SMTPUsername, es.config.SMTPPassword, es.config.SMTPHost)
+	smtpAddr := es.config.SMTPHost + ":" + es.config.SMTPPort
+	err := smtp.SendMail(smtpAddr, auth, es.config.FromEmail, []string{to}, msg)
+	es.logEmail(to, subject, err)
+	if err != nil {
+		return fmt.Errorf("failed to send email: %w", err)
+	}
+
+	return nil
+}
+
+const subscriptionConfirmationTemplate = `
+<!DOCTYPE html>
+<html>
+<head>
+    <meta charset="UTF-8">
+    <title>Confirm your subscription</title>
+    <style>
+        body { font-family: Arial, sans-serif; line-height: 1.6; color: #333; }
+        .container { max-width: 600px; margin: 0 auto; padding: 20px; }
+        .header { background-color: #4f46e5; color: white; padding: 20px; text-align: center; }
+        .content { padding: 20px; background-color: #f9f9f9; }
+        .button { display: inline-block; background-color: #4f46e5; color: white; padding: 12px 24px; text-decoration: none; border-radius: 4px; }
+        .footer { padding: 20px; text-align: center; color: #666; font-size: 12px; }
+        .logo { font-size: 24px; font-weight: bold; }
+    </style>
+</head>
+<body>
+    <div class="container">
+        <div class="header">
+            <div class="logo">{{.CompanyName}}</div>
+            <h1>Confirm Your Subscription</h1>
+        </div>
+        <div class="content">
+            <h2>{{if .Name}}Hi {{.Name}},{{else}}Hi there,{{end}}</h2>
+            <p>Thank you for subscribing to news from {{.CompanyName}}. Please confirm that you want to receive our emails.</p>
+            <p style="text-align: center;"><a class="button" href="{{.Link}}">Confirm my subscription</a></p>
+            <p>This link is valid for 7 days. If you did not subscribe, you can safely ignore this email and you will not hear from us again.</p>
+            <p>Best regards,<br>
+            The {{.CompanyName}} Team</p>
+        </div>
+        <div class="footer">
+            <p>© {{.CompanyName}}. All rights reserved.</p>
+            <p>This is an automated message. Please do not reply to this email.</p>
+        </div>
+    </div>
+</body>
+</html>`
+
+const subscriptionWelcomeTemplate = `
+<!DOCTYPE html>
+<html>
+<head>
+    <meta charset="UTF-8">
+    <title>Welcome to our newsletter</title>
+    <style>
+        body { font-family: Arial, sans-serif; line-height: 1.6; color: #333; }
+        .container { max-width: 600px; margin: 0 auto; padding: 20px; }
+        .header { background-color: #4f46e5; color: white; padding: 20px; text-align: center; }
+        .content { padding: 20px; background-color: #f9f9f9; }
+        .footer { padding: 20px; text-align: center; color: #666; font-size: 12px; }
+        .logo { font-size: 24px; font-weight: bold; }
+    </style>
+</head>
+<body>
+    <div class="container">
+        <div class="header">
+            <div class="logo">{{.CompanyName}}</div>
+            <h1>You're Subscribed</h1>
+        </div>
+        <div class="content">
+            <h2>{{if .Name}}Hi {{.Name}},{{else}}Hi there,{{end}}</h2>
+            <p>Your subscription is confirmed. We will keep you posted on the news you signed up for from {{.CompanyName}}.</p>
+            <p>Best regards,<br>
+            The {{.CompanyName}} Team</p>
+        </div>
+        <div class="footer">
+            <p>© {{.CompanyName}}. All rights reserved.</p>
+            <p>No longer interested? <a href="{{.UnsubscribeLink}}">Unsubscribe</a> at any time.</p>
+        </div>
+    </div>
+</body>
+</html>`
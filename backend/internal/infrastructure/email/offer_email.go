@@ -0,0 +1,214 @@
+package email
+
+import (
+	"fmt"
+	"strconv"
+
+	"super2025-backend/internal/domain/entities"
+
+	"go.uber.org/zap"
+)
+
+// SendOfferLetter sends a candidate their offer with the link to accept or decline it
+func (es *EmailService) SendOfferLetter(offer *entities.Offer, candidateEmail, candidateName, position, link string) error {
+	// Validate configuration before sending
+	if err := es.validateConfig(); err != nil {
+		es.logger.Error("Email configuration invalid, skipping offer letter", zap.Error(err))
+		return fmt.Errorf("email configuration invalid: %w", err)
+	}
+
+	subject := fmt.Sprintf("Your offer for %s at Super 2025", position)
+
+	data := struct {
+		CandidateName string
+		Position      string
+		CompanyName   string
+		Salary        string
+		StartDate     string
+		ExpiresAt     string
+		Terms         string
+		AcceptLink    string
+		DeclineLink   string
+	}{
+		CandidateName: candidateName,
+		Position:      position,
+		CompanyName:   "Super 2025",
+		Salary:        formatAmount(offer.Salary) + " " + offer.Currency,
+		StartDate:     offer.StartDate.Format("January 2, 2006"),
+		ExpiresAt:     offer.ExpiresAt.UTC().Format("January 2, 2006 at 15:04 UTC"),
+		Terms:         offer.Terms,
+		AcceptLink:    link + "?decision=accept",
+		DeclineLink:   link + "?decision=decline",
+	}
+
+	htmlBody, err := renderTemplate("offer_letter", offerLetterTemplate, data)
+	if err != nil {
+		es.logger.Error("Failed to generate offer letter template", zap.Error(err))
+		return fmt.Errorf("failed to generate email template: %w", err)
+	}
+
+	if err := es.sendEmail(candidateEmail, subject, htmlBody); err != nil {
+		es.logger.Error("Failed to send offer letter",
+			zap.String("candidate_email", candidateEmail),
+			zap.String("offer_id", offer.ID),
+			zap.Error(err))
+		return fmt.Errorf("failed to send offer letter: %w", err)
+	}
+
+	es.logger.Info("Offer letter sent successfully",
+		zap.String("candidate_email", candidateEmail),
+		zap.String("offer_id", offer.ID))
+
+	return nil
+}
+
+// SendOfferResponseNotification tells HR that an offer was accepted, declined or expired
+func (es *EmailService) SendOfferResponseNotification(offer *entities.Offer, candidateName, position string) error {
+	// Validate configuration before sending
+	if err := es.validateConfig(); err != nil {
+		es.logger.Error("Email configuration invalid, skipping offer notification", zap.Error(err))
+		return fmt.Errorf("email configuration invalid: %w", err)
+	}
+
+	subject := fmt.Sprintf("Offer %s: %s - %s", offer.Status, candidateName, position)
+
+	data := struct {
+		CandidateName string
+		Position      string
+		CompanyName   string
+		Status        string
+		Salary        string
+		StartDate     string
+		DeclineReason string
+	}{
+		CandidateName: candidateName,
+		Position:      position,
+		CompanyName:   "Super 2025",
+		Status:        string(offer.Status),
+		Salary:        formatAmount(offer.Salary) + " " + offer.Currency,
+		StartDate:     offer.StartDate.Format("January 2, 2006"),
+		DeclineReason: offer.DeclineReason,
+	}
+
+	htmlBody, err := renderTemplate("offer_response", offerResponseTemplate, data)
+	if err != nil {
+		es.logger.Error("Failed to generate offer notification template", zap.Error(err))
+		return fmt.Errorf("failed to generate email template: %w", err)
+	}
+
+	if err := es.sendEmail(es.config.HREmail, subject, htmlBody, candidateName); err != nil {
+		es.logger.Error("Failed to send offer notification",
+			zap.String("hr_email", es.config.HREmail),
+			zap.String("offer_id", offer.ID),
+			zap.Error(err))
+		return fmt.Errorf("failed to send offer notification: %w", err)
+	}
+
+	es.logger.Info("Offer notification sent successfully",
+		zap.String("hr_email", es.config.HREmail),
+		zap.String("offer_id", offer.ID),
+		zap.String("status", string(offer.Status)))
+
+	return nil
+}
+
+// formatAmount formats a whole amount with thousands separators, e.g. 85,000
+func formatAmount(amount int64) string {
+	digits := strconv.FormatInt(amount, 10)
+	sign := ""
+	if digits[0] == '-' {
+		sign, digits = "-", digits[1:]
+	}
+	for i := len(digits) - 3; i > 0; i -= 3 {
+		digits = digits[:i] + "," + digits[i:]
+	}
+	return sign + digits
+}
+
+const offerLetterTemplate = `
+<!DOCTYPE html>
+<html>
+<head>
+    <meta charset="UTF-8">
+    <title>Your offer</title>
+    <style>
+        body { font-family: Arial, sans-serif; line-height: 1.6; color: #333; }
+        .container { max-width: 600px; margin: 0 auto; padding: 20px; }
+        .header { background-color: #4f46e5; color: white; padding: 20px; text-align: center; }
+        .content { padding: 20px; background-color: #f9f9f9; }
+        .details { background-color: white; padding: 15px; border-left: 4px solid #4f46e5; margin: 15px 0; }
+        .button { display: inline-block; color: white; padding: 12px 24px; text-decoration: none; border-radius: 4px; margin: 0 5px; }
+        .accept { background-color: #16a34a; }
+        .decline { background-color: #6b7280; }
+        .footer { padding: 20px; text-align: center; color: #666; font-size: 12px; }
+        .logo { font-size: 24px; font-weight: bold; }
+    </style>
+</head>
+<body>
+    <div class="container">
+        <div class="header">
+            <div class="logo">{{.CompanyName}}</div>
+            <h1>Congratulations!</h1>
+        </div>
+        <div class="content">
+            <h2>Dear {{.CandidateName}},</h2>
+            <p>We are delighted to offer you the <strong>{{.Position}}</strong> position at {{.CompanyName}}.</p>
+            <div class="details">
+                <p><strong>Annual salary:</strong> {{.Salary}}</p>
+                <p><strong>Start date:</strong> {{.StartDate}}</p>
+                {{if .Terms}}<p><strong>Terms:</strong><br>{{.Terms}}</p>{{end}}
+            </div>
+            <p>Please let us know your decision by <strong>{{.ExpiresAt}}</strong>.</p>
+            <p style="text-align: center;">
+                <a class="button accept" href="{{.AcceptLink}}">Accept offer</a>
+                <a class="button decline" href="{{.DeclineLink}}">Decline offer</a>
+            </p>
+            <p>This link is personal to you. If you have any questions about the offer, please reach out to your recruiter.</p>
+            <p>Best regards,<br>
+            The {{.CompanyName}} Careers Team</p>
+        </div>
+        <div class="footer">
+            <p>© {{.CompanyName}}. All rights reserved.</p>
+            <p>This is an automated message. Please do not reply to this email.</p>
+        </div>
+    </div>
+</body>
+</html>`
+
+const offerResponseTemplate = `
+<!DOCTYPE html>
+<html>
+<head>
+    <meta charset="UTF-8">
+    <title>Offer {{.Status}}</title>
+    <style>
+        body { font-family: Arial, sans-serif; line-height: 1.6; color: #333; }
+        .container { max-width: 600px; margin: 0 auto; padding: 20px; }
+        .header { background-color: #4f46e5; color: white; padding: 20px; text-align: center; }
+        .content { padding: 20px; background-color: #f9f9f9; }
+        .details { background-color: white; padding: 15px; border-left: 4px solid #4f46e5; margin: 15px 0; }
+        .footer { padding: 20px; text-align: center; color: #666; font-size: 12px; }
+        .logo { font-size: 24px; font-weight: bold; }
+    </style>
+</head>
+<body>
+    <div class="container">
+        <div class="header">
+            <div class="logo">{{.CompanyName}}</div>
+            <h1>Offer {{.Status}}</h1>
+        </div>
+        <div class="content">
+            <p>The offer to <strong>{{.CandidateName}}</strong> for the <strong>{{.Position}}</strong> position has been <strong>{{.Status}}</strong>.</p>
+            <div class="details">
+                <p><strong>Annual salary:</strong> {{.Salary}}</p>
+                <p><strong>Start date:</strong> {{.StartDate}}</p>
+                {{if .DeclineReason}}<p><strong>Reason given:</strong> {{.DeclineReason}}</p>{{end}}
+            </div>
+        </div>
+        <div class="footer">
+            <p>© {{.CompanyName}}. All rights reserved.</p>
+            <p>This is an automated message from the careers system.</p>
+        </div>
+    </div>
+</body>
+</html>`
@@ -0,0 +1,87 @@
+package email
+
+import (
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// SendPortalLoginLink sends a candidate the one-time link to the status portal
+func (es *EmailService) SendPortalLoginLink(candidateEmail, candidateName, link string, expiresAt time.Time) error {
+	// Validate configuration before sending
+	if err := es.validateConfig(); err != nil {
+		es.logger.Error("Email configuration invalid, skipping portal login email", zap.Error(err))
+		return fmt.Errorf("email configuration invalid: %w", err)
+	}
+
+	subject := "Your link to check your application status"
+
+	data := struct {
+		CandidateName string
+		CompanyName   string
+		Link          string
+		ValidFor      string
+	}{
+		CandidateName: candidateName,
+		CompanyName:   "Super 2025",
+		Link:          link,
+		ValidFor:      fmt.Sprintf("%d minutes", int(time.Until(expiresAt).Round(time.Minute).Minutes())),
+	}
+
+	htmlBody, err := renderTemplate("portal_login", portalLoginTemplate, data)
+	if err != nil {
+		es.logger.Error("Failed to generate portal login template", zap.Error(err))
+		return fmt.Errorf("failed to generate email template: %w", err)
+	}
+
+	if err := es.sendEmail(candidateEmail, subject, htmlBody); err != nil {
+		es.logger.Error("Failed to send portal login email",
+			zap.String("candidate_email", candidateEmail),
+			zap.Error(err))
+		return fmt.Errorf("failed to send portal login email: %w", err)
+	}
+
+	es.logger.Info("Portal login email sent successfully",
+		zap.String("candidate_email", candidateEmail))
+
+	return nil
+}
+
+const portalLoginTemplate = `
+<!DOCTYPE html>
+<html>
+<head>
+    <meta charset="UTF-8">
+    <title>Check your application status</title>
+    <style>
+        body { font-family: Arial, sans-serif; line-height: 1.6; color: #333; }
+        .container { max-width: 600px; margin: 0 auto; padding: 20px; }
+        .header { background-color: #4f46e5; color: white; padding: 20px; text-align: center; }
+        .content { padding: 20px; background-color: #f9f9f9; }
+        .button { display: inline-block; background-color: #4f46e5; color: white; padding: 12px 24px; text-decoration: none; border-radius: 4px; }
+        .footer { padding: 20px; text-align: center; color: #666; font-size: 12px; }
+        .logo { font-size: 24px; font-weight: bold; }
+    </style>
+</head>
+<body>
+    <div class="container">
+        <div class="header">
+            <div class="logo">{{.CompanyName}}</div>
+            <h1>Your Applications</h1>
+        </div>
+        <div class="content">
+            <h2>Dear {{.CandidateName}},</h2>
+            <p>Use the button below to see the current status of your applications with us.</p>
+            <p style="text-align: center;"><a class="button" href="{{.Link}}">View my applications</a></p>
+            <p>This link can be used once and is valid for {{.ValidFor}}. If you did not ask for it, you can safely ignore this email.</p>
+            <p>Best regards,<br>
+            The {{.CompanyName}} Careers Team</p>
+        </div>
+        <div class="footer">
+            <p>© {{.CompanyName}}. All rights reserved.</p>
+            <p>This is an automated message. Please do not reply to this email.</p>
+        </div>
+    </div>
+</body>
+</html>`
@@ -0,0 +1,111 @@
+package email
+
+import (
+	"fmt"
+
+	"super2025-backend/internal/domain/entities"
+
+	"go.uber.org/zap"
+)
+
+// referralStatusMessages describes each application status to the referring employee
+var referralStatusMessages = map[entities.ApplicationStatus]string{
+	entities.StatusPending:   "The application has been received and is waiting for review.",
+	entities.StatusReviewing: "Our recruiters are reviewing the application.",
+	entities.StatusInterview: "The candidate has moved on to the interview stage.",
+	entities.StatusOffered:   "The candidate has received an offer.",
+	entities.StatusHired:     "The candidate has accepted and joined the team. Thank you for the referral!",
+	entities.StatusRejected:  "We have decided not to move forward with the application.",
+	entities.StatusWithdrawn: "The candidate has withdrawn their application.",
+}
+
+// SendReferralStatusUpdate tells an employee that the application of someone they referred changed status
+func (es *EmailService) SendReferralStatusUpdate(referral *entities.ReferralCode, candidateName, position string, status entities.ApplicationStatus) error {
+	// Validate configuration before sending
+	if err := es.validateConfig(); err != nil {
+		es.logger.Error("Email configuration invalid, skipping referral status update", zap.Error(err))
+		return fmt.Errorf("email configuration invalid: %w", err)
+	}
+
+	subject := fmt.Sprintf("Referral update: %s - %s", candidateName, position)
+
+	data := struct {
+		EmployeeName  string
+		CandidateName string
+		Position      string
+		CompanyName   string
+		Status        string
+		Message       string
+		Code          string
+	}{
+		EmployeeName:  referral.EmployeeName,
+		CandidateName: candidateName,
+		Position:      position,
+		CompanyName:   "Super 2025",
+		Status:        string(status),
+		Message:       referralStatusMessages[status],
+		Code:          referral.Code,
+	}
+
+	htmlBody, err := renderTemplate("referral_status", referralStatusTemplate, data)
+	if err != nil {
+		es.logger.Error("Failed to generate referral status template", zap.Error(err))
+		return fmt.Errorf("failed to generate email template: %w", err)
+	}
+
+	if err := es.sendEmail(referral.EmployeeEmail, subject, htmlBody, candidateName); err != nil {
+		es.logger.Error("Failed to send referral status update",
+			zap.String("employee_email", referral.EmployeeEmail),
+			zap.String("referral_code_id", referral.ID),
+			zap.Error(err))
+		return fmt.Errorf("failed to send referral status update: %w", err)
+	}
+
+	es.logger.Info("Referral status update sent successfully",
+		zap.String("employee_email", referral.EmployeeEmail),
+		zap.String("referral_code_id", referral.ID),
+		zap.String("status", string(status)))
+
+	return nil
+}
+
+const referralStatusTemplate = `
+<!DOCTYPE html>
+<html>
+<head>
+    <meta charset="UTF-8">
+    <title>Referral update</title>
+    <style>
+        body { font-family: Arial, sans-serif; line-height: 1.6; color: #333; }
+        .container { max-width: 600px; margin: 0 auto; padding: 20px; }
+        .header { background-color: #4f46e5; color: white; padding: 20px; text-align: center; }
+        .content { padding: 20px; background-color: #f9f9f9; }
+        .details { background-color: white; padding: 15px; border-left: 4px solid #4f46e5; margin: 15px 0; }
+        .footer { padding: 20px; text-align: center; color: #666; font-size: 12px; }
+        .logo { font-size: 24px; font-weight: bold; }
+    </style>
+</head>
+<body>
+    <div class="container">
+        <div class="header">
+            <div class="logo">{{.CompanyName}}</div>
+            <h1>Referral update</h1>
+        </div>
+        <div class="content">
+            <h2>Hi {{.EmployeeName}},</h2>
+            <p>There is news about <strong>{{.CandidateName}}</strong>, whom you referred for the <strong>{{.Position}}</strong> position.</p>
+            <div class="details">
+                <p><strong>Status:</strong> {{.Status}}</p>
+                {{if .Message}}<p>{{.Message}}</p>{{end}}
+            </div>
+            <p>Keep the referrals coming — share your code <strong>{{.Code}}</strong> with people you would love to work with.</p>
+            <p>Best regards,<br>
+            The {{.CompanyName}} Careers Team</p>
+        </div>
+        <div class="footer">
+            <p>© {{.CompanyName}}. All rights reserved.</p>
+            <p>This is an automated message. Please do not reply to this email.</p>
+        </div>
+    </div>
+</body>
+</html>`
@@ -0,0 +1,114 @@
+package email
+
+import (
+	"fmt"
+	"time"
+
+	"super2025-backend/internal/domain/entities"
+
+	"go.uber.org/zap"
+)
+
+// SendSchedulingInvitation sends a candidate the link to pick one of the offered interview slots
+func (es *EmailService) SendSchedulingInvitation(invitation *entities.SchedulingInvitation, candidateEmail, candidateName, position, link string) error {
+	// Validate configuration before sending
+	if err := es.validateConfig(); err != nil {
+		es.logger.Error("Email configuration invalid, skipping scheduling email", zap.Error(err))
+		return fmt.Errorf("email configuration invalid: %w", err)
+	}
+
+	loc, err := time.LoadLocation(invitation.Timezone)
+	if err != nil {
+		loc = time.UTC
+	}
+
+	slots := make([]string, len(invitation.Slots))
+	for i, slot := range invitation.Slots {
+		start := slot.StartAt.In(loc)
+		slots[i] = fmt.Sprintf("%s, %s - %s", start.Format("Mon, Jan 2"), start.Format("15:04"), slot.EndAt.In(loc).Format("15:04"))
+	}
+
+	subject := fmt.Sprintf("Schedule your interview for %s", position)
+
+	data := struct {
+		CandidateName string
+		Position      string
+		CompanyName   string
+		Link          string
+		Slots         []string
+		Timezone      string
+		ExpiresAt     string
+	}{
+		CandidateName: candidateName,
+		Position:      position,
+		CompanyName:   "Super 2025",
+		Link:          link,
+		Slots:         slots,
+		Timezone:      invitation.Timezone,
+		ExpiresAt:     invitation.ExpiresAt.In(loc).Format("Monday, January 2, 2006 at 15:04"),
+	}
+
+	htmlBody, err := renderTemplate("scheduling_invitation", schedulingInvitationTemplate, data)
+	if err != nil {
+		es.logger.Error("Failed to generate scheduling template", zap.Error(err))
+		return fmt.Errorf("failed to generate email template: %w", err)
+	}
+
+	if err := es.sendEmail(candidateEmail, subject, htmlBody); err != nil {
+		es.logger.Error("Failed to send scheduling email",
+			zap.String("candidate_email", candidateEmail),
+			zap.String("invitation_id", invitation.ID),
+			zap.Error(err))
+		return fmt.Errorf("failed to send scheduling email: %w", err)
+	}
+
+	es.logger.Info("Scheduling email sent successfully",
+		zap.String("candidate_email", candidateEmail),
+		zap.String("invitation_id", invitation.ID))
+
+	return nil
+}
+
+const schedulingInvitationTemplate = `
+<!DOCTYPE html>
+<html>
+<head>
+    <meta charset="UTF-8">
+    <title>Schedule your interview</title>
+    <style>
+        body { font-family: Arial, sans-serif; line-height: 1.6; color: #333; }
+        .container { max-width: 600px; margin: 0 auto; padding: 20px; }
+        .header { background-color: #4f46e5; color: white; padding: 20px; text-align: center; }
+        .content { padding: 20px; background-color: #f9f9f9; }
+        .details { background-color: white; padding: 15px; border-left: 4px solid #4f46e5; margin: 15px 0; }
+        .button { display: inline-block; background-color: #4f46e5; color: white; padding: 12px 24px; text-decoration: none; border-radius: 4px; }
+        .footer { padding: 20px; text-align: center; color: #666; font-size: 12px; }
+        .logo { font-size: 24px; font-weight: bold; }
+    </style>
+</head>
+<body>
+    <div class="container">
+        <div class="header">
+            <div class="logo">{{.CompanyName}}</div>
+            <h1>Schedule Your Interview</h1>
+        </div>
+        <div class="content">
+            <h2>Dear {{.CandidateName}},</h2>
+            <p>We would like to invite you to an interview for the <strong>{{.Position}}</strong> position. Please pick the time that suits you best from the following options ({{.Timezone}}):</p>
+            <div class="details">
+                <ul>
+                    {{range .Slots}}<li>{{.}}</li>{{end}}
+                </ul>
+            </div>
+            <p style="text-align: center;"><a class="button" href="{{.Link}}">Choose a time</a></p>
+            <p>This link is personal to you and is valid until {{.ExpiresAt}}. Once you have picked a time you will receive a calendar invitation.</p>
+            <p>Best regards,<br>
+            The {{.CompanyName}} Careers Team</p>
+        </div>
+        <div class="footer">
+            <p>© {{.CompanyName}}. All rights reserved.</p>
+            <p>This is an automated message. Please do not reply to this email.</p>
+        </div>
+    </div>
+</body>
+</html>`
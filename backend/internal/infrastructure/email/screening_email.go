@@ -0,0 +1,108 @@
+package email
+
+import (
+	"fmt"
+
+	"super2025-backend/internal/domain/entities"
+
+	"go.uber.org/zap"
+)
+
+// SendScreeningNotification tells a recruiter that a screening rule matched a new application.
+// The notification goes to HR when no recipient is given.
+func (es *EmailService) SendScreeningNotification(recipient string, rule *entities.ScreeningRule, application *entities.Application, position string) error {
+	// Validate configuration before sending
+	if err := es.validateConfig(); err != nil {
+		es.logger.Error("Email configuration invalid, skipping screening notification", zap.Error(err))
+		return fmt.Errorf("email configuration invalid: %w", err)
+	}
+
+	if recipient == "" {
+		recipient = es.config.HREmail
+	}
+	if recipient == "" {
+		es.logger.Error("HR_EMAIL not configured, cannot send screening notification")
+		return fmt.Errorf("HR_EMAIL not configured")
+	}
+
+	subject := fmt.Sprintf("Screening rule matched: %s - %s", rule.Name, application.Name)
+
+	data := struct {
+		RuleName       string
+		CandidateName  string
+		CandidateEmail string
+		Position       string
+		ApplicationID  string
+		Answers        []entities.ApplicationAnswer
+		CompanyName    string
+	}{
+		RuleName:       rule.Name,
+		CandidateName:  application.Name,
+		CandidateEmail: application.Email,
+		Position:       position,
+		ApplicationID:  application.ID,
+		Answers:        application.Answers,
+		CompanyName:    "Super 2025",
+	}
+
+	htmlBody, err := renderTemplate("screening_notification", screeningNotificationTemplate, data)
+	if err != nil {
+		es.logger.Error("Failed to generate screening notification template", zap.Error(err))
+		return fmt.Errorf("failed to generate email template: %w", err)
+	}
+
+	if err := es.sendEmail(recipient, subject, htmlBody, application.Name); err != nil {
+		es.logger.Error("Failed to send screening notification",
+			zap.String("recipient", recipient),
+			zap.String("rule_id", rule.ID),
+			zap.Error(err))
+		return fmt.Errorf("failed to send screening notification: %w", err)
+	}
+
+	es.logger.Info("Screening notification sent successfully",
+		zap.String("recipient", recipient),
+		zap.String("rule_id", rule.ID),
+		zap.String("application_id", application.ID))
+
+	return nil
+}
+
+const screeningNotificationTemplate = `
+<!DOCTYPE html>
+<html>
+<head>
+    <meta charset="UTF-8">
+    <title>Screening rule matched</title>
+    <style>
+        body { font-family: Arial, sans-serif; line-height: 1.6; color: #333; }
+        .container { max-width: 600px; margin: 0 auto; padding: 20px; }
+        .header { background-color: #0f766e; color: white; padding: 20px; text-align: center; }
+        .content { padding: 20px; background-color: #f9f9f9; }
+        .details { background-color: white; padding: 15px; border-left: 4px solid #0f766e; margin: 15px 0; }
+        .footer { padding: 20px; text-align: center; color: #666; font-size: 12px; }
+    </style>
+</head>
+<body>
+    <div class="container">
+        <div class="header">
+            <h1>Screening rule matched</h1>
+        </div>
+        <div class="content">
+            <p>The screening rule <strong>{{.RuleName}}</strong> matched a new application.</p>
+            <div class="details">
+                <p><strong>Candidate:</strong> {{.CandidateName}} ({{.CandidateEmail}})</p>
+                <p><strong>Position:</strong> {{.Position}}</p>
+                <p><strong>Application ID:</strong> {{.ApplicationID}}</p>
+            </div>
+            {{if .Answers}}
+            <div class="details">
+                {{range .Answers}}<p><strong>{{.Label}}</strong><br>{{range $i, $v := .Values}}{{if $i}}, {{end}}{{$v}}{{end}}</p>{{end}}
+            </div>
+            {{end}}
+        </div>
+        <div class="footer">
+            <p>© {{.CompanyName}} HR System. All rights reserved.</p>
+        </div>
+    </div>
+</body>
+</html>`
@@ -0,0 +1,177 @@
+package feed
+
+import (
+	"encoding/xml"
+	"fmt"
+	"time"
+)
+
+// Feed describes a syndication feed that can be rendered as RSS 2.0 or Atom 1.0
+type Feed struct {
+	Title       string
+	Link        string // the site the feed belongs to
+	SelfLink    string // the URL the feed itself is served from
+	Description string
+	Updated     time.Time
+	Items       []Item
+}
+
+// Item is a single entry of a feed
+type Item struct {
+	ID        string // a permanent, unique identifier; the link is used if empty
+	Title     string
+	Link      string
+	Summary   string
+	Author    string
+	Category  string
+	Published time.Time
+	Updated   time.Time
+}
+
+func (i Item) id() string {
+	if i.ID != "" {
+		return i.ID
+	}
+	return i.Link
+}
+
+type rssDocument struct {
+	XMLName xml.Name   `xml:"rss"`
+	Version string     `xml:"version,attr"`
+	Atom    string     `xml:"xmlns:atom,attr"`
+	DC      string     `xml:"xmlns:dc,attr"`
+	Channel rssChannel `xml:"channel"`
+}
+
+type rssChannel struct {
+	Title         string    `xml:"title"`
+	Link          string    `xml:"link"`
+	Description   string    `xml:"description"`
+	LastBuildDate string    `xml:"lastBuildDate,omitempty"`
+	Self          atomLink  `xml:"atom:link"`
+	Items         []rssItem `xml:"item"`
+}
+
+type rssItem struct {
+	Title       string  `xml:"title"`
+	Link        string  `xml:"link"`
+	GUID        rssGUID `xml:"guid"`
+	Description string  `xml:"description,omitempty"`
+	Author      string  `xml:"dc:creator,omitempty"`
+	Category    string  `xml:"category,omitempty"`
+	PubDate     string  `xml:"pubDate"`
+}
+
+type rssGUID struct {
+	IsPermaLink bool   `xml:"isPermaLink,attr"`
+	Value       string `xml:",chardata"`
+}
+
+// RenderRSS produces an RSS 2.0 document for the feed
+func (f Feed) RenderRSS() ([]byte, error) {
+	doc := rssDocument{
+		Version: "2.0",
+		Atom:    "http://www.w3.org/2005/Atom",
+		DC:      "http://purl.org/dc/elements/1.1/",
+		Channel: rssChannel{
+			Title:       f.Title,
+			Link:        f.Link,
+			Description: f.Description,
+			Self:        atomLink{Href: f.SelfLink, Rel: "self", Type: "application/rss+xml"},
+		},
+	}
+	if !f.Updated.IsZero() {
+		doc.Channel.LastBuildDate = f.Updated.UTC().Format(time.RFC1123Z)
+	}
+	for _, item := range f.Items {
+		doc.Channel.Items = append(doc.Channel.Items, rssItem{
+			Title:       item.Title,
+			Link:        item.Link,
+			GUID:        rssGUID{IsPermaLink: item.ID == "", Value: item.id()},
+			Description: item.Summary,
+			Author:      item.Author,
+			Category:    item.Category,
+			PubDate:     item.Published.UTC().Format(time.RFC1123Z),
+		})
+	}
+	return marshal(doc)
+}
+
+type atomDocument struct {
+	XMLName  xml.Name    `xml:"http://www.w3.org/2005/Atom feed"`
+	Title    string      `xml:"title"`
+	ID       string      `xml:"id"`
+	Subtitle string      `xml:"subtitle,omitempty"`
+	Updated  string      `xml:"updated"`
+	Links    []atomLink  `xml:"link"`
+	Entries  []atomEntry `xml:"entry"`
+}
+
+type atomLink struct {
+	Href string `xml:"href,attr"`
+	Rel  string `xml:"rel,attr,omitempty"`
+	Type string `xml:"type,attr,omitempty"`
+}
+
+type atomEntry struct {
+	Title     string        `xml:"title"`
+	ID        string        `xml:"id"`
+	Link      atomLink      `xml:"link"`
+	Published string        `xml:"published"`
+	Updated   string        `xml:"updated"`
+	Summary   string        `xml:"summary,omitempty"`
+	Author    *atomAuthor   `xml:"author,omitempty"`
+	Category  *atomCategory `xml:"category,omitempty"`
+}
+
+type atomAuthor struct {
+	Name string `xml:"name"`
+}
+
+type atomCategory struct {
+	Term string `xml:"term,attr"`
+}
+
+// RenderAtom produces an Atom 1.0 document (RFC 4287) for the feed
+func (f Feed) RenderAtom() ([]byte, error) {
+	doc := atomDocument{
+		Title:    f.Title,
+		ID:       f.Link,
+		Subtitle: f.Description,
+		Updated:  f.Updated.UTC().Format(time.RFC3339),
+		Links: []atomLink{
+			{Href: f.Link, Rel: "alternate", Type: "text/html"},
+			{Href: f.SelfLink, Rel: "self", Type: "application/atom+xml"},
+		},
+	}
+	for _, item := range f.Items {
+		updated := item.Updated
+		if updated.IsZero() {
+			updated = item.Published
+		}
+		entry := atomEntry{
+			Title:     item.Title,
+			ID:        item.id(),
+			Link:      atomLink{Href: item.Link, Rel: "alternate", Type: "text/html"},
+			Published: item.Published.UTC().Format(time.RFC3339),
+			Updated:   updated.UTC().Format(time.RFC3339),
+			Summary:   item.Summary,
+		}
+		if item.Author != "" {
+			entry.Author = &atomAuthor{Name: item.Author}
+		}
+		if item.Category != "" {
+			entry.Category = &atomCategory{Term: item.Category}
+		}
+		doc.Entries = append(doc.Entries, entry)
+	}
+	return marshal(doc)
+}
+
+func marshal(doc interface{}) ([]byte, error) {
+	data, err := xml.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to render feed: %w", err)
+	}
+	return append([]byte(xml.Header), data...), nil
+}
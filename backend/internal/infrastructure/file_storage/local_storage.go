@@ -18,19 +18,31 @@ import (
 type LocalStorage struct {
 	uploadDir string
 	baseURL   string
+	urlPath   string
 	logger    *zap.Logger
 }
 
-// NewLocalStorage creates a new local storage instance
+// NewLocalStorage creates a new local storage instance for resumes
 func NewLocalStorage(cfg *config.Config, logger *zap.Logger) (*LocalStorage, error) {
+	return newLocalStorage(cfg.FileStorage.UploadDir, cfg.Application.BaseURL, "resumes", logger)
+}
+
+// NewLocalImageStorage creates a new local storage instance for public images,
+// served under /api/v1/files/images
+func NewLocalImageStorage(cfg *config.Config, logger *zap.Logger) (*LocalStorage, error) {
+	return newLocalStorage(cfg.FileStorage.ImageUploadDir, cfg.Application.BaseURL, "images", logger)
+}
+
+func newLocalStorage(uploadDir, baseURL, urlPath string, logger *zap.Logger) (*LocalStorage, error) {
 	// Create upload directory if it doesn't exist
-	if err := os.MkdirAll(cfg.FileStorage.UploadDir, 0755); err != nil {
+	if err := os.MkdirAll(uploadDir, 0755); err != nil {
 		return nil, fmt.Errorf("failed to create upload directory: %w", err)
 	}
 
 	return &LocalStorage{
-		uploadDir: cfg.FileStorage.UploadDir,
-		baseURL:   cfg.Application.BaseURL,
+		uploadDir: uploadDir,
+		baseURL:   baseURL,
+		urlPath:   urlPath,
 		logger:    logger,
 	}, nil
 }
@@ -63,7 +75,7 @@ func (ls *LocalStorage) Upload(ctx context.Context, filename string, content []b
 	}
 
 	// Generate public URL
-	publicURL := fmt.Sprintf("%s/api/v1/files/resumes/%s", ls.baseURL, filename)
+	publicURL := fmt.Sprintf("%s/api/v1/files/%s/%s", ls.baseURL, ls.urlPath, filename)
 	
 	ls.logger.Info("File uploaded successfully", 
 		zap.String("filename", filename), 
@@ -106,6 +118,28 @@ func (ls *LocalStorage) GetSignedURL(ctx context.Context, url string, expiration
 	return url, nil
 }
 
+// Download reads back a stored file by its URL
+func (ls *LocalStorage) Download(ctx context.Context, url string) ([]byte, error) {
+	filename := extractFilenameFromURL(url)
+	if filename == "" {
+		return nil, fmt.Errorf("invalid URL: cannot extract filename")
+	}
+
+	file, _, err := ls.ServeFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	if closer, ok := file.(io.Closer); ok {
+		defer closer.Close()
+	}
+
+	content, err := io.ReadAll(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file: %w", err)
+	}
+	return content, nil
+}
+
 // ServeFile serves a file from local storage (used by HTTP handler)
 func (ls *LocalStorage) ServeFile(filename string) (io.ReadSeeker, int64, error) {
 	// Sanitize filename to prevent directory traversal
@@ -0,0 +1,162 @@
+// Package imaging produces resized copies of uploaded images using only the standard library.
+package imaging
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"image/draw"
+	_ "image/gif" // register the GIF decoder
+	"image/jpeg"
+	"image/png"
+	"sort"
+)
+
+const (
+	// maxPixels guards against decompression bombs: a small file that decodes to a huge bitmap
+	maxPixels = 40_000_000
+	// jpegQuality is the encoder quality for JPEG variants
+	jpegQuality = 85
+)
+
+var (
+	// ErrUnsupportedFormat is returned for content that is not a JPEG, PNG or GIF image
+	ErrUnsupportedFormat = errors.New("unsupported image format")
+	// ErrTooManyPixels is returned for images whose dimensions exceed maxPixels
+	ErrTooManyPixels = errors.New("image dimensions are too large")
+)
+
+// Variant is a resized copy of an image
+type Variant struct {
+	Width  int
+	Height int
+	// Extension is ".jpg" for JPEG sources and ".png" otherwise
+	Extension   string
+	ContentType string
+	Content     []byte
+}
+
+// Resize decodes content and returns one copy per requested width, narrowest first.
+// Images are never upscaled: widths wider than the source are skipped, and if all of
+// them are, a single copy at the source width is returned. JPEG orientation metadata
+// is applied so portraits taken on phones come out upright.
+func Resize(content []byte, widths []int) ([]Variant, error) {
+	config, format, err := image.DecodeConfig(bytes.NewReader(content))
+	if err != nil {
+		return nil, ErrUnsupportedFormat
+	}
+	if config.Width <= 0 || config.Height <= 0 || config.Width*config.Height > maxPixels {
+		return nil, ErrTooManyPixels
+	}
+
+	decoded, _, err := image.Decode(bytes.NewReader(content))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode image: %w", err)
+	}
+
+	src := toRGBA(decoded)
+	if format == "jpeg" {
+		src = orient(src, jpegOrientation(content))
+	}
+	srcWidth := src.Bounds().Dx()
+
+	targets := make([]int, 0, len(widths))
+	for _, w := range widths {
+		if w > 0 && w <= srcWidth {
+			targets = append(targets, w)
+		}
+	}
+	if len(targets) == 0 {
+		targets = append(targets, srcWidth)
+	}
+	sort.Ints(targets)
+
+	variants := make([]Variant, 0, len(targets))
+	for _, w := range targets {
+		resized := src
+		if w != srcWidth {
+			resized = downscale(src, w)
+		}
+		variant, err := encode(resized, format)
+		if err != nil {
+			return nil, err
+		}
+		variants = append(variants, variant)
+	}
+	return variants, nil
+}
+
+func encode(img *image.RGBA, format string) (Variant, error) {
+	var buf bytes.Buffer
+	variant := Variant{Width: img.Bounds().Dx(), Height: img.Bounds().Dy()}
+
+	if format == "jpeg" {
+		if err := jpeg.Encode(&buf, img, &jpeg.Options{Quality: jpegQuality}); err != nil {
+			return Variant{}, fmt.Errorf("failed to encode image: %w", err)
+		}
+		variant.Extension = ".jpg"
+		variant.ContentType = "image/jpeg"
+	} else {
+		if err := png.Encode(&buf, img); err != nil {
+			return Variant{}, fmt.Errorf("failed to encode image: %w", err)
+		}
+		variant.Extension = ".png"
+		variant.ContentType = "image/png"
+	}
+
+	variant.Content = buf.Bytes()
+	return variant, nil
+}
+
+// toRGBA copies img into an RGBA bitmap with its origin at (0, 0)
+func toRGBA(img image.Image) *image.RGBA {
+	b := img.Bounds()
+	dst := image.NewRGBA(image.Rect(0, 0, b.Dx(), b.Dy()))
+	draw.Draw(dst, dst.Bounds(), img, b.Min, draw.Src)
+	return dst
+}
+
+// downscale resizes src to width using a box filter: each destination pixel is
+// the average of the source pixels it covers, which avoids aliasing when shrinking.
+func downscale(src *image.RGBA, width int) *image.RGBA {
+	sw, sh := src.Bounds().Dx(), src.Bounds().Dy()
+	height := (sh*width + sw/2) / sw
+	if height < 1 {
+		height = 1
+	}
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+
+	for dy := 0; dy < height; dy++ {
+		y0, y1 := dy*sh/height, (dy+1)*sh/height
+		if y1 == y0 {
+			y1 = y0 + 1
+		}
+		for dx := 0; dx < width; dx++ {
+			x0, x1 := dx*sw/width, (dx+1)*sw/width
+			if x1 == x0 {
+				x1 = x0 + 1
+			}
+
+			var r, g, b, a, n uint64
+			for y := y0; y < y1; y++ {
+				row := src.Pix[y*src.Stride:]
+				for x := x0; x < x1; x++ {
+					p := row[x*4 : x*4+4]
+					r += uint64(p[0])
+					g += uint64(p[1])
+					b += uint64(p[2])
+					a += uint64(p[3])
+					n++
+				}
+			}
+
+			o := dst.PixOffset(dx, dy)
+			dst.Pix[o+0] = uint8(r / n)
+			dst.Pix[o+1] = uint8(g / n)
+			dst.Pix[o+2] = uint8(b / n)
+			dst.Pix[o+3] = uint8(a / n)
+		}
+	}
+	return dst
+}
@@ -0,0 +1,109 @@
+package imaging
+
+import (
+	"encoding/binary"
+	"image"
+)
+
+// orient applies an EXIF orientation (1-8) so the image displays upright
+func orient(src *image.RGBA, orientation int) *image.RGBA {
+	if orientation < 2 || orientation > 8 {
+		return src
+	}
+
+	w, h := src.Bounds().Dx(), src.Bounds().Dy()
+	dw, dh := w, h
+	if orientation >= 5 {
+		dw, dh = h, w
+	}
+	dst := image.NewRGBA(image.Rect(0, 0, dw, dh))
+
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			var tx, ty int
+			switch orientation {
+			case 2: // mirrored horizontally
+				tx, ty = w-1-x, y
+			case 3: // rotated 180
+				tx, ty = w-1-x, h-1-y
+			case 4: // mirrored vertically
+				tx, ty = x, h-1-y
+			case 5: // transposed
+				tx, ty = y, x
+			case 6: // needs a 90 degree clockwise turn
+				tx, ty = h-1-y, x
+			case 7: // transversed
+				tx, ty = h-1-y, w-1-x
+			case 8: // needs a 90 degree counter-clockwise turn
+				tx, ty = y, w-1-x
+			}
+			s := src.PixOffset(x, y)
+			copy(dst.Pix[dst.PixOffset(tx, ty):], src.Pix[s:s+4])
+		}
+	}
+	return dst
+}
+
+// jpegOrientation returns the EXIF orientation of a JPEG, or 1 when it has none
+func jpegOrientation(content []byte) int {
+	if len(content) < 4 || content[0] != 0xFF || content[1] != 0xD8 {
+		return 1
+	}
+
+	for i := 2; i+4 <= len(content); {
+		if content[i] != 0xFF {
+			return 1
+		}
+		marker := content[i+1]
+		if marker == 0xDA || marker == 0xD9 { // image data starts; metadata is over
+			return 1
+		}
+		length := int(binary.BigEndian.Uint16(content[i+2:]))
+		if length < 2 || i+2+length > len(content) {
+			return 1
+		}
+		segment := content[i+4 : i+2+length]
+		if marker == 0xE1 && len(segment) > 6 && string(segment[:6]) == "Exif\x00\x00" {
+			return exifOrientation(segment[6:])
+		}
+		i += 2 + length
+	}
+	return 1
+}
+
+// exifOrientation reads the orientation tag from the first IFD of a TIFF structure
+func exifOrientation(tiff []byte) int {
+	if len(tiff) < 8 {
+		return 1
+	}
+
+	var order binary.ByteOrder
+	switch string(tiff[:2]) {
+	case "II":
+		order = binary.LittleEndian
+	case "MM":
+		order = binary.BigEndian
+	default:
+		return 1
+	}
+	if order.Uint16(tiff[2:]) != 42 {
+		return 1
+	}
+
+	ifd := int(order.Uint32(tiff[4:]))
+	if ifd < 8 || ifd+2 > len(tiff) {
+		return 1
+	}
+	count := int(order.Uint16(tiff[ifd:]))
+	for n := 0; n < count; n++ {
+		entry := ifd + 2 + n*12
+		if entry+12 > len(tiff) {
+			return 1
+		}
+		const orientationTag, shortType = 0x0112, 3
+		if order.Uint16(tiff[entry:]) == orientationTag && order.Uint16(tiff[entry+2:]) == shortType {
+			return int(order.Uint16(tiff[entry+8:]))
+		}
+	}
+	return 1
+}
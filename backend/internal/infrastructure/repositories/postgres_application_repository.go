@@ -10,6 +10,7 @@ import (
 	"super2025-backend/internal/domain/repositories"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 // PostgresApplicationRepository implements the ApplicationRepository interface
@@ -24,9 +25,36 @@ func NewPostgresApplicationRepository(db *gorm.DB) *PostgresApplicationRepositor
 	}
 }
 
-// Create creates a new application in the database
-func (r *PostgresApplicationRepository) Create(ctx context.Context, application *entities.Application) error {
-	if err := r.db.WithContext(ctx).Create(application).Error; err != nil {
+// Create upserts the candidate and creates a new application linked to them, together
+// with its initial status event, so a failed insert leaves no candidate behind
+func (r *PostgresApplicationRepository) Create(ctx context.Context, application *entities.Application, candidate *entities.Candidate) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := upsertCandidate(tx, candidate); err != nil {
+			return err
+		}
+		application.CandidateID = &candidate.ID
+		if err := tx.Omit(clause.Associations).Create(application).Error; err != nil {
+			return err
+		}
+		if application.Attribution != nil {
+			application.Attribution.ApplicationID = application.ID
+			if err := tx.Create(application.Attribution).Error; err != nil {
+				return err
+			}
+		}
+		for i := range application.Answers {
+			application.Answers[i].ApplicationID = application.ID
+		}
+		if len(application.Answers) > 0 {
+			if err := tx.Create(&application.Answers).Error; err != nil {
+				return err
+			}
+		}
+		event := entities.NewStatusEvent(application.ID, "", application.Status, "candidate", "", false)
+		event.CreatedAt = application.CreatedAt
+		return tx.Create(event).Error
+	})
+	if err != nil {
 		return fmt.Errorf("failed to create application: %w", err)
 	}
 	return nil
@@ -35,7 +63,9 @@ func (r *PostgresApplicationRepository) Create(ctx context.Context, application
 // GetByID retrieves an application by ID
 func (r *PostgresApplicationRepository) GetByID(ctx context.Context, id string) (*entities.Application, error) {
 	var application entities.Application
-	if err := r.db.WithContext(ctx).First(&application, "id = ?", id).Error; err != nil {
+	if err := r.db.WithContext(ctx).Preload("Tags").Preload("Attribution").Preload("Answers", func(db *gorm.DB) *gorm.DB {
+		return db.Order("sort_order ASC")
+	}).First(&application, "id = ?", id).Error; err != nil {
 		if err == gorm.ErrRecordNotFound {
 			return nil, errors.ErrApplicationNotFound
 		}
@@ -47,7 +77,7 @@ func (r *PostgresApplicationRepository) GetByID(ctx context.Context, id string)
 // GetByEmailAndPosition retrieves an application by email and position
 func (r *PostgresApplicationRepository) GetByEmailAndPosition(ctx context.Context, email, positionID string) (*entities.Application, error) {
 	var application entities.Application
-	if err := r.db.WithContext(ctx).First(&application, "email = ? AND position_id = ?", email, positionID).Error; err != nil {
+	if err := r.db.WithContext(ctx).First(&application, "LOWER(email) = LOWER(?) AND position_id = ?", email, positionID).Error; err != nil {
 		if err == gorm.ErrRecordNotFound {
 			return nil, errors.ErrApplicationNotFound
 		}
@@ -79,6 +109,16 @@ func (r *PostgresApplicationRepository) List(ctx context.Context, filter reposit
 	if filter.DateTo != "" {
 		query = query.Where("created_at <= ?", filter.DateTo)
 	}
+	if len(filter.Tags) > 0 {
+		tagged := r.db.Table("application_tags apt").
+			Select("apt.application_id").
+			Joins("JOIN tags t ON t.id = apt.tag_id").
+			Where("t.name IN ?", filter.Tags)
+		if filter.TagMatch == repositories.TagMatchAll {
+			tagged = tagged.Group("apt.application_id").Having("COUNT(DISTINCT t.name) = ?", len(filter.Tags))
+		}
+		query = query.Where("id IN (?)", tagged)
+	}
 
 	// Count total records
 	if err := query.Count(&total).Error; err != nil {
@@ -90,7 +130,7 @@ func (r *PostgresApplicationRepository) List(ctx context.Context, filter reposit
 
 	// Apply pagination and ordering
 	orderClause := fmt.Sprintf("%s %s", filter.SortBy, filter.SortOrder)
-	if err := query.Offset(offset).Limit(filter.PageSize).Order(orderClause).Find(&applications).Error; err != nil {
+	if err := query.Preload("Tags").Offset(offset).Limit(filter.PageSize).Order(orderClause).Find(&applications).Error; err != nil {
 		return nil, 0, fmt.Errorf("failed to get applications: %w", err)
 	}
 
@@ -100,33 +140,64 @@ func (r *PostgresApplicationRepository) List(ctx context.Context, filter reposit
 // Update updates an application
 func (r *PostgresApplicationRepository) Update(ctx context.Context, application *entities.Application) error {
 	application.UpdatedAt = time.Now()
-	if err := r.db.WithContext(ctx).Save(application).Error; err != nil {
+	if err := r.db.WithContext(ctx).Omit(clause.Associations).Save(application).Error; err != nil {
 		return fmt.Errorf("failed to update application: %w", err)
 	}
 	return nil
 }
 
-// UpdateStatus updates the status of an application
-func (r *PostgresApplicationRepository) UpdateStatus(ctx context.Context, id string, status entities.ApplicationStatus, processedBy string) error {
-	now := time.Now()
-	result := r.db.WithContext(ctx).Model(&entities.Application{}).
-		Where("id = ?", id).
+// UpdateWithStatusEvent saves the application's new status and records the status event in
+// one transaction. Only the status columns are written, and only while the application is
+// still in the status the event starts from.
+func (r *PostgresApplicationRepository) UpdateWithStatusEvent(ctx context.Context, application *entities.Application, event *entities.ApplicationStatusEvent) error {
+	application.UpdatedAt = time.Now()
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		saved, err := saveStatusMove(tx, &repositories.StatusMove{Application: application, Event: event})
+		if err != nil {
+			return err
+		}
+		if !saved {
+			return errors.ErrApplicationChanged
+		}
+		return nil
+	})
+	if err == errors.ErrApplicationChanged {
+		return err
+	}
+	if err != nil {
+		return fmt.Errorf("failed to update application status: %w", err)
+	}
+	return nil
+}
+
+// saveStatusMove saves an application's new status and records the status event, unless the
+// application is no longer in the status the move starts from. It reports whether it was saved.
+func saveStatusMove(tx *gorm.DB, move *repositories.StatusMove) (bool, error) {
+	application := move.Application
+	result := tx.Model(&entities.Application{}).
+		Where("id = ? AND status = ?", application.ID, move.Event.FromStatus).
 		Updates(map[string]interface{}{
-			"status":       status,
-			"processed_by": processedBy,
-			"processed_at": &now,
-			"updated_at":   now,
+			"status":       application.Status,
+			"processed_at": application.ProcessedAt,
+			"processed_by": application.ProcessedBy,
+			"updated_at":   application.UpdatedAt,
 		})
-
 	if result.Error != nil {
-		return fmt.Errorf("failed to update application status: %w", result.Error)
+		return false, result.Error
 	}
-
 	if result.RowsAffected == 0 {
-		return errors.ErrApplicationNotFound
+		return false, nil
 	}
+	return true, tx.Create(move.Event).Error
+}
 
-	return nil
+// ListStatusEvents retrieves the status history of an application, oldest first
+func (r *PostgresApplicationRepository) ListStatusEvents(ctx context.Context, applicationID string) ([]*entities.ApplicationStatusEvent, error) {
+	var events []*entities.ApplicationStatusEvent
+	if err := r.db.WithContext(ctx).Where("application_id = ?", applicationID).Order("created_at ASC").Find(&events).Error; err != nil {
+		return nil, fmt.Errorf("failed to get status events: %w", err)
+	}
+	return events, nil
 }
 
 // Delete deletes an application
@@ -152,10 +223,31 @@ func (r *PostgresApplicationRepository) GetByEmail(ctx context.Context, email st
 	return applications, nil
 }
 
-// GetByPosition retrieves applications by position
-func (r *PostgresApplicationRepository) GetByPosition(ctx context.Context, position string) ([]*entities.Application, error) {
+// ListByEmail retrieves every application submitted with an email, including deleted ones,
+// oldest first, with tags, attribution and answers
+func (r *PostgresApplicationRepository) ListByEmail(ctx context.Context, email string) ([]*entities.Application, error) {
+	var applications []*entities.Application
+	if err := r.db.WithContext(ctx).Unscoped().Preload("Tags").Preload("Attribution").Preload("Answers", func(db *gorm.DB) *gorm.DB {
+		return db.Order("sort_order ASC")
+	}).Where("LOWER(email) = LOWER(?)", email).Order("created_at ASC").Find(&applications).Error; err != nil {
+		return nil, fmt.Errorf("failed to get applications by email: %w", err)
+	}
+	return applications, nil
+}
+
+// ListByCandidate retrieves all applications linked to a candidate, newest first
+func (r *PostgresApplicationRepository) ListByCandidate(ctx context.Context, candidateID string) ([]*entities.Application, error) {
+	var applications []*entities.Application
+	if err := r.db.WithContext(ctx).Where("candidate_id = ?", candidateID).Order("created_at DESC").Find(&applications).Error; err != nil {
+		return nil, fmt.Errorf("failed to get applications by candidate: %w", err)
+	}
+	return applications, nil
+}
+
+// ListByPositionAndStatus retrieves all applications for a position in the given status
+func (r *PostgresApplicationRepository) ListByPositionAndStatus(ctx context.Context, positionID string, status entities.ApplicationStatus) ([]*entities.Application, error) {
 	var applications []*entities.Application
-	if err := r.db.WithContext(ctx).Where("position = ?", position).Order("created_at DESC").Find(&applications).Error; err != nil {
+	if err := r.db.WithContext(ctx).Where("position_id = ? AND status = ?", positionID, status).Order("created_at ASC").Find(&applications).Error; err != nil {
 		return nil, fmt.Errorf("failed to get applications by position: %w", err)
 	}
 	return applications, nil
@@ -0,0 +1,61 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+
+	"super2025-backend/internal/domain/entities"
+	"super2025-backend/internal/domain/errors"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// PostgresCandidateRepository implements the CandidateRepository interface
+type PostgresCandidateRepository struct {
+	db *gorm.DB
+}
+
+// NewPostgresCandidateRepository creates a new PostgreSQL candidate repository
+func NewPostgresCandidateRepository(db *gorm.DB) *PostgresCandidateRepository {
+	return &PostgresCandidateRepository{
+		db: db,
+	}
+}
+
+// upsertCandidate creates the candidate or updates name and phone of the existing one with
+// the same email. The stored record, including its ID, is written back into candidate.
+func upsertCandidate(db *gorm.DB, candidate *entities.Candidate) error {
+	candidate.Email = entities.NormalizeEmail(candidate.Email)
+	return db.Clauses(
+		clause.OnConflict{
+			Columns:   []clause.Column{{Name: "email"}},
+			DoUpdates: clause.AssignmentColumns([]string{"name", "phone", "updated_at"}),
+		},
+		clause.Returning{},
+	).Create(candidate).Error
+}
+
+// GetByID retrieves a candidate by ID
+func (r *PostgresCandidateRepository) GetByID(ctx context.Context, id string) (*entities.Candidate, error) {
+	var candidate entities.Candidate
+	if err := r.db.WithContext(ctx).First(&candidate, "id = ?", id).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, errors.ErrCandidateNotFound
+		}
+		return nil, fmt.Errorf("failed to get candidate: %w", err)
+	}
+	return &candidate, nil
+}
+
+// GetByEmail retrieves a candidate by normalized email
+func (r *PostgresCandidateRepository) GetByEmail(ctx context.Context, email string) (*entities.Candidate, error) {
+	var candidate entities.Candidate
+	if err := r.db.WithContext(ctx).First(&candidate, "email = ?", entities.NormalizeEmail(email)).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, errors.ErrCandidateNotFound
+		}
+		return nil, fmt.Errorf("failed to get candidate: %w", err)
+	}
+	return &candidate, nil
+}
@@ -0,0 +1,82 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+
+	"super2025-backend/internal/domain/entities"
+	"super2025-backend/internal/domain/errors"
+
+	"gorm.io/gorm"
+)
+
+// PostgresCommentRepository implements the CommentRepository interface
+type PostgresCommentRepository struct {
+	db *gorm.DB
+}
+
+// NewPostgresCommentRepository creates a new PostgreSQL comment repository
+func NewPostgresCommentRepository(db *gorm.DB) *PostgresCommentRepository {
+	return &PostgresCommentRepository{
+		db: db,
+	}
+}
+
+// Create creates a new comment in the database
+func (r *PostgresCommentRepository) Create(ctx context.Context, comment *entities.ApplicationComment) error {
+	if err := r.db.WithContext(ctx).Create(comment).Error; err != nil {
+		return fmt.Errorf("failed to create comment: %w", err)
+	}
+	return nil
+}
+
+// GetByID retrieves a comment of an application by ID
+func (r *PostgresCommentRepository) GetByID(ctx context.Context, applicationID, id string) (*entities.ApplicationComment, error) {
+	var comment entities.ApplicationComment
+	if err := r.db.WithContext(ctx).First(&comment, "id = ? AND application_id = ?", id, applicationID).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, errors.ErrCommentNotFound
+		}
+		return nil, fmt.Errorf("failed to get comment: %w", err)
+	}
+	return &comment, nil
+}
+
+// ListByApplication retrieves all comments of an application, oldest first
+func (r *PostgresCommentRepository) ListByApplication(ctx context.Context, applicationID string) ([]*entities.ApplicationComment, error) {
+	var comments []*entities.ApplicationComment
+	if err := r.db.WithContext(ctx).Where("application_id = ?", applicationID).Order("created_at ASC").Find(&comments).Error; err != nil {
+		return nil, fmt.Errorf("failed to get comments: %w", err)
+	}
+	return comments, nil
+}
+
+// Update updates a comment
+func (r *PostgresCommentRepository) Update(ctx context.Context, comment *entities.ApplicationComment) error {
+	if err := r.db.WithContext(ctx).Save(comment).Error; err != nil {
+		return fmt.Errorf("failed to update comment: %w", err)
+	}
+	return nil
+}
+
+// Delete deletes a comment together with its direct replies
+func (r *PostgresCommentRepository) Delete(ctx context.Context, applicationID, id string) error {
+	var rowsAffected int64
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Delete(&entities.ApplicationComment{}, "id = ? AND application_id = ?", id, applicationID)
+		if result.Error != nil {
+			return result.Error
+		}
+		rowsAffected = result.RowsAffected
+		return tx.Delete(&entities.ApplicationComment{}, "parent_id = ?", id).Error
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete comment: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return errors.ErrCommentNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,91 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"super2025-backend/internal/domain/entities"
+	"super2025-backend/internal/domain/errors"
+
+	"gorm.io/gorm"
+)
+
+// availabilityID is the primary key of the single availability row
+const availabilityID = 1
+
+// PostgresConsultationRepository implements the ConsultationRepository interface
+type PostgresConsultationRepository struct {
+	db *gorm.DB
+}
+
+// NewPostgresConsultationRepository creates a new PostgreSQL consultation repository
+func NewPostgresConsultationRepository(db *gorm.DB) *PostgresConsultationRepository {
+	return &PostgresConsultationRepository{
+		db: db,
+	}
+}
+
+// GetAvailability retrieves the weekly availability
+func (r *PostgresConsultationRepository) GetAvailability(ctx context.Context) (*entities.ConsultationAvailability, error) {
+	var availability entities.ConsultationAvailability
+	if err := r.db.WithContext(ctx).First(&availability, "id = ?", availabilityID).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, errors.ErrAvailabilityNotConfigured
+		}
+		return nil, fmt.Errorf("failed to get consultation availability: %w", err)
+	}
+	return &availability, nil
+}
+
+// SaveAvailability creates or replaces the weekly availability
+func (r *PostgresConsultationRepository) SaveAvailability(ctx context.Context, availability *entities.ConsultationAvailability) error {
+	availability.ID = availabilityID
+	if err := r.db.WithContext(ctx).Save(availability).Error; err != nil {
+		return fmt.Errorf("failed to save consultation availability: %w", err)
+	}
+	return nil
+}
+
+// ListBetween retrieves the consultations overlapping [from, to), earliest first
+func (r *PostgresConsultationRepository) ListBetween(ctx context.Context, from, to time.Time) ([]*entities.Consultation, error) {
+	var consultations []*entities.Consultation
+	err := r.db.WithContext(ctx).
+		Where("start_at < ? AND end_at > ?", to, from).
+		Order("start_at ASC").
+		Find(&consultations).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get consultations: %w", err)
+	}
+	return consultations, nil
+}
+
+// Book atomically stores a consultation. An advisory lock serializes bookings so
+// the overlap check and insert cannot race with another booking.
+func (r *PostgresConsultationRepository) Book(ctx context.Context, consultation *entities.Consultation) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec("SELECT pg_advisory_xact_lock(hashtext(?))", "consultations").Error; err != nil {
+			return err
+		}
+
+		var overlapping int64
+		err := tx.Model(&entities.Consultation{}).
+			Where("start_at < ? AND end_at > ?", consultation.EndAt, consultation.StartAt).
+			Count(&overlapping).Error
+		if err != nil {
+			return err
+		}
+		if overlapping > 0 {
+			return errors.ErrConsultationSlotUnavailable
+		}
+
+		return tx.Create(consultation).Error
+	})
+	if err == errors.ErrConsultationSlotUnavailable {
+		return err
+	}
+	if err != nil {
+		return fmt.Errorf("failed to book consultation: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,39 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+
+	"super2025-backend/internal/domain/entities"
+
+	"gorm.io/gorm"
+)
+
+// PostgresEmailLogRepository implements the EmailLogRepository interface
+type PostgresEmailLogRepository struct {
+	db *gorm.DB
+}
+
+// NewPostgresEmailLogRepository creates a new PostgreSQL email log repository
+func NewPostgresEmailLogRepository(db *gorm.DB) *PostgresEmailLogRepository {
+	return &PostgresEmailLogRepository{
+		db: db,
+	}
+}
+
+// Create records a sent email
+func (r *PostgresEmailLogRepository) Create(ctx context.Context, entry *entities.EmailLog) error {
+	if err := r.db.WithContext(ctx).Create(entry).Error; err != nil {
+		return fmt.Errorf("failed to create email log entry: %w", err)
+	}
+	return nil
+}
+
+// ListByRecipient retrieves the emails sent to an address, oldest first
+func (r *PostgresEmailLogRepository) ListByRecipient(ctx context.Context, email string) ([]*entities.EmailLog, error) {
+	var entries []*entities.EmailLog
+	if err := r.db.WithContext(ctx).Where("recipient = ?", entities.NormalizeEmail(email)).Order("created_at ASC").Find(&entries).Error; err != nil {
+		return nil, fmt.Errorf("failed to get email log: %w", err)
+	}
+	return entries, nil
+}
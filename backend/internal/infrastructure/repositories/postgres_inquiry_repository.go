@@ -0,0 +1,97 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"super2025-backend/internal/domain/entities"
+	"super2025-backend/internal/domain/errors"
+	"super2025-backend/internal/domain/repositories"
+
+	"gorm.io/gorm"
+)
+
+// PostgresInquiryRepository implements the InquiryRepository interface
+type PostgresInquiryRepository struct {
+	db *gorm.DB
+}
+
+// NewPostgresInquiryRepository creates a new PostgreSQL inquiry repository
+func NewPostgresInquiryRepository(db *gorm.DB) *PostgresInquiryRepository {
+	return &PostgresInquiryRepository{
+		db: db,
+	}
+}
+
+// Create stores a new inquiry
+func (r *PostgresInquiryRepository) Create(ctx context.Context, inquiry *entities.Inquiry) error {
+	if err := r.db.WithContext(ctx).Create(inquiry).Error; err != nil {
+		return fmt.Errorf("failed to create inquiry: %w", err)
+	}
+	return nil
+}
+
+// GetByID retrieves an inquiry by its ID
+func (r *PostgresInquiryRepository) GetByID(ctx context.Context, id string) (*entities.Inquiry, error) {
+	var inquiry entities.Inquiry
+	if err := r.db.WithContext(ctx).First(&inquiry, "id = ?", id).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, errors.ErrInquiryNotFound
+		}
+		return nil, fmt.Errorf("failed to get inquiry: %w", err)
+	}
+	return &inquiry, nil
+}
+
+// List retrieves inquiries, newest first, optionally filtered by status
+func (r *PostgresInquiryRepository) List(ctx context.Context, filter repositories.InquiryFilter) ([]*entities.Inquiry, int64, error) {
+	var inquiries []*entities.Inquiry
+	var total int64
+
+	query := r.db.WithContext(ctx).Model(&entities.Inquiry{})
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to count inquiries: %w", err)
+	}
+
+	offset := (filter.Page - 1) * filter.PageSize
+	if err := query.Offset(offset).Limit(filter.PageSize).Order("created_at DESC").Find(&inquiries).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to get inquiries: %w", err)
+	}
+
+	return inquiries, total, nil
+}
+
+// CountByIPSince counts the inquiries sent from an IP address since the given time
+func (r *PostgresInquiryRepository) CountByIPSince(ctx context.Context, ipAddress string, since time.Time) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&entities.Inquiry{}).
+		Where("ip_address = ? AND created_at >= ?", ipAddress, since).
+		Count(&count).Error
+	if err != nil {
+		return 0, fmt.Errorf("failed to count inquiries: %w", err)
+	}
+	return count, nil
+}
+
+// UpdateStatus sets the status of an inquiry and who changed it
+func (r *PostgresInquiryRepository) UpdateStatus(ctx context.Context, id string, status entities.InquiryStatus, handledBy string) error {
+	result := r.db.WithContext(ctx).Model(&entities.Inquiry{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"status":     status,
+			"handled_by": handledBy,
+			"updated_at": time.Now(),
+		})
+	if result.Error != nil {
+		return fmt.Errorf("failed to update inquiry status: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return errors.ErrInquiryNotFound
+	}
+	return nil
+}
@@ -0,0 +1,122 @@
+package repositories
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"super2025-backend/internal/domain/entities"
+	"super2025-backend/internal/domain/errors"
+
+	"gorm.io/gorm"
+)
+
+// PostgresInterviewRepository implements the InterviewRepository interface
+type PostgresInterviewRepository struct {
+	db *gorm.DB
+}
+
+// NewPostgresInterviewRepository creates a new PostgreSQL interview repository
+func NewPostgresInterviewRepository(db *gorm.DB) *PostgresInterviewRepository {
+	return &PostgresInterviewRepository{
+		db: db,
+	}
+}
+
+// Create creates a new interview in the database, unless an interviewer is busy at its time
+func (r *PostgresInterviewRepository) Create(ctx context.Context, interview *entities.Interview) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		busy, err := interviewersBusy(tx, interview)
+		if err != nil {
+			return err
+		}
+		if busy {
+			return errors.ErrInterviewerUnavailable
+		}
+		return tx.Create(interview).Error
+	})
+	if err == errors.ErrInterviewerUnavailable {
+		return err
+	}
+	if err != nil {
+		return fmt.Errorf("failed to create interview: %w", err)
+	}
+	return nil
+}
+
+// GetByID retrieves an interview by ID
+func (r *PostgresInterviewRepository) GetByID(ctx context.Context, id string) (*entities.Interview, error) {
+	var interview entities.Interview
+	if err := r.db.WithContext(ctx).First(&interview, "id = ?", id).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, errors.ErrInterviewNotFound
+		}
+		return nil, fmt.Errorf("failed to get interview: %w", err)
+	}
+	return &interview, nil
+}
+
+// ListByApplication retrieves the interviews of an application, earliest first
+func (r *PostgresInterviewRepository) ListByApplication(ctx context.Context, applicationID string) ([]*entities.Interview, error) {
+	var interviews []*entities.Interview
+	if err := r.db.WithContext(ctx).Where("application_id = ?", applicationID).Order("start_at ASC").Find(&interviews).Error; err != nil {
+		return nil, fmt.Errorf("failed to get interviews: %w", err)
+	}
+	return interviews, nil
+}
+
+// Update updates an interview. A scheduled interview is only saved if none of its
+// interviewers has another interview at its time.
+func (r *PostgresInterviewRepository) Update(ctx context.Context, interview *entities.Interview) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		busy, err := interviewersBusy(tx, interview)
+		if err != nil {
+			return err
+		}
+		if busy {
+			return errors.ErrInterviewerUnavailable
+		}
+		return tx.Save(interview).Error
+	})
+	if err == errors.ErrInterviewerUnavailable {
+		return err
+	}
+	if err != nil {
+		return fmt.Errorf("failed to update interview: %w", err)
+	}
+	return nil
+}
+
+// interviewersBusy reports whether an interviewer of a scheduled interview has another
+// scheduled interview overlapping it. It takes a per-interviewer advisory lock, held
+// until the transaction ends, so concurrent bookings for an interviewer are checked
+// one at a time. Locks are taken in a fixed order so they cannot deadlock.
+func interviewersBusy(tx *gorm.DB, interview *entities.Interview) (bool, error) {
+	if interview.Status != entities.InterviewScheduled {
+		return false, nil
+	}
+	interviewers := append([]string(nil), interview.Interviewers...)
+	sort.Strings(interviewers)
+	for _, interviewer := range interviewers {
+		if err := tx.Exec("SELECT pg_advisory_xact_lock(hashtext(?))", "interviewer:"+interviewer).Error; err != nil {
+			return false, err
+		}
+		contains, err := json.Marshal([]string{interviewer})
+		if err != nil {
+			return false, err
+		}
+		var overlapping int64
+		err = tx.Model(&entities.Interview{}).
+			Where("id <> ? AND status = ? AND start_at < ? AND end_at > ? AND interviewers @> ?::jsonb",
+				interview.ID, entities.InterviewScheduled, interview.EndAt, interview.StartAt, string(contains)).
+			Count(&overlapping).Error
+		if err != nil {
+			return false, err
+		}
+		if overlapping > 0 {
+			return true, nil
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,123 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"super2025-backend/internal/domain/entities"
+	"super2025-backend/internal/domain/errors"
+	"super2025-backend/internal/domain/repositories"
+
+	"gorm.io/gorm"
+)
+
+// PostgresOfferRepository implements the OfferRepository interface
+type PostgresOfferRepository struct {
+	db *gorm.DB
+}
+
+// NewPostgresOfferRepository creates a new PostgreSQL offer repository
+func NewPostgresOfferRepository(db *gorm.DB) *PostgresOfferRepository {
+	return &PostgresOfferRepository{
+		db: db,
+	}
+}
+
+// Create creates a new offer in the database together with the application's status move, if any
+func (r *PostgresOfferRepository) Create(ctx context.Context, offer *entities.Offer, move *repositories.StatusMove) (bool, error) {
+	created := false
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if move != nil {
+			saved, err := saveStatusMove(tx, move)
+			if err != nil || !saved {
+				return err
+			}
+		}
+		if err := tx.Create(offer).Error; err != nil {
+			return err
+		}
+		created = true
+		return nil
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to create offer: %w", err)
+	}
+	return created, nil
+}
+
+// GetByID retrieves an offer by ID
+func (r *PostgresOfferRepository) GetByID(ctx context.Context, id string) (*entities.Offer, error) {
+	var offer entities.Offer
+	if err := r.db.WithContext(ctx).First(&offer, "id = ?", id).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, errors.ErrOfferNotFound
+		}
+		return nil, fmt.Errorf("failed to get offer: %w", err)
+	}
+	return &offer, nil
+}
+
+// ListByApplication retrieves the offers of an application, newest first
+func (r *PostgresOfferRepository) ListByApplication(ctx context.Context, applicationID string) ([]*entities.Offer, error) {
+	var offers []*entities.Offer
+	if err := r.db.WithContext(ctx).Where("application_id = ?", applicationID).Order("created_at DESC").Find(&offers).Error; err != nil {
+		return nil, fmt.Errorf("failed to get offers: %w", err)
+	}
+	return offers, nil
+}
+
+// ListExpired retrieves pending offers whose deadline has passed at now
+func (r *PostgresOfferRepository) ListExpired(ctx context.Context, now time.Time) ([]*entities.Offer, error) {
+	var offers []*entities.Offer
+	err := r.db.WithContext(ctx).
+		Where("status = ? AND expires_at <= ?", entities.OfferPending, now).
+		Order("expires_at ASC").
+		Find(&offers).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get expired offers: %w", err)
+	}
+	return offers, nil
+}
+
+// Resolve saves a resolved offer only if it is still pending in the database, together
+// with the application's status move, if any. If the move cannot be saved the offer
+// is left pending.
+func (r *PostgresOfferRepository) Resolve(ctx context.Context, offer *entities.Offer, move *repositories.StatusMove) (bool, error) {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&entities.Offer{}).
+			Where("id = ? AND status = ?", offer.ID, entities.OfferPending).
+			Updates(map[string]interface{}{
+				"status":         offer.Status,
+				"responded_at":   offer.RespondedAt,
+				"decline_reason": offer.DeclineReason,
+				"rescinded_by":   offer.RescindedBy,
+				"updated_at":     offer.UpdatedAt,
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return errors.ErrOfferNotPending
+		}
+		if move == nil {
+			return nil
+		}
+		saved, err := saveStatusMove(tx, move)
+		if err != nil {
+			return err
+		}
+		if !saved {
+			// Roll the offer update back with the transaction
+			return errors.ErrOfferNotPending
+		}
+		return nil
+	})
+	if err == errors.ErrOfferNotPending {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to resolve offer: %w", err)
+	}
+	return true, nil
+}
@@ -0,0 +1,88 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"super2025-backend/internal/domain/entities"
+	"super2025-backend/internal/domain/errors"
+
+	"gorm.io/gorm"
+)
+
+// PostgresPortalRepository implements the PortalRepository interface
+type PostgresPortalRepository struct {
+	db *gorm.DB
+}
+
+// NewPostgresPortalRepository creates a new PostgreSQL portal repository
+func NewPostgresPortalRepository(db *gorm.DB) *PostgresPortalRepository {
+	return &PostgresPortalRepository{
+		db: db,
+	}
+}
+
+// Create stores a new, unredeemed login
+func (r *PostgresPortalRepository) Create(ctx context.Context, session *entities.PortalSession) error {
+	if err := r.db.WithContext(ctx).Create(session).Error; err != nil {
+		return fmt.Errorf("failed to create portal session: %w", err)
+	}
+	return nil
+}
+
+// CountLoginsSince counts the logins requested for a candidate since the given time
+func (r *PostgresPortalRepository) CountLoginsSince(ctx context.Context, candidateID string, since time.Time) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&entities.PortalSession{}).
+		Where("candidate_id = ? AND created_at >= ?", candidateID, since).
+		Count(&count).Error
+	if err != nil {
+		return 0, fmt.Errorf("failed to count portal logins: %w", err)
+	}
+	return count, nil
+}
+
+// Redeem atomically turns an unused, unexpired login into a session. The
+// conditional update makes a login link work exactly once.
+func (r *PostgresPortalRepository) Redeem(ctx context.Context, loginTokenHash, sessionTokenHash string, expiresAt time.Time) (*entities.PortalSession, error) {
+	var session entities.PortalSession
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		now := time.Now()
+		result := tx.Model(&entities.PortalSession{}).
+			Where("login_token_hash = ? AND redeemed_at IS NULL AND login_expires_at > ?", loginTokenHash, now).
+			Updates(map[string]interface{}{
+				"session_token_hash": sessionTokenHash,
+				"redeemed_at":        now,
+				"expires_at":         expiresAt,
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return errors.ErrPortalLoginInvalid
+		}
+		return tx.First(&session, "login_token_hash = ?", loginTokenHash).Error
+	})
+	if err != nil {
+		if err == errors.ErrPortalLoginInvalid {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to redeem portal login: %w", err)
+	}
+	return &session, nil
+}
+
+// GetActiveSession retrieves an unexpired session by token hash
+func (r *PostgresPortalRepository) GetActiveSession(ctx context.Context, sessionTokenHash string) (*entities.PortalSession, error) {
+	var session entities.PortalSession
+	err := r.db.WithContext(ctx).
+		First(&session, "session_token_hash = ? AND expires_at > ?", sessionTokenHash, time.Now()).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, errors.ErrPortalSessionInvalid
+		}
+		return nil, fmt.Errorf("failed to get portal session: %w", err)
+	}
+	return &session, nil
+}
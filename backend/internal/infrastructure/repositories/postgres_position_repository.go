@@ -0,0 +1,233 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"super2025-backend/internal/domain/entities"
+	"super2025-backend/internal/domain/errors"
+	"super2025-backend/internal/domain/repositories"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// PostgresPositionRepository implements the PositionRepository interface
+type PostgresPositionRepository struct {
+	db *gorm.DB
+}
+
+// NewPostgresPositionRepository creates a new PostgreSQL position repository
+func NewPostgresPositionRepository(db *gorm.DB) *PostgresPositionRepository {
+	return &PostgresPositionRepository{
+		db: db,
+	}
+}
+
+// Create creates a new position in the database
+func (r *PostgresPositionRepository) Create(ctx context.Context, position *entities.Position) error {
+	if err := r.db.WithContext(ctx).Create(position).Error; err != nil {
+		return fmt.Errorf("failed to create position: %w", err)
+	}
+	return nil
+}
+
+// GetByID retrieves a position by ID
+func (r *PostgresPositionRepository) GetByID(ctx context.Context, id string) (*entities.Position, error) {
+	var position entities.Position
+	if err := r.db.WithContext(ctx).First(&position, "id = ?", id).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, errors.ErrPositionNotFound
+		}
+		return nil, fmt.Errorf("failed to get position: %w", err)
+	}
+	return &position, nil
+}
+
+// List retrieves positions with filters and pagination
+func (r *PostgresPositionRepository) List(ctx context.Context, filter repositories.PositionFilter) ([]*entities.Position, int64, error) {
+	var positions []*entities.Position
+	var total int64
+
+	query := r.db.WithContext(ctx).Model(&entities.Position{})
+
+	// Apply filters
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+	if filter.Department != "" {
+		query = query.Where("department = ?", filter.Department)
+	}
+	if filter.EmploymentType != "" {
+		query = query.Where("employment_type = ?", filter.EmploymentType)
+	}
+
+	// Count total records
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to count positions: %w", err)
+	}
+
+	// Apply pagination and ordering
+	offset := (filter.Page - 1) * filter.PageSize
+	if err := query.Offset(offset).Limit(filter.PageSize).Order("created_at DESC").Find(&positions).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to get positions: %w", err)
+	}
+
+	return positions, total, nil
+}
+
+// Update updates a position
+func (r *PostgresPositionRepository) Update(ctx context.Context, position *entities.Position) error {
+	position.UpdatedAt = time.Now()
+	if err := r.db.WithContext(ctx).Save(position).Error; err != nil {
+		return fmt.Errorf("failed to update position: %w", err)
+	}
+	return nil
+}
+
+// Delete deletes a position
+func (r *PostgresPositionRepository) Delete(ctx context.Context, id string) error {
+	result := r.db.WithContext(ctx).Delete(&entities.Position{}, "id = ?", id)
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete position: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return errors.ErrPositionNotFound
+	}
+
+	return nil
+}
+
+// ListDueForSchedule retrieves draft positions due to open and open positions due to close
+func (r *PostgresPositionRepository) ListDueForSchedule(ctx context.Context, now time.Time) ([]*entities.Position, error) {
+	var positions []*entities.Position
+	err := r.db.WithContext(ctx).
+		Where("(status = ? AND open_at IS NOT NULL AND open_at <= ?) OR (status = ? AND close_at IS NOT NULL AND close_at <= ?)",
+			entities.PositionStatusDraft, now, entities.PositionStatusOpen, now).
+		Find(&positions).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get scheduled positions: %w", err)
+	}
+	return positions, nil
+}
+
+// Close closes a position unless it is already closed, moves its applications and
+// records the closure in one transaction. The conditional updates keep concurrent
+// closes (manual and scheduled) and status changes from both proceeding.
+func (r *PostgresPositionRepository) Close(ctx context.Context, closure *entities.PositionClosure, moves []repositories.StatusMove) ([]repositories.StatusMove, bool, error) {
+	var moved []repositories.StatusMove
+	wasOpen := false
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&entities.Position{}).
+			Where("id = ? AND status <> ?", closure.PositionID, entities.PositionStatusClosed).
+			Updates(map[string]interface{}{
+				"status":     entities.PositionStatusClosed,
+				"closed_at":  closure.CreatedAt,
+				"updated_at": closure.CreatedAt,
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+		wasOpen = true
+
+		moved = make([]repositories.StatusMove, 0, len(moves))
+		for _, move := range moves {
+			saved, err := saveStatusMove(tx, &move)
+			if err != nil {
+				return err
+			}
+			if saved {
+				moved = append(moved, move)
+			}
+		}
+
+		closure.ApplicationsMoved = len(moved)
+		return tx.Create(closure).Error
+	})
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to close position: %w", err)
+	}
+	return moved, wasOpen, nil
+}
+
+// ListClosures retrieves the closures recorded for a position, newest first
+func (r *PostgresPositionRepository) ListClosures(ctx context.Context, positionID string) ([]*entities.PositionClosure, error) {
+	var closures []*entities.PositionClosure
+	if err := r.db.WithContext(ctx).Where("position_id = ?", positionID).Order("created_at DESC").Find(&closures).Error; err != nil {
+		return nil, fmt.Errorf("failed to get position closures: %w", err)
+	}
+	return closures, nil
+}
+
+// ListQuestions retrieves the custom application questions of a position in display order
+func (r *PostgresPositionRepository) ListQuestions(ctx context.Context, positionID string) ([]*entities.PositionQuestion, error) {
+	var questions []*entities.PositionQuestion
+	if err := r.db.WithContext(ctx).Where("position_id = ?", positionID).Order("sort_order ASC, created_at ASC").Find(&questions).Error; err != nil {
+		return nil, fmt.Errorf("failed to get position questions: %w", err)
+	}
+	return questions, nil
+}
+
+// ReplaceQuestions saves the question set of a position in one transaction, deactivating
+// the active screening rules that no longer fit the questions
+func (r *PostgresPositionRepository) ReplaceQuestions(ctx context.Context, positionID string, questions []*entities.PositionQuestion) (int, error) {
+	deactivated := 0
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		keep := make([]string, 0, len(questions))
+		for _, question := range questions {
+			if question.ID != "" {
+				keep = append(keep, question.ID)
+			}
+		}
+
+		remove := tx.Where("position_id = ?", positionID)
+		if len(keep) > 0 {
+			remove = remove.Where("id NOT IN ?", keep)
+		}
+		if err := remove.Delete(&entities.PositionQuestion{}).Error; err != nil {
+			return err
+		}
+
+		for _, question := range questions {
+			question.PositionID = positionID
+			if err := tx.Save(question).Error; err != nil {
+				return err
+			}
+		}
+
+		// Rules on a removed question, or on a type or option the question no longer has
+		var rules []*entities.ScreeningRule
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("position_id = ? AND active = ?", positionID, true).
+			Find(&rules).Error; err != nil {
+			return err
+		}
+		var stale []string
+		for _, rule := range rules {
+			if !rule.FitsQuestions(questions) {
+				stale = append(stale, rule.ID)
+			}
+		}
+		if len(stale) == 0 {
+			return nil
+		}
+		result := tx.Model(&entities.ScreeningRule{}).
+			Where("id IN ?", stale).
+			Updates(map[string]interface{}{"active": false, "updated_at": time.Now()})
+		if result.Error != nil {
+			return result.Error
+		}
+		deactivated = int(result.RowsAffected)
+		return nil
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to save position questions: %w", err)
+	}
+	return deactivated, nil
+}
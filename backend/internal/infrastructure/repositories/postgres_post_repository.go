@@ -0,0 +1,136 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+
+	"super2025-backend/internal/domain/entities"
+	"super2025-backend/internal/domain/errors"
+	"super2025-backend/internal/domain/repositories"
+
+	"gorm.io/gorm"
+)
+
+// PostgresPostRepository implements the PostRepository interface
+type PostgresPostRepository struct {
+	db *gorm.DB
+}
+
+// NewPostgresPostRepository creates a new PostgreSQL post repository
+func NewPostgresPostRepository(db *gorm.DB) *PostgresPostRepository {
+	return &PostgresPostRepository{
+		db: db,
+	}
+}
+
+// Create creates a new post
+func (r *PostgresPostRepository) Create(ctx context.Context, post *entities.Post) error {
+	if err := r.db.WithContext(ctx).Create(post).Error; err != nil {
+		return fmt.Errorf("failed to create post: %w", err)
+	}
+	return nil
+}
+
+// GetByID retrieves a post by its ID
+func (r *PostgresPostRepository) GetByID(ctx context.Context, id string) (*entities.Post, error) {
+	return r.getBy(ctx, "id = ?", id)
+}
+
+// GetBySlug retrieves a post by its slug
+func (r *PostgresPostRepository) GetBySlug(ctx context.Context, slug string) (*entities.Post, error) {
+	return r.getBy(ctx, "slug = ?", slug)
+}
+
+func (r *PostgresPostRepository) getBy(ctx context.Context, query string, value string) (*entities.Post, error) {
+	var post entities.Post
+	if err := r.db.WithContext(ctx).First(&post, query, value).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, errors.ErrPostNotFound
+		}
+		return nil, fmt.Errorf("failed to get post: %w", err)
+	}
+	return &post, nil
+}
+
+// SlugExists reports whether a post other than excludeID uses the slug
+func (r *PostgresPostRepository) SlugExists(ctx context.Context, slug, excludeID string) (bool, error) {
+	var count int64
+	query := r.db.WithContext(ctx).Model(&entities.Post{}).Where("slug = ?", slug)
+	if excludeID != "" {
+		query = query.Where("id <> ?", excludeID)
+	}
+	if err := query.Count(&count).Error; err != nil {
+		return false, fmt.Errorf("failed to check post slug: %w", err)
+	}
+	return count > 0, nil
+}
+
+// List retrieves posts with filters and pagination, newest first
+func (r *PostgresPostRepository) List(ctx context.Context, filter repositories.PostFilter) ([]*entities.Post, int64, error) {
+	var posts []*entities.Post
+	var total int64
+
+	query := r.filtered(ctx, filter)
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to count posts: %w", err)
+	}
+
+	offset := (filter.Page - 1) * filter.PageSize
+	err := query.Offset(offset).Limit(filter.PageSize).
+		Order("published_at DESC NULLS FIRST, created_at DESC").
+		Find(&posts).Error
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get posts: %w", err)
+	}
+
+	return posts, total, nil
+}
+
+// ListCategories retrieves the categories of the posts matching the filter with their post counts
+func (r *PostgresPostRepository) ListCategories(ctx context.Context, filter repositories.PostFilter) ([]*entities.PostCategory, error) {
+	var categories []*entities.PostCategory
+	err := r.filtered(ctx, filter).
+		Select("MIN(category) AS name, category_slug AS slug, COUNT(*) AS count").
+		Where("category_slug <> ''").
+		Group("category_slug").
+		Order("count DESC, slug ASC").
+		Scan(&categories).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get post categories: %w", err)
+	}
+	return categories, nil
+}
+
+func (r *PostgresPostRepository) filtered(ctx context.Context, filter repositories.PostFilter) *gorm.DB {
+	query := r.db.WithContext(ctx).Model(&entities.Post{})
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+	if filter.CategorySlug != "" {
+		query = query.Where("category_slug = ?", filter.CategorySlug)
+	}
+	if filter.LiveAt != nil {
+		query = query.Where("status = ? AND published_at <= ?", entities.PostStatusPublished, *filter.LiveAt)
+	}
+	return query
+}
+
+// Update updates an existing post
+func (r *PostgresPostRepository) Update(ctx context.Context, post *entities.Post) error {
+	if err := r.db.WithContext(ctx).Save(post).Error; err != nil {
+		return fmt.Errorf("failed to update post: %w", err)
+	}
+	return nil
+}
+
+// Delete deletes a post by ID
+func (r *PostgresPostRepository) Delete(ctx context.Context, id string) error {
+	result := r.db.WithContext(ctx).Delete(&entities.Post{}, "id = ?", id)
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete post: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return errors.ErrPostNotFound
+	}
+	return nil
+}
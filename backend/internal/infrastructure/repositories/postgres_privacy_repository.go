@@ -0,0 +1,307 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"super2025-backend/internal/domain/entities"
+
+	"gorm.io/gorm"
+)
+
+// PostgresPrivacyRepository implements the PrivacyRepository interface
+type PostgresPrivacyRepository struct {
+	db *gorm.DB
+}
+
+// NewPostgresPrivacyRepository creates a new PostgreSQL privacy repository
+func NewPostgresPrivacyRepository(db *gorm.DB) *PostgresPrivacyRepository {
+	return &PostgresPrivacyRepository{
+		db: db,
+	}
+}
+
+// Erase anonymizes the applications in place, keeping position, status, source, tags,
+// referral, UTM values and dates for reporting. Free-text fields that may describe the
+// candidate (comments, answers, notes, feedback, offer terms) are deleted or cleared,
+// and the candidate record goes with their portal sessions. Soft-deleted rows are
+// included. An advisory lock serializes erasures so receipts are chained in order.
+func (r *PostgresPrivacyRepository) Erase(ctx context.Context, email string, candidateID *string, applicationIDs []string, receipt *entities.ErasureReceipt) error {
+	email = entities.NormalizeEmail(email)
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec("SELECT pg_advisory_xact_lock(hashtext(?))", "erasure_receipts").Error; err != nil {
+			return err
+		}
+
+		if len(applicationIDs) > 0 {
+			counts, err := anonymizeApplications(tx, applicationIDs, receipt.ErasedAt)
+			if err != nil {
+				return err
+			}
+			receipt.ApplicationsAnonymized = counts.applications
+			receipt.AnswersDeleted = counts.answers
+			receipt.CommentsDeleted = counts.comments
+		}
+
+		if candidateID != nil {
+			if err := tx.Where("candidate_id = ?", *candidateID).Delete(&entities.PortalSession{}).Error; err != nil {
+				return err
+			}
+			// Applications sent under another address may still point at the candidate
+			if err := tx.Model(&entities.Application{}).Unscoped().
+				Where("candidate_id = ?", *candidateID).
+				Update("candidate_id", nil).Error; err != nil {
+				return err
+			}
+			result := tx.Unscoped().Where("id = ?", *candidateID).Delete(&entities.Candidate{})
+			if result.Error != nil {
+				return result.Error
+			}
+			receipt.CandidateDeleted = result.RowsAffected > 0
+		}
+
+		result := tx.Where("recipient = ?", email).Delete(&entities.EmailLog{})
+		if result.Error != nil {
+			return result.Error
+		}
+		receipt.EmailLogsDeleted = int(result.RowsAffected)
+
+		var previous entities.ErasureReceipt
+		err := tx.Order("sequence DESC").First(&previous).Error
+		switch {
+		case err == gorm.ErrRecordNotFound:
+			receipt.Seal(nil)
+		case err != nil:
+			return err
+		default:
+			receipt.Seal(&previous)
+		}
+		return tx.Create(receipt).Error
+	})
+	if err != nil {
+		return fmt.Errorf("failed to erase personal data: %w", err)
+	}
+	return nil
+}
+
+// erasureCounts records how many rows anonymizeApplications changed
+type erasureCounts struct {
+	applications int
+	answers      int
+	comments     int
+}
+
+// anonymizeApplications clears the personal data held on and about the applications
+func anonymizeApplications(tx *gorm.DB, applicationIDs []string, erasedAt time.Time) (erasureCounts, error) {
+	var counts erasureCounts
+
+	result := tx.Model(&entities.Application{}).Unscoped().
+		Where("id IN ?", applicationIDs).
+		Updates(map[string]interface{}{
+			"candidate_id": nil,
+			"name":         entities.ErasedName,
+			"email":        gorm.Expr("'erased-' || id || ?", "@"+entities.ErasedEmailDomain),
+			"phone":        nil,
+			"cover_letter": "",
+			"resume_url":   "",
+			"ip_address":   nil,
+			"user_agent":   nil,
+			"notes":        nil,
+			"erased_at":    erasedAt,
+		})
+	if result.Error != nil {
+		return counts, result.Error
+	}
+	counts.applications = int(result.RowsAffected)
+
+	result = tx.Where("application_id IN ?", applicationIDs).Delete(&entities.ApplicationAnswer{})
+	if result.Error != nil {
+		return counts, result.Error
+	}
+	counts.answers = int(result.RowsAffected)
+
+	result = tx.Unscoped().Where("application_id IN ?", applicationIDs).Delete(&entities.ApplicationComment{})
+	if result.Error != nil {
+		return counts, result.Error
+	}
+	counts.comments = int(result.RowsAffected)
+
+	// UTM values describe the campaign, not the candidate, and are kept
+	cleared := []struct {
+		model   interface{}
+		columns map[string]interface{}
+	}{
+		{&entities.ApplicationAttribution{}, map[string]interface{}{"referrer": "", "landing_page": ""}},
+		{&entities.ApplicationStatusEvent{}, map[string]interface{}{"notes": ""}},
+		{&entities.Interview{}, map[string]interface{}{"notes": "", "location": "", "video_link": ""}},
+		{&entities.SchedulingInvitation{}, map[string]interface{}{"notes": "", "location": "", "video_link": ""}},
+		{&entities.Scorecard{}, map[string]interface{}{"feedback": ""}},
+		{&entities.Offer{}, map[string]interface{}{"terms": "", "decline_reason": ""}},
+	}
+	for _, c := range cleared {
+		if err := tx.Model(c.model).Where("application_id IN ?", applicationIDs).UpdateColumns(c.columns).Error; err != nil {
+			return counts, err
+		}
+	}
+	return counts, nil
+}
+
+// ListReceipts retrieves erasure receipts in sequence order, optionally only those for a subject hash
+func (r *PostgresPrivacyRepository) ListReceipts(ctx context.Context, subjectHash string) ([]*entities.ErasureReceipt, error) {
+	var receipts []*entities.ErasureReceipt
+
+	query := r.db.WithContext(ctx)
+	if subjectHash != "" {
+		query = query.Where("subject_hash = ?", subjectHash)
+	}
+
+	if err := query.Order("sequence ASC").Find(&receipts).Error; err != nil {
+		return nil, fmt.Errorf("failed to get erasure receipts: %w", err)
+	}
+	return receipts, nil
+}
+
+// ListRetentionDue retrieves the applications a retention rule applies to as of before,
+// deleted ones included, oldest first. A limit of 0 returns them all.
+func (r *PostgresPrivacyRepository) ListRetentionDue(ctx context.Context, rule entities.RetentionRule, before time.Time, limit int) ([]*entities.Application, error) {
+	var applications []*entities.Application
+
+	query := r.db.WithContext(ctx).Unscoped().Where("erased_at IS NULL")
+	if rule.Status != "" {
+		query = query.Where("status = ? AND COALESCE(processed_at, updated_at) < ?", rule.Status, before).
+			Order("COALESCE(processed_at, updated_at) ASC")
+	} else {
+		query = query.Where("created_at < ?", before).Order("created_at ASC")
+	}
+	if rule.Action == entities.RetentionClearMetadata {
+		query = query.Where("(COALESCE(ip_address::text, '') <> '' OR COALESCE(user_agent, '') <> '')")
+	}
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+
+	if err := query.Find(&applications).Error; err != nil {
+		return nil, fmt.Errorf("failed to get applications due for retention: %w", err)
+	}
+	return applications, nil
+}
+
+// AnonymizeApplications erases the personal data of applications as Erase does, and
+// deletes candidates and email log entries no other application refers to
+func (r *PostgresPrivacyRepository) AnonymizeApplications(ctx context.Context, applicationIDs []string, erasedAt time.Time) (int, error) {
+	var anonymized int
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		candidateIDs, emails, err := applicationSubjects(tx, applicationIDs)
+		if err != nil {
+			return err
+		}
+		counts, err := anonymizeApplications(tx, applicationIDs, erasedAt)
+		if err != nil {
+			return err
+		}
+		anonymized = counts.applications
+		return deleteOrphanedSubjects(tx, candidateIDs, emails)
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to anonymize applications: %w", err)
+	}
+	return anonymized, nil
+}
+
+// PurgeApplications permanently deletes applications and everything recorded about them.
+// Child rows are deleted explicitly rather than relying on foreign key cascades, which
+// tables created by AutoMigrate do not have.
+func (r *PostgresPrivacyRepository) PurgeApplications(ctx context.Context, applicationIDs []string) (int, error) {
+	var purged int
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		candidateIDs, emails, err := applicationSubjects(tx, applicationIDs)
+		if err != nil {
+			return err
+		}
+
+		if err := tx.Exec("DELETE FROM interview_slots WHERE invitation_id IN (SELECT id FROM scheduling_invitations WHERE application_id IN ?)", applicationIDs).Error; err != nil {
+			return err
+		}
+		if err := tx.Exec("DELETE FROM application_tags WHERE application_id IN ?", applicationIDs).Error; err != nil {
+			return err
+		}
+		children := []interface{}{
+			&entities.ApplicationAnswer{},
+			&entities.ApplicationAttribution{},
+			&entities.ApplicationComment{},
+			&entities.ApplicationStatusEvent{},
+			&entities.ScreeningResult{},
+			&entities.SchedulingInvitation{},
+			&entities.Scorecard{},
+			&entities.Offer{},
+			&entities.Interview{},
+		}
+		for _, child := range children {
+			if err := tx.Unscoped().Where("application_id IN ?", applicationIDs).Delete(child).Error; err != nil {
+				return err
+			}
+		}
+
+		result := tx.Unscoped().Where("id IN ?", applicationIDs).Delete(&entities.Application{})
+		if result.Error != nil {
+			return result.Error
+		}
+		purged = int(result.RowsAffected)
+		return deleteOrphanedSubjects(tx, candidateIDs, emails)
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to purge applications: %w", err)
+	}
+	return purged, nil
+}
+
+// ClearApplicationMetadata clears the IP address and user agent of applications
+func (r *PostgresPrivacyRepository) ClearApplicationMetadata(ctx context.Context, applicationIDs []string) (int, error) {
+	result := r.db.WithContext(ctx).Model(&entities.Application{}).Unscoped().
+		Where("id IN ?", applicationIDs).
+		UpdateColumns(map[string]interface{}{"ip_address": nil, "user_agent": nil})
+	if result.Error != nil {
+		return 0, fmt.Errorf("failed to clear application metadata: %w", result.Error)
+	}
+	return int(result.RowsAffected), nil
+}
+
+// applicationSubjects returns the candidates and normalized email addresses of applications
+func applicationSubjects(tx *gorm.DB, applicationIDs []string) ([]string, []string, error) {
+	var candidateIDs, emails []string
+	if err := tx.Model(&entities.Application{}).Unscoped().
+		Where("id IN ? AND candidate_id IS NOT NULL", applicationIDs).
+		Distinct().Pluck("candidate_id", &candidateIDs).Error; err != nil {
+		return nil, nil, err
+	}
+	if err := tx.Model(&entities.Application{}).Unscoped().
+		Where("id IN ?", applicationIDs).
+		Distinct().Pluck("LOWER(email)", &emails).Error; err != nil {
+		return nil, nil, err
+	}
+	return candidateIDs, emails, nil
+}
+
+// deleteOrphanedSubjects deletes the candidates no application refers to any more, with
+// their portal sessions, and the email log entries for addresses no longer on file
+func deleteOrphanedSubjects(tx *gorm.DB, candidateIDs []string, emails []string) error {
+	if len(candidateIDs) > 0 {
+		if err := tx.Where("candidate_id IN ? AND NOT EXISTS (SELECT 1 FROM applications WHERE applications.candidate_id = portal_sessions.candidate_id)", candidateIDs).
+			Delete(&entities.PortalSession{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Unscoped().Where("id IN ? AND NOT EXISTS (SELECT 1 FROM applications WHERE applications.candidate_id = candidates.id)", candidateIDs).
+			Delete(&entities.Candidate{}).Error; err != nil {
+			return err
+		}
+	}
+	if len(emails) > 0 {
+		if err := tx.Where("recipient IN ? AND NOT EXISTS (SELECT 1 FROM applications WHERE LOWER(applications.email) = email_logs.recipient) AND NOT EXISTS (SELECT 1 FROM candidates WHERE candidates.email = email_logs.recipient)", emails).
+			Delete(&entities.EmailLog{}).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
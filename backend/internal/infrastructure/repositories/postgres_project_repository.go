@@ -0,0 +1,120 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+
+	"super2025-backend/internal/domain/entities"
+	"super2025-backend/internal/domain/errors"
+	"super2025-backend/internal/domain/repositories"
+
+	"gorm.io/gorm"
+)
+
+// PostgresProjectRepository implements the ProjectRepository interface
+type PostgresProjectRepository struct {
+	db *gorm.DB
+}
+
+// NewPostgresProjectRepository creates a new PostgreSQL project repository
+func NewPostgresProjectRepository(db *gorm.DB) *PostgresProjectRepository {
+	return &PostgresProjectRepository{
+		db: db,
+	}
+}
+
+// Create creates a new project
+func (r *PostgresProjectRepository) Create(ctx context.Context, project *entities.Project) error {
+	if err := r.db.WithContext(ctx).Create(project).Error; err != nil {
+		return fmt.Errorf("failed to create project: %w", err)
+	}
+	return nil
+}
+
+// GetByID retrieves a project by its ID
+func (r *PostgresProjectRepository) GetByID(ctx context.Context, id string) (*entities.Project, error) {
+	return r.getBy(ctx, "id = ?", id)
+}
+
+// GetBySlug retrieves a project by its slug
+func (r *PostgresProjectRepository) GetBySlug(ctx context.Context, slug string) (*entities.Project, error) {
+	return r.getBy(ctx, "slug = ?", slug)
+}
+
+func (r *PostgresProjectRepository) getBy(ctx context.Context, query string, value string) (*entities.Project, error) {
+	var project entities.Project
+	if err := r.db.WithContext(ctx).First(&project, query, value).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, errors.ErrProjectNotFound
+		}
+		return nil, fmt.Errorf("failed to get project: %w", err)
+	}
+	return &project, nil
+}
+
+// SlugExists reports whether a project other than excludeID uses the slug
+func (r *PostgresProjectRepository) SlugExists(ctx context.Context, slug, excludeID string) (bool, error) {
+	var count int64
+	query := r.db.WithContext(ctx).Model(&entities.Project{}).Where("slug = ?", slug)
+	if excludeID != "" {
+		query = query.Where("id <> ?", excludeID)
+	}
+	if err := query.Count(&count).Error; err != nil {
+		return false, fmt.Errorf("failed to check project slug: %w", err)
+	}
+	return count > 0, nil
+}
+
+// List retrieves projects in display order
+func (r *PostgresProjectRepository) List(ctx context.Context, filter repositories.ProjectFilter) ([]*entities.Project, error) {
+	var projects []*entities.Project
+
+	query := r.db.WithContext(ctx).Model(&entities.Project{})
+	if filter.Category != "" {
+		query = query.Where("LOWER(category) = LOWER(?)", filter.Category)
+	}
+	if filter.PublishedOnly {
+		query = query.Where("published = ?", true)
+	}
+
+	if err := query.Order("sort_order ASC, title ASC").Find(&projects).Error; err != nil {
+		return nil, fmt.Errorf("failed to get projects: %w", err)
+	}
+	return projects, nil
+}
+
+// Update updates an existing project
+func (r *PostgresProjectRepository) Update(ctx context.Context, project *entities.Project) error {
+	if err := r.db.WithContext(ctx).Save(project).Error; err != nil {
+		return fmt.Errorf("failed to update project: %w", err)
+	}
+	return nil
+}
+
+// Reorder sets the sort order of the given projects to their position in ids
+func (r *PostgresProjectRepository) Reorder(ctx context.Context, ids []string) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for i, id := range ids {
+			result := tx.Model(&entities.Project{}).Where("id = ?", id).Update("sort_order", i+1)
+			if result.Error != nil {
+				return fmt.Errorf("failed to reorder projects: %w", result.Error)
+			}
+			if result.RowsAffected == 0 {
+				return errors.ErrProjectNotFound
+			}
+		}
+		return nil
+	})
+}
+
+// Delete deletes a project by ID
+func (r *PostgresProjectRepository) Delete(ctx context.Context, id string) error {
+	result := r.db.WithContext(ctx).Delete(&entities.Project{}, "id = ?", id)
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete project: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return errors.ErrProjectNotFound
+	}
+	return nil
+}
@@ -0,0 +1,84 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+
+	"super2025-backend/internal/domain/entities"
+	"super2025-backend/internal/domain/errors"
+
+	"gorm.io/gorm"
+)
+
+// PostgresReferralCodeRepository implements the ReferralCodeRepository interface
+type PostgresReferralCodeRepository struct {
+	db *gorm.DB
+}
+
+// NewPostgresReferralCodeRepository creates a new PostgreSQL referral code repository
+func NewPostgresReferralCodeRepository(db *gorm.DB) *PostgresReferralCodeRepository {
+	return &PostgresReferralCodeRepository{
+		db: db,
+	}
+}
+
+// Create creates a new referral code
+func (r *PostgresReferralCodeRepository) Create(ctx context.Context, code *entities.ReferralCode) error {
+	if err := r.db.WithContext(ctx).Create(code).Error; err != nil {
+		return fmt.Errorf("failed to create referral code: %w", err)
+	}
+	return nil
+}
+
+// GetByID retrieves a referral code by its ID
+func (r *PostgresReferralCodeRepository) GetByID(ctx context.Context, id string) (*entities.ReferralCode, error) {
+	return r.getBy(ctx, "id = ?", id)
+}
+
+// GetByCode retrieves a referral code by its normalized code
+func (r *PostgresReferralCodeRepository) GetByCode(ctx context.Context, code string) (*entities.ReferralCode, error) {
+	return r.getBy(ctx, "code = ?", code)
+}
+
+func (r *PostgresReferralCodeRepository) getBy(ctx context.Context, query string, value string) (*entities.ReferralCode, error) {
+	var code entities.ReferralCode
+	if err := r.db.WithContext(ctx).First(&code, query, value).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, errors.ErrReferralCodeNotFound
+		}
+		return nil, fmt.Errorf("failed to get referral code: %w", err)
+	}
+	return &code, nil
+}
+
+// CodeExists reports whether a code has already been issued
+func (r *PostgresReferralCodeRepository) CodeExists(ctx context.Context, code string) (bool, error) {
+	var count int64
+	if err := r.db.WithContext(ctx).Model(&entities.ReferralCode{}).Where("code = ?", code).Count(&count).Error; err != nil {
+		return false, fmt.Errorf("failed to check referral code: %w", err)
+	}
+	return count > 0, nil
+}
+
+// List retrieves referral codes, optionally only active ones, ordered by employee name
+func (r *PostgresReferralCodeRepository) List(ctx context.Context, activeOnly bool) ([]*entities.ReferralCode, error) {
+	var codes []*entities.ReferralCode
+
+	query := r.db.WithContext(ctx)
+	if activeOnly {
+		query = query.Where("active = ?", true)
+	}
+
+	if err := query.Order("employee_name ASC, created_at ASC").Find(&codes).Error; err != nil {
+		return nil, fmt.Errorf("failed to get referral codes: %w", err)
+	}
+	return codes, nil
+}
+
+// Update updates an existing referral code
+func (r *PostgresReferralCodeRepository) Update(ctx context.Context, code *entities.ReferralCode) error {
+	if err := r.db.WithContext(ctx).Save(code).Error; err != nil {
+		return fmt.Errorf("failed to update referral code: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,168 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"super2025-backend/internal/domain/repositories"
+
+	"gorm.io/gorm"
+)
+
+// PostgresReportRepository implements the ReportRepository interface
+type PostgresReportRepository struct {
+	db *gorm.DB
+}
+
+// NewPostgresReportRepository creates a new PostgreSQL report repository
+func NewPostgresReportRepository(db *gorm.DB) *PostgresReportRepository {
+	return &PostgresReportRepository{
+		db: db,
+	}
+}
+
+// TimeInStage aggregates stage durations from the status event history.
+// Each event opens a stage that is closed by the next event of the same application.
+func (r *PostgresReportRepository) TimeInStage(ctx context.Context, filter repositories.ReportFilter) ([]*repositories.StageStats, error) {
+	where, args := reportWhereClause(filter)
+
+	query := fmt.Sprintf(`
+		SELECT
+			stages.to_status AS status,
+			COUNT(stages.exited_at) AS completed,
+			COUNT(*) - COUNT(stages.exited_at) AS open,
+			COALESCE(AVG(EXTRACT(EPOCH FROM (stages.exited_at - stages.entered_at))), 0) AS avg_seconds,
+			COALESCE(MIN(EXTRACT(EPOCH FROM (stages.exited_at - stages.entered_at))), 0) AS min_seconds,
+			COALESCE(MAX(EXTRACT(EPOCH FROM (stages.exited_at - stages.entered_at))), 0) AS max_seconds
+		FROM (
+			SELECT
+				e.to_status,
+				e.created_at AS entered_at,
+				LEAD(e.created_at) OVER (PARTITION BY e.application_id ORDER BY e.created_at) AS exited_at
+			FROM application_status_events e
+			JOIN applications a ON a.id = e.application_id
+			WHERE %s
+		) stages
+		GROUP BY stages.to_status
+		ORDER BY stages.to_status`, where)
+
+	var stats []*repositories.StageStats
+	if err := r.db.WithContext(ctx).Raw(query, args...).Scan(&stats).Error; err != nil {
+		return nil, fmt.Errorf("failed to aggregate time in stage: %w", err)
+	}
+	return stats, nil
+}
+
+// ReferralBonuses lists referred applications with the time they were first offered.
+// Hired counts as offered so that applications moved there by an override are not missed.
+func (r *PostgresReportRepository) ReferralBonuses(ctx context.Context, filter repositories.ReportFilter) ([]*repositories.ReferralBonus, error) {
+	conditions := []string{"a.deleted_at IS NULL"}
+	var args []interface{}
+	if filter.PositionID != "" {
+		conditions = append(conditions, "a.position_id = ?")
+		args = append(args, filter.PositionID)
+	}
+
+	having := []string{"TRUE"}
+	if filter.DateFrom != "" {
+		having = append(having, "MIN(e.created_at) >= ?")
+		args = append(args, filter.DateFrom)
+	}
+	if filter.DateTo != "" {
+		having = append(having, "MIN(e.created_at) <= ?")
+		args = append(args, filter.DateTo)
+	}
+
+	query := fmt.Sprintf(`
+		SELECT
+			rc.id AS referral_code_id,
+			rc.code,
+			rc.employee_name,
+			rc.employee_email,
+			a.id AS application_id,
+			a.name AS candidate_name,
+			a.position_id,
+			COALESCE(p.title, a.position_id) AS position_title,
+			a.status,
+			MIN(e.created_at) AS offered_at
+		FROM applications a
+		JOIN referral_codes rc ON rc.id = a.referral_code_id
+		JOIN application_status_events e ON e.application_id = a.id AND e.to_status IN ('offered', 'hired')
+		LEFT JOIN positions p ON p.id = a.position_id
+		WHERE %s
+		GROUP BY rc.id, a.id, p.title
+		HAVING %s
+		ORDER BY rc.employee_name, offered_at`, strings.Join(conditions, " AND "), strings.Join(having, " AND "))
+
+	var bonuses []*repositories.ReferralBonus
+	if err := r.db.WithContext(ctx).Raw(query, args...).Scan(&bonuses).Error; err != nil {
+		return nil, fmt.Errorf("failed to list referral bonuses: %w", err)
+	}
+	return bonuses, nil
+}
+
+// sourceGroupingColumns maps each source grouping to the column it groups by
+var sourceGroupingColumns = map[repositories.SourceGrouping]string{
+	repositories.SourceGroupingSource:   "a.source",
+	repositories.SourceGroupingMedium:   "att.utm_medium",
+	repositories.SourceGroupingCampaign: "att.utm_campaign",
+}
+
+// SourceEffectiveness counts applications per source and how many reached each stage
+// according to the status event history. Stages reached through an override count too.
+func (r *PostgresReportRepository) SourceEffectiveness(ctx context.Context, filter repositories.ReportFilter, groupBy repositories.SourceGrouping) ([]*repositories.SourceStats, error) {
+	column, ok := sourceGroupingColumns[groupBy]
+	if !ok {
+		return nil, fmt.Errorf("unsupported source grouping %q", groupBy)
+	}
+	where, args := reportWhereClause(filter)
+
+	query := fmt.Sprintf(`
+		SELECT
+			COALESCE(NULLIF(%s, ''), 'unknown') AS source,
+			COUNT(*) AS applications,
+			COUNT(*) FILTER (WHERE reached.interview) AS interviews,
+			COUNT(*) FILTER (WHERE reached.offered) AS offers,
+			COUNT(*) FILTER (WHERE reached.hired) AS hires
+		FROM applications a
+		LEFT JOIN application_attributions att ON att.application_id = a.id
+		CROSS JOIN LATERAL (
+			SELECT
+				COALESCE(BOOL_OR(e.to_status IN ('interview', 'offered', 'hired')), FALSE) AS interview,
+				COALESCE(BOOL_OR(e.to_status IN ('offered', 'hired')), FALSE) AS offered,
+				COALESCE(BOOL_OR(e.to_status = 'hired'), FALSE) AS hired
+			FROM application_status_events e
+			WHERE e.application_id = a.id
+		) reached
+		WHERE %s
+		GROUP BY 1
+		ORDER BY applications DESC, source`, column, where)
+
+	var stats []*repositories.SourceStats
+	if err := r.db.WithContext(ctx).Raw(query, args...).Scan(&stats).Error; err != nil {
+		return nil, fmt.Errorf("failed to aggregate source effectiveness: %w", err)
+	}
+	return stats, nil
+}
+
+// reportWhereClause builds the application filter shared by report queries
+func reportWhereClause(filter repositories.ReportFilter) (string, []interface{}) {
+	conditions := []string{"a.deleted_at IS NULL"}
+	var args []interface{}
+
+	if filter.PositionID != "" {
+		conditions = append(conditions, "a.position_id = ?")
+		args = append(args, filter.PositionID)
+	}
+	if filter.DateFrom != "" {
+		conditions = append(conditions, "a.created_at >= ?")
+		args = append(args, filter.DateFrom)
+	}
+	if filter.DateTo != "" {
+		conditions = append(conditions, "a.created_at <= ?")
+		args = append(args, filter.DateTo)
+	}
+
+	return strings.Join(conditions, " AND "), args
+}
@@ -0,0 +1,115 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"super2025-backend/internal/domain/entities"
+	"super2025-backend/internal/domain/errors"
+
+	"gorm.io/gorm"
+)
+
+// PostgresSchedulingRepository implements the SchedulingRepository interface
+type PostgresSchedulingRepository struct {
+	db *gorm.DB
+}
+
+// NewPostgresSchedulingRepository creates a new PostgreSQL scheduling repository
+func NewPostgresSchedulingRepository(db *gorm.DB) *PostgresSchedulingRepository {
+	return &PostgresSchedulingRepository{
+		db: db,
+	}
+}
+
+// CreateInvitation creates an invitation together with its slots
+func (r *PostgresSchedulingRepository) CreateInvitation(ctx context.Context, invitation *entities.SchedulingInvitation) error {
+	if err := r.db.WithContext(ctx).Create(invitation).Error; err != nil {
+		return fmt.Errorf("failed to create scheduling invitation: %w", err)
+	}
+	return nil
+}
+
+// GetInvitationByTokenHash retrieves an invitation and its slots by token hash
+func (r *PostgresSchedulingRepository) GetInvitationByTokenHash(ctx context.Context, tokenHash string) (*entities.SchedulingInvitation, error) {
+	var invitation entities.SchedulingInvitation
+	err := r.db.WithContext(ctx).
+		Preload("Slots", func(db *gorm.DB) *gorm.DB { return db.Order("start_at ASC") }).
+		First(&invitation, "token_hash = ?", tokenHash).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, errors.ErrSchedulingLinkNotFound
+		}
+		return nil, fmt.Errorf("failed to get scheduling invitation: %w", err)
+	}
+	return &invitation, nil
+}
+
+// ListInvitationsByApplication retrieves the invitations of an application, newest first
+func (r *PostgresSchedulingRepository) ListInvitationsByApplication(ctx context.Context, applicationID string) ([]*entities.SchedulingInvitation, error) {
+	var invitations []*entities.SchedulingInvitation
+	err := r.db.WithContext(ctx).
+		Preload("Slots", func(db *gorm.DB) *gorm.DB { return db.Order("start_at ASC") }).
+		Where("application_id = ?", applicationID).
+		Order("created_at DESC").
+		Find(&invitations).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get scheduling invitations: %w", err)
+	}
+	return invitations, nil
+}
+
+// BookSlot atomically claims a slot and creates its interview. Conditional updates
+// make the first of two concurrent bookings win, and per-interviewer advisory locks
+// serialize the overlap check against interviews booked through other invitations.
+func (r *PostgresSchedulingRepository) BookSlot(ctx context.Context, invitationID, slotID string, interview *entities.Interview) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		now := time.Now()
+
+		// Claim the invitation: a candidate books exactly one slot
+		result := tx.Model(&entities.SchedulingInvitation{}).
+			Where("id = ? AND booked_at IS NULL AND expires_at > ?", invitationID, now).
+			Updates(map[string]interface{}{"booked_at": now, "updated_at": now})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return errors.ErrSlotUnavailable
+		}
+
+		// Claim the slot
+		result = tx.Model(&entities.InterviewSlot{}).
+			Where("id = ? AND invitation_id = ? AND booked_at IS NULL", slotID, invitationID).
+			Update("booked_at", now)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return errors.ErrSlotUnavailable
+		}
+
+		// Interviewers may have been offered the same time in other invitations
+		busy, err := interviewersBusy(tx, interview)
+		if err != nil {
+			return err
+		}
+		if busy {
+			return errors.ErrSlotUnavailable
+		}
+
+		if err := tx.Create(interview).Error; err != nil {
+			return err
+		}
+		return tx.Model(&entities.SchedulingInvitation{}).
+			Where("id = ?", invitationID).
+			Update("interview_id", interview.ID).Error
+	})
+	if err == errors.ErrSlotUnavailable {
+		return err
+	}
+	if err != nil {
+		return fmt.Errorf("failed to book interview slot: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,60 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+
+	"super2025-backend/internal/domain/entities"
+	"super2025-backend/internal/domain/errors"
+
+	"gorm.io/gorm"
+)
+
+// PostgresScorecardRepository implements the ScorecardRepository interface
+type PostgresScorecardRepository struct {
+	db *gorm.DB
+}
+
+// NewPostgresScorecardRepository creates a new PostgreSQL scorecard repository
+func NewPostgresScorecardRepository(db *gorm.DB) *PostgresScorecardRepository {
+	return &PostgresScorecardRepository{
+		db: db,
+	}
+}
+
+// Create creates a new scorecard in the database
+func (r *PostgresScorecardRepository) Create(ctx context.Context, scorecard *entities.Scorecard) error {
+	if err := r.db.WithContext(ctx).Create(scorecard).Error; err != nil {
+		return fmt.Errorf("failed to create scorecard: %w", err)
+	}
+	return nil
+}
+
+// GetByID retrieves a scorecard of an application by ID
+func (r *PostgresScorecardRepository) GetByID(ctx context.Context, applicationID, id string) (*entities.Scorecard, error) {
+	var scorecard entities.Scorecard
+	if err := r.db.WithContext(ctx).First(&scorecard, "id = ? AND application_id = ?", id, applicationID).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, errors.ErrScorecardNotFound
+		}
+		return nil, fmt.Errorf("failed to get scorecard: %w", err)
+	}
+	return &scorecard, nil
+}
+
+// ListByApplication retrieves all scorecards of an application, oldest first
+func (r *PostgresScorecardRepository) ListByApplication(ctx context.Context, applicationID string) ([]*entities.Scorecard, error) {
+	var scorecards []*entities.Scorecard
+	if err := r.db.WithContext(ctx).Where("application_id = ?", applicationID).Order("created_at ASC").Find(&scorecards).Error; err != nil {
+		return nil, fmt.Errorf("failed to get scorecards: %w", err)
+	}
+	return scorecards, nil
+}
+
+// Update updates a scorecard
+func (r *PostgresScorecardRepository) Update(ctx context.Context, scorecard *entities.Scorecard) error {
+	if err := r.db.WithContext(ctx).Save(scorecard).Error; err != nil {
+		return fmt.Errorf("failed to update scorecard: %w", err)
+	}
+	return nil
+}
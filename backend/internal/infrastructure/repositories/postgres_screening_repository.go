@@ -0,0 +1,120 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"super2025-backend/internal/domain/entities"
+	"super2025-backend/internal/domain/errors"
+
+	"gorm.io/gorm"
+)
+
+// PostgresScreeningRepository implements the ScreeningRepository interface
+type PostgresScreeningRepository struct {
+	db *gorm.DB
+}
+
+// NewPostgresScreeningRepository creates a new PostgreSQL screening repository
+func NewPostgresScreeningRepository(db *gorm.DB) *PostgresScreeningRepository {
+	return &PostgresScreeningRepository{
+		db: db,
+	}
+}
+
+// CreateRule creates a new screening rule
+func (r *PostgresScreeningRepository) CreateRule(ctx context.Context, rule *entities.ScreeningRule) error {
+	if err := r.db.WithContext(ctx).Create(rule).Error; err != nil {
+		return fmt.Errorf("failed to create screening rule: %w", err)
+	}
+	return nil
+}
+
+// GetRule retrieves a screening rule by its ID
+func (r *PostgresScreeningRepository) GetRule(ctx context.Context, id string) (*entities.ScreeningRule, error) {
+	var rule entities.ScreeningRule
+	if err := r.db.WithContext(ctx).First(&rule, "id = ?", id).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, errors.ErrScreeningRuleNotFound
+		}
+		return nil, fmt.Errorf("failed to get screening rule: %w", err)
+	}
+	return &rule, nil
+}
+
+// ListRules retrieves the rules of a position in evaluation order, optionally only active ones
+func (r *PostgresScreeningRepository) ListRules(ctx context.Context, positionID string, activeOnly bool) ([]*entities.ScreeningRule, error) {
+	var rules []*entities.ScreeningRule
+
+	query := r.db.WithContext(ctx).Where("position_id = ?", positionID)
+	if activeOnly {
+		query = query.Where("active = ?", true)
+	}
+
+	if err := query.Order("sort_order ASC, created_at ASC").Find(&rules).Error; err != nil {
+		return nil, fmt.Errorf("failed to get screening rules: %w", err)
+	}
+	return rules, nil
+}
+
+// UpdateRule updates an existing screening rule
+func (r *PostgresScreeningRepository) UpdateRule(ctx context.Context, rule *entities.ScreeningRule) error {
+	if err := r.db.WithContext(ctx).Save(rule).Error; err != nil {
+		return fmt.Errorf("failed to update screening rule: %w", err)
+	}
+	return nil
+}
+
+// DeleteRule deletes a screening rule
+func (r *PostgresScreeningRepository) DeleteRule(ctx context.Context, id string) error {
+	result := r.db.WithContext(ctx).Delete(&entities.ScreeningRule{}, "id = ?", id)
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete screening rule: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return errors.ErrScreeningRuleNotFound
+	}
+	return nil
+}
+
+// CreateResults records the rules that fired for an application
+func (r *PostgresScreeningRepository) CreateResults(ctx context.Context, results []*entities.ScreeningResult) error {
+	if len(results) == 0 {
+		return nil
+	}
+	if err := r.db.WithContext(ctx).Create(&results).Error; err != nil {
+		return fmt.Errorf("failed to create screening results: %w", err)
+	}
+	return nil
+}
+
+// ListResults retrieves the screening results of an application, oldest first
+func (r *PostgresScreeningRepository) ListResults(ctx context.Context, applicationID string) ([]*entities.ScreeningResult, error) {
+	var results []*entities.ScreeningResult
+	if err := r.db.WithContext(ctx).Where("application_id = ?", applicationID).Order("created_at ASC").Find(&results).Error; err != nil {
+		return nil, fmt.Errorf("failed to get screening results: %w", err)
+	}
+	return results, nil
+}
+
+// ListDueResults retrieves scheduled status changes that are due at now
+func (r *PostgresScreeningRepository) ListDueResults(ctx context.Context, now time.Time) ([]*entities.ScreeningResult, error) {
+	var results []*entities.ScreeningResult
+	err := r.db.WithContext(ctx).
+		Where("outcome = ? AND due_at <= ?", entities.ScreeningOutcomeScheduled, now).
+		Order("due_at ASC").
+		Find(&results).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get due screening results: %w", err)
+	}
+	return results, nil
+}
+
+// UpdateResult updates a screening result
+func (r *PostgresScreeningRepository) UpdateResult(ctx context.Context, result *entities.ScreeningResult) error {
+	if err := r.db.WithContext(ctx).Save(result).Error; err != nil {
+		return fmt.Errorf("failed to update screening result: %w", err)
+	}
+	return nil
+}
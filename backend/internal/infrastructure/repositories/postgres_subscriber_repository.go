@@ -0,0 +1,88 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"super2025-backend/internal/domain/entities"
+	"super2025-backend/internal/domain/errors"
+
+	"gorm.io/gorm"
+)
+
+// PostgresSubscriberRepository implements the SubscriberRepository interface
+type PostgresSubscriberRepository struct {
+	db *gorm.DB
+}
+
+// NewPostgresSubscriberRepository creates a new PostgreSQL subscriber repository
+func NewPostgresSubscriberRepository(db *gorm.DB) *PostgresSubscriberRepository {
+	return &PostgresSubscriberRepository{
+		db: db,
+	}
+}
+
+// Create creates a new subscriber
+func (r *PostgresSubscriberRepository) Create(ctx context.Context, subscriber *entities.Subscriber) error {
+	if err := r.db.WithContext(ctx).Create(subscriber).Error; err != nil {
+		return fmt.Errorf("failed to create subscriber: %w", err)
+	}
+	return nil
+}
+
+// GetByID retrieves a subscriber by its ID
+func (r *PostgresSubscriberRepository) GetByID(ctx context.Context, id string) (*entities.Subscriber, error) {
+	return r.getBy(ctx, "id = ?", id)
+}
+
+// GetByEmail retrieves a subscriber by email address
+func (r *PostgresSubscriberRepository) GetByEmail(ctx context.Context, email string) (*entities.Subscriber, error) {
+	return r.getBy(ctx, "email = ?", email)
+}
+
+func (r *PostgresSubscriberRepository) getBy(ctx context.Context, query string, value string) (*entities.Subscriber, error) {
+	var subscriber entities.Subscriber
+	if err := r.db.WithContext(ctx).First(&subscriber, query, value).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, errors.ErrSubscriberNotFound
+		}
+		return nil, fmt.Errorf("failed to get subscriber: %w", err)
+	}
+	return &subscriber, nil
+}
+
+// ListConfirmed retrieves confirmed subscribers, optionally only those who chose topic
+func (r *PostgresSubscriberRepository) ListConfirmed(ctx context.Context, topic string) ([]*entities.Subscriber, error) {
+	var subscribers []*entities.Subscriber
+
+	query := r.db.WithContext(ctx).Where("status = ?", entities.SubscriberStatusConfirmed)
+	if topic != "" {
+		query = query.Where("topics @> ?::jsonb", fmt.Sprintf("[%q]", topic))
+	}
+
+	if err := query.Order("confirmed_at ASC").Find(&subscribers).Error; err != nil {
+		return nil, fmt.Errorf("failed to get subscribers: %w", err)
+	}
+	return subscribers, nil
+}
+
+// CountConfirmationsByIPSince counts confirmation emails requested from an IP address since the given time
+func (r *PostgresSubscriberRepository) CountConfirmationsByIPSince(ctx context.Context, ipAddress string, since time.Time) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&entities.Subscriber{}).
+		Where("ip_address = ? AND confirmation_sent_at >= ?", ipAddress, since).
+		Count(&count).Error
+	if err != nil {
+		return 0, fmt.Errorf("failed to count subscription requests: %w", err)
+	}
+	return count, nil
+}
+
+// Update updates an existing subscriber
+func (r *PostgresSubscriberRepository) Update(ctx context.Context, subscriber *entities.Subscriber) error {
+	if err := r.db.WithContext(ctx).Save(subscriber).Error; err != nil {
+		return fmt.Errorf("failed to update subscriber: %w", err)
+	}
+	return nil
+}
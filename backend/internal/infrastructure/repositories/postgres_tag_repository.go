@@ -0,0 +1,122 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+
+	"super2025-backend/internal/domain/entities"
+	"super2025-backend/internal/domain/errors"
+	"super2025-backend/internal/domain/repositories"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// PostgresTagRepository implements the TagRepository interface
+type PostgresTagRepository struct {
+	db *gorm.DB
+}
+
+// NewPostgresTagRepository creates a new PostgreSQL tag repository
+func NewPostgresTagRepository(db *gorm.DB) *PostgresTagRepository {
+	return &PostgresTagRepository{
+		db: db,
+	}
+}
+
+// FindOrCreate returns the tags with the given normalized names, creating missing ones
+func (r *PostgresTagRepository) FindOrCreate(ctx context.Context, names []string) ([]*entities.Tag, error) {
+	newTags := make([]*entities.Tag, len(names))
+	for i, name := range names {
+		newTags[i] = &entities.Tag{Name: name}
+	}
+
+	var tags []*entities.Tag
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.OnConflict{Columns: []clause.Column{{Name: "name"}}, DoNothing: true}).Create(&newTags).Error; err != nil {
+			return err
+		}
+		return tx.Where("name IN ?", names).Order("name ASC").Find(&tags).Error
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to find or create tags: %w", err)
+	}
+	return tags, nil
+}
+
+// GetByName retrieves a tag by its normalized name
+func (r *PostgresTagRepository) GetByName(ctx context.Context, name string) (*entities.Tag, error) {
+	var tag entities.Tag
+	if err := r.db.WithContext(ctx).First(&tag, "name = ?", name).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, errors.ErrTagNotFound
+		}
+		return nil, fmt.Errorf("failed to get tag: %w", err)
+	}
+	return &tag, nil
+}
+
+// List retrieves all tags with the number of non-deleted applications using each
+func (r *PostgresTagRepository) List(ctx context.Context) ([]*repositories.TagUsage, error) {
+	var usages []*repositories.TagUsage
+	err := r.db.WithContext(ctx).Raw(`
+		SELECT t.id, t.name, COUNT(a.id) AS application_count
+		FROM tags t
+		LEFT JOIN application_tags apt ON apt.tag_id = t.id
+		LEFT JOIN applications a ON a.id = apt.application_id AND a.deleted_at IS NULL
+		GROUP BY t.id, t.name
+		ORDER BY t.name ASC`).Scan(&usages).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tags: %w", err)
+	}
+	return usages, nil
+}
+
+// AddToApplication links the tags to an application; existing links are kept
+func (r *PostgresTagRepository) AddToApplication(ctx context.Context, applicationID string, tags []*entities.Tag) error {
+	if len(tags) == 0 {
+		return nil
+	}
+
+	links := make([]map[string]interface{}, len(tags))
+	for i, tag := range tags {
+		links[i] = map[string]interface{}{"application_id": applicationID, "tag_id": tag.ID}
+	}
+
+	err := r.db.WithContext(ctx).Table("application_tags").
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(links).Error
+	if err != nil {
+		return fmt.Errorf("failed to tag application: %w", err)
+	}
+	return nil
+}
+
+// RemoveFromApplication unlinks a tag from an application
+func (r *PostgresTagRepository) RemoveFromApplication(ctx context.Context, applicationID string, tag *entities.Tag) error {
+	result := r.db.WithContext(ctx).Exec(
+		"DELETE FROM application_tags WHERE application_id = ? AND tag_id = ?", applicationID, tag.ID)
+	if result.Error != nil {
+		return fmt.Errorf("failed to untag application: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return errors.ErrTagNotFound
+	}
+
+	return nil
+}
+
+// ListByApplication retrieves the tags of an application
+func (r *PostgresTagRepository) ListByApplication(ctx context.Context, applicationID string) ([]*entities.Tag, error) {
+	var tags []*entities.Tag
+	err := r.db.WithContext(ctx).
+		Joins("JOIN application_tags apt ON apt.tag_id = tags.id").
+		Where("apt.application_id = ?", applicationID).
+		Order("tags.name ASC").
+		Find(&tags).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get application tags: %w", err)
+	}
+	return tags, nil
+}
@@ -0,0 +1,120 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+
+	"super2025-backend/internal/domain/entities"
+	"super2025-backend/internal/domain/errors"
+	"super2025-backend/internal/domain/repositories"
+
+	"gorm.io/gorm"
+)
+
+// PostgresTeamMemberRepository implements the TeamMemberRepository interface
+type PostgresTeamMemberRepository struct {
+	db *gorm.DB
+}
+
+// NewPostgresTeamMemberRepository creates a new PostgreSQL team member repository
+func NewPostgresTeamMemberRepository(db *gorm.DB) *PostgresTeamMemberRepository {
+	return &PostgresTeamMemberRepository{
+		db: db,
+	}
+}
+
+// Create creates a new team member
+func (r *PostgresTeamMemberRepository) Create(ctx context.Context, member *entities.TeamMember) error {
+	if err := r.db.WithContext(ctx).Create(member).Error; err != nil {
+		return fmt.Errorf("failed to create team member: %w", err)
+	}
+	return nil
+}
+
+// GetByID retrieves a team member by its ID
+func (r *PostgresTeamMemberRepository) GetByID(ctx context.Context, id string) (*entities.TeamMember, error) {
+	return r.getBy(ctx, "id = ?", id)
+}
+
+// GetBySlug retrieves a team member by its slug
+func (r *PostgresTeamMemberRepository) GetBySlug(ctx context.Context, slug string) (*entities.TeamMember, error) {
+	return r.getBy(ctx, "slug = ?", slug)
+}
+
+func (r *PostgresTeamMemberRepository) getBy(ctx context.Context, query string, value string) (*entities.TeamMember, error) {
+	var member entities.TeamMember
+	if err := r.db.WithContext(ctx).First(&member, query, value).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, errors.ErrTeamMemberNotFound
+		}
+		return nil, fmt.Errorf("failed to get team member: %w", err)
+	}
+	return &member, nil
+}
+
+// SlugExists reports whether a team member other than excludeID uses the slug
+func (r *PostgresTeamMemberRepository) SlugExists(ctx context.Context, slug, excludeID string) (bool, error) {
+	var count int64
+	query := r.db.WithContext(ctx).Model(&entities.TeamMember{}).Where("slug = ?", slug)
+	if excludeID != "" {
+		query = query.Where("id <> ?", excludeID)
+	}
+	if err := query.Count(&count).Error; err != nil {
+		return false, fmt.Errorf("failed to check team member slug: %w", err)
+	}
+	return count > 0, nil
+}
+
+// List retrieves team members in display order
+func (r *PostgresTeamMemberRepository) List(ctx context.Context, filter repositories.TeamMemberFilter) ([]*entities.TeamMember, error) {
+	var members []*entities.TeamMember
+
+	query := r.db.WithContext(ctx).Model(&entities.TeamMember{})
+	if filter.Role != "" {
+		query = query.Where("role = ?", filter.Role)
+	}
+	if filter.PublishedOnly {
+		query = query.Where("published = ?", true)
+	}
+
+	if err := query.Order("sort_order ASC, name ASC").Find(&members).Error; err != nil {
+		return nil, fmt.Errorf("failed to get team members: %w", err)
+	}
+	return members, nil
+}
+
+// Update updates an existing team member
+func (r *PostgresTeamMemberRepository) Update(ctx context.Context, member *entities.TeamMember) error {
+	if err := r.db.WithContext(ctx).Save(member).Error; err != nil {
+		return fmt.Errorf("failed to update team member: %w", err)
+	}
+	return nil
+}
+
+// Reorder sets the sort order of the given team members to their position in ids
+func (r *PostgresTeamMemberRepository) Reorder(ctx context.Context, ids []string) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for i, id := range ids {
+			result := tx.Model(&entities.TeamMember{}).Where("id = ?", id).Update("sort_order", i+1)
+			if result.Error != nil {
+				return fmt.Errorf("failed to reorder team members: %w", result.Error)
+			}
+			if result.RowsAffected == 0 {
+				return errors.ErrTeamMemberNotFound
+			}
+		}
+		return nil
+	})
+}
+
+// Delete deletes a team member by ID
+func (r *PostgresTeamMemberRepository) Delete(ctx context.Context, id string) error {
+	result := r.db.WithContext(ctx).Delete(&entities.TeamMember{}, "id = ?", id)
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete team member: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return errors.ErrTeamMemberNotFound
+	}
+	return nil
+}
@@ -0,0 +1,38 @@
+package scheduler
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// Job is a unit of periodic background work
+type Job func(ctx context.Context) error
+
+// Every runs job once immediately and then at the given interval until ctx is cancelled.
+// Errors are logged and do not stop the schedule.
+func Every(ctx context.Context, name string, interval time.Duration, job Job, logger *zap.Logger) {
+	if interval <= 0 {
+		logger.Warn("Background job disabled", zap.String("job", name))
+		return
+	}
+
+	logger.Info("Background job started", zap.String("job", name), zap.Duration("interval", interval))
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := job(ctx); err != nil {
+			logger.Error("Background job failed", zap.String("job", name), zap.Error(err))
+		}
+
+		select {
+		case <-ctx.Done():
+			logger.Info("Background job stopped", zap.String("job", name))
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -1,6 +1,8 @@
 package handlers
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -11,8 +13,10 @@ import (
 	"super2025-backend/internal/application/dto"
 	"super2025-backend/internal/application/services"
 	"super2025-backend/internal/domain/entities"
+	domainErrors "super2025-backend/internal/domain/errors"
 	"super2025-backend/internal/infrastructure/config"
 	"super2025-backend/internal/infrastructure/file_storage"
+	"super2025-backend/internal/presentation/middleware"
 
 	"github.com/gin-gonic/gin"
 	"go.uber.org/zap"
@@ -21,6 +25,7 @@ import (
 // ApplicationHandler handles HTTP requests for applications
 type ApplicationHandler struct {
 	applicationService *services.ApplicationService
+	scorecardService   *services.ScorecardService
 	localStorage       *file_storage.LocalStorage
 	logger             *zap.Logger
 	config             *config.Config
@@ -29,12 +34,14 @@ type ApplicationHandler struct {
 // NewApplicationHandler creates a new application handler
 func NewApplicationHandler(
 	applicationService *services.ApplicationService,
+	scorecardService *services.ScorecardService,
 	localStorage *file_storage.LocalStorage,
 	logger *zap.Logger,
 	config *config.Config,
 ) *ApplicationHandler {
 	return &ApplicationHandler{
 		applicationService: applicationService,
+		scorecardService:   scorecardService,
 		localStorage:       localStorage,
 		logger:             logger,
 		config:             config,
@@ -52,11 +59,32 @@ func (h *ApplicationHandler) CreateApplication(c *gin.Context) {
 
 	// Extract form fields
 	req := dto.CreateApplicationRequest{
-		Name:        c.PostForm("fullName"),
-		Email:       c.PostForm("email"),
-		Phone:       c.PostForm("phone"),
-		PositionID:  c.PostForm("position"),
-		CoverLetter: c.PostForm("coverLetter"),
+		Name:         c.PostForm("fullName"),
+		Email:        c.PostForm("email"),
+		Phone:        c.PostForm("phone"),
+		PositionID:   c.PostForm("position"),
+		CoverLetter:  c.PostForm("coverLetter"),
+		ReferralCode: c.PostForm("referral_code"),
+		Attribution: dto.AttributionRequest{
+			UTMSource:   c.PostForm("utm_source"),
+			UTMMedium:   c.PostForm("utm_medium"),
+			UTMCampaign: c.PostForm("utm_campaign"),
+			UTMTerm:     c.PostForm("utm_term"),
+			UTMContent:  c.PostForm("utm_content"),
+			Referrer:    c.PostForm("referrer"),
+			LandingPage: c.PostForm("landing_page"),
+		},
+	}
+	if answers := c.PostForm("answers"); answers != "" {
+		if err := json.Unmarshal([]byte(answers), &req.Answers); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "answers must be a JSON object keyed by question ID"})
+			return
+		}
+	}
+	// The careers site forwards the referrer of the candidate's first visit;
+	// otherwise fall back to the page that submitted the form
+	if req.Attribution.Referrer == "" {
+		req.Attribution.Referrer = c.GetHeader("Referer")
 	}
 
 	// Validate required fields
@@ -104,14 +132,44 @@ func (h *ApplicationHandler) CreateApplication(c *gin.Context) {
 	metadata := map[string]string{
 		"ip_address": clientIP,
 		"user_agent": userAgent,
-		"source":     "web",
 	}
 
 	// Create application
 	response, err := h.applicationService.CreateApplication(c.Request.Context(), &req, fileContent, header.Filename, metadata)
 	if err != nil {
 		h.logger.Error("Failed to create application", zap.Error(err))
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create application"})
+		var answerErr *entities.AnswerValidationError
+		switch {
+		case errors.Is(err, domainErrors.ErrPositionNotFound):
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Unknown position"})
+		case errors.Is(err, domainErrors.ErrPositionClosed):
+			c.JSON(http.StatusGone, gin.H{
+				"error": "This position is closed and no longer accepting applications",
+				"code":  "position_closed",
+			})
+		case errors.Is(err, domainErrors.ErrApplicationAlreadyExists):
+			c.JSON(http.StatusConflict, gin.H{"error": "You have already applied for this position"})
+		case errors.Is(err, domainErrors.ErrInvalidFileType):
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Only PDF, DOC, and DOCX files are allowed"})
+		case errors.As(err, &answerErr):
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error":  "Some answers are missing or invalid",
+				"code":   "invalid_answers",
+				"fields": answerErr.Fields,
+			})
+		case errors.Is(err, domainErrors.ErrReferralCodeNotFound):
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": "Unknown or inactive referral code",
+				"code":  "invalid_referral_code",
+			})
+		case errors.Is(err, domainErrors.ErrSelfReferral):
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": "You cannot use your own referral code",
+				"code":  "self_referral",
+			})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create application"})
+		}
 		return
 	}
 
@@ -157,6 +215,22 @@ func (h *ApplicationHandler) GetApplications(c *gin.Context) {
 		req.Status = entities.ApplicationStatus(statusStr)
 	}
 
+	// Parse tags: accepts both ?tags=a,b and ?tags=a&tags=b
+	for _, value := range c.QueryArray("tags") {
+		for _, tag := range strings.Split(value, ",") {
+			if tag = strings.TrimSpace(tag); tag != "" {
+				req.Tags = append(req.Tags, tag)
+			}
+		}
+	}
+	switch tagMatch := c.DefaultQuery("tag_match", "any"); tagMatch {
+	case "any", "all":
+		req.TagMatch = tagMatch
+	default:
+		c.JSON(http.StatusBadRequest, gin.H{"error": "tag_match must be any or all"})
+		return
+	}
+
 	// Get applications
 	response, err := h.applicationService.ListApplications(c.Request.Context(), req)
 	if err != nil {
@@ -183,6 +257,16 @@ func (h *ApplicationHandler) GetApplication(c *gin.Context) {
 		return
 	}
 
+	// Scorecard summaries are for staff only, and hidden from interviewers
+	// who have not submitted their own scorecard yet
+	if actor := middleware.Actor(c); actor != "" && middleware.IsAdmin(c, h.config) {
+		summary, err := h.scorecardService.Summary(c.Request.Context(), idStr, actor)
+		if err != nil {
+			h.logger.Error("Failed to summarize scorecards", zap.String("id", idStr), zap.Error(err))
+		}
+		response.ScorecardSummary = summary
+	}
+
 	c.JSON(http.StatusOK, response)
 }
 
@@ -206,17 +290,63 @@ func (h *ApplicationHandler) UpdateApplicationStatus(c *gin.Context) {
 		entities.StatusReviewing,
 		entities.StatusInterview,
 		entities.StatusOffered,
+		entities.StatusHired,
 		entities.StatusRejected,
+		entities.StatusWithdrawn,
 	}
 	if !containsStatus(validStatuses, req.Status) {
 		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid status"})
 		return
 	}
 
+	// Only admins may override the status transition graph
+	if req.Override && !middleware.IsAdmin(c, h.config) {
+		c.JSON(http.StatusForbidden, gin.H{"error": "Status override requires admin access"})
+		return
+	}
+
 	response, err := h.applicationService.UpdateApplicationStatus(c.Request.Context(), idStr, &req)
 	if err != nil {
 		h.logger.Error("Failed to update application status", zap.String("id", idStr), zap.Error(err))
-		c.JSON(http.StatusNotFound, gin.H{"error": "Application not found"})
+
+		var transitionErr *entities.StatusTransitionError
+		switch {
+		case errors.As(err, &transitionErr):
+			c.JSON(http.StatusConflict, dto.StatusTransitionErrorResponse{
+				Error:           "Invalid status transition",
+				CurrentStatus:   transitionErr.From,
+				RequestedStatus: transitionErr.To,
+				AllowedStatuses: transitionErr.Allowed,
+			})
+		case errors.Is(err, domainErrors.ErrApplicationNotFound):
+			c.JSON(http.StatusNotFound, gin.H{"error": "Application not found"})
+		case errors.Is(err, domainErrors.ErrApplicationChanged):
+			c.JSON(http.StatusConflict, gin.H{"error": "Application status was changed by someone else; reload and try again"})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update application status"})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// GetApplicationTimeline handles GET /api/v1/applications/:id/timeline
+func (h *ApplicationHandler) GetApplicationTimeline(c *gin.Context) {
+	idStr := c.Param("id")
+	if idStr == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid application ID"})
+		return
+	}
+
+	response, err := h.applicationService.GetApplicationTimeline(c.Request.Context(), idStr)
+	if err != nil {
+		h.logger.Error("Failed to get application timeline", zap.String("id", idStr), zap.Error(err))
+		if errors.Is(err, domainErrors.ErrApplicationNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Application not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get application timeline"})
 		return
 	}
 
@@ -296,6 +426,51 @@ func (h *ApplicationHandler) TestEmail(c *gin.Context) {
 	})
 }
 
+// GetWithdrawal handles GET /api/v1/withdrawals/:token
+func (h *ApplicationHandler) GetWithdrawal(c *gin.Context) {
+	response, err := h.applicationService.GetWithdrawal(c.Request.Context(), c.Param("token"))
+	if err != nil {
+		h.respondWithdrawalError(c, err, "Failed to load application")
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// WithdrawApplication handles POST /api/v1/withdrawals/:token
+func (h *ApplicationHandler) WithdrawApplication(c *gin.Context) {
+	// The reason is optional, so an empty body is fine
+	var req dto.WithdrawApplicationRequest
+	if err := c.ShouldBindJSON(&req); err != nil && !errors.Is(err, io.EOF) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body"})
+		return
+	}
+
+	response, err := h.applicationService.WithdrawApplication(c.Request.Context(), c.Param("token"), &req)
+	if err != nil {
+		h.logger.Warn("Failed to withdraw application", zap.Error(err))
+		h.respondWithdrawalError(c, err, "Failed to withdraw application")
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// respondWithdrawalError maps withdraw link errors to HTTP responses
+func (h *ApplicationHandler) respondWithdrawalError(c *gin.Context, err error, fallback string) {
+	var transitionErr *entities.StatusTransitionError
+	switch {
+	case errors.Is(err, domainErrors.ErrInvalidLink):
+		c.JSON(http.StatusNotFound, gin.H{"error": "Withdraw link is invalid"})
+	case errors.Is(err, domainErrors.ErrLinkExpired):
+		c.JSON(http.StatusGone, gin.H{"error": "Withdraw link has expired"})
+	case errors.As(err, &transitionErr), errors.Is(err, domainErrors.ErrApplicationChanged):
+		c.JSON(http.StatusConflict, gin.H{"error": "This application can no longer be withdrawn"})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": fallback})
+	}
+}
+
 // Utility functions
 func contains(slice []string, item string) bool {
 	for _, s := range slice {
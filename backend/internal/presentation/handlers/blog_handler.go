@@ -0,0 +1,178 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"super2025-backend/internal/application/dto"
+	"super2025-backend/internal/application/services"
+	"super2025-backend/internal/domain/entities"
+	domainErrors "super2025-backend/internal/domain/errors"
+	"super2025-backend/internal/infrastructure/config"
+	"super2025-backend/internal/presentation/middleware"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// BlogHandler handles HTTP requests for blog posts and feeds
+type BlogHandler struct {
+	blogService *services.BlogService
+	logger      *zap.Logger
+	config      *config.Config
+}
+
+// NewBlogHandler creates a new blog handler
+func NewBlogHandler(
+	blogService *services.BlogService,
+	logger *zap.Logger,
+	config *config.Config,
+) *BlogHandler {
+	return &BlogHandler{
+		blogService: blogService,
+		logger:      logger,
+		config:      config,
+	}
+}
+
+// CreatePost handles POST /api/v1/blog/posts
+func (h *BlogHandler) CreatePost(c *gin.Context) {
+	var req dto.CreatePostRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body"})
+		return
+	}
+
+	response, err := h.blogService.CreatePost(c.Request.Context(), &req)
+	if err != nil {
+		h.logger.Error("Failed to create post", zap.Error(err))
+		h.respondError(c, err, "Failed to create post")
+		return
+	}
+
+	c.JSON(http.StatusCreated, response)
+}
+
+// GetPosts handles GET /api/v1/blog/posts
+// Public callers only see published posts; admins also see drafts and may filter by status.
+func (h *BlogHandler) GetPosts(c *gin.Context) {
+	page, err := strconv.Atoi(c.DefaultQuery("page", "1"))
+	if err != nil || page < 1 {
+		page = 1
+	}
+
+	pageSize, err := strconv.Atoi(c.DefaultQuery("page_size", "10"))
+	if err != nil || pageSize < 1 || pageSize > 100 {
+		pageSize = 10
+	}
+
+	req := &dto.ListPostsRequest{
+		Status:   entities.PostStatus(c.Query("status")),
+		Category: c.Query("category"),
+		Page:     page,
+		PageSize: pageSize,
+	}
+
+	response, err := h.blogService.ListPosts(c.Request.Context(), req, middleware.IsAdmin(c, h.config))
+	if err != nil {
+		h.logger.Error("Failed to get posts", zap.Error(err))
+		h.respondError(c, err, "Failed to get posts")
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// GetPost handles GET /api/v1/blog/posts/:slug
+func (h *BlogHandler) GetPost(c *gin.Context) {
+	slug := c.Param("slug")
+
+	response, err := h.blogService.GetPost(c.Request.Context(), slug, middleware.IsAdmin(c, h.config))
+	if err != nil {
+		h.respondError(c, err, "Failed to get post")
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// UpdatePost handles PUT /api/v1/blog/posts/:id
+func (h *BlogHandler) UpdatePost(c *gin.Context) {
+	id := c.Param("id")
+
+	var req dto.UpdatePostRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body"})
+		return
+	}
+
+	response, err := h.blogService.UpdatePost(c.Request.Context(), id, &req)
+	if err != nil {
+		h.logger.Error("Failed to update post", zap.String("id", id), zap.Error(err))
+		h.respondError(c, err, "Failed to update post")
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// DeletePost handles DELETE /api/v1/blog/posts/:id
+func (h *BlogHandler) DeletePost(c *gin.Context) {
+	id := c.Param("id")
+
+	if err := h.blogService.DeletePost(c.Request.Context(), id); err != nil {
+		h.logger.Error("Failed to delete post", zap.String("id", id), zap.Error(err))
+		h.respondError(c, err, "Failed to delete post")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Post deleted successfully"})
+}
+
+// GetCategories handles GET /api/v1/blog/categories
+func (h *BlogHandler) GetCategories(c *gin.Context) {
+	response, err := h.blogService.ListCategories(c.Request.Context(), middleware.IsAdmin(c, h.config))
+	if err != nil {
+		h.logger.Error("Failed to get post categories", zap.Error(err))
+		h.respondError(c, err, "Failed to get categories")
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// GetRSSFeed handles GET /api/v1/blog/feed.rss
+func (h *BlogHandler) GetRSSFeed(c *gin.Context) {
+	h.serveFeed(c, services.FeedRSS, "application/rss+xml; charset=utf-8")
+}
+
+// GetAtomFeed handles GET /api/v1/blog/feed.atom
+func (h *BlogHandler) GetAtomFeed(c *gin.Context) {
+	h.serveFeed(c, services.FeedAtom, "application/atom+xml; charset=utf-8")
+}
+
+func (h *BlogHandler) serveFeed(c *gin.Context, format, contentType string) {
+	data, err := h.blogService.Feed(c.Request.Context(), format)
+	if err != nil {
+		h.logger.Error("Failed to render feed", zap.String("format", format), zap.Error(err))
+		h.respondError(c, err, "Failed to render feed")
+		return
+	}
+
+	c.Data(http.StatusOK, contentType, data)
+}
+
+// respondError maps service errors to HTTP responses
+func (h *BlogHandler) respondError(c *gin.Context, err error, fallback string) {
+	switch {
+	case errors.Is(err, domainErrors.ErrPostNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": "Post not found"})
+	case errors.Is(err, domainErrors.ErrPostSlugTaken):
+		c.JSON(http.StatusConflict, gin.H{"error": "A post with this slug already exists"})
+	case errors.Is(err, domainErrors.ErrValidationFailed):
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": fallback})
+	}
+}
@@ -0,0 +1,48 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"super2025-backend/internal/application/services"
+	domainErrors "super2025-backend/internal/domain/errors"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// CandidateHandler handles HTTP requests for candidates
+type CandidateHandler struct {
+	candidateService *services.CandidateService
+	logger           *zap.Logger
+}
+
+// NewCandidateHandler creates a new candidate handler
+func NewCandidateHandler(candidateService *services.CandidateService, logger *zap.Logger) *CandidateHandler {
+	return &CandidateHandler{
+		candidateService: candidateService,
+		logger:           logger,
+	}
+}
+
+// GetCandidate handles GET /api/v1/candidates/:id
+func (h *CandidateHandler) GetCandidate(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid candidate ID"})
+		return
+	}
+
+	response, err := h.candidateService.GetCandidate(c.Request.Context(), id)
+	if err != nil {
+		h.logger.Error("Failed to get candidate", zap.String("id", id), zap.Error(err))
+		if errors.Is(err, domainErrors.ErrCandidateNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Candidate not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get candidate"})
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
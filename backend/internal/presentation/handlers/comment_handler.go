@@ -0,0 +1,136 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"super2025-backend/internal/application/dto"
+	"super2025-backend/internal/application/services"
+	domainErrors "super2025-backend/internal/domain/errors"
+	"super2025-backend/internal/presentation/middleware"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// CommentHandler handles HTTP requests for reviewer comments
+type CommentHandler struct {
+	commentService *services.CommentService
+	logger         *zap.Logger
+}
+
+// NewCommentHandler creates a new comment handler
+func NewCommentHandler(commentService *services.CommentService, logger *zap.Logger) *CommentHandler {
+	return &CommentHandler{
+		commentService: commentService,
+		logger:         logger,
+	}
+}
+
+// CreateComment handles POST /api/v1/applications/:id/comments
+func (h *CommentHandler) CreateComment(c *gin.Context) {
+	applicationID := c.Param("id")
+	author, ok := requireActor(c)
+	if !ok {
+		return
+	}
+
+	var req dto.CreateCommentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body"})
+		return
+	}
+
+	response, err := h.commentService.CreateComment(c.Request.Context(), applicationID, author, &req)
+	if err != nil {
+		h.logger.Error("Failed to create comment", zap.String("application_id", applicationID), zap.Error(err))
+		h.respondError(c, err, "Failed to create comment")
+		return
+	}
+
+	c.JSON(http.StatusCreated, response)
+}
+
+// GetComments handles GET /api/v1/applications/:id/comments
+func (h *CommentHandler) GetComments(c *gin.Context) {
+	applicationID := c.Param("id")
+
+	response, err := h.commentService.ListComments(c.Request.Context(), applicationID, middleware.Actor(c))
+	if err != nil {
+		h.logger.Error("Failed to get comments", zap.String("application_id", applicationID), zap.Error(err))
+		h.respondError(c, err, "Failed to get comments")
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// UpdateComment handles PUT /api/v1/applications/:id/comments/:commentId
+func (h *CommentHandler) UpdateComment(c *gin.Context) {
+	applicationID := c.Param("id")
+	commentID := c.Param("commentId")
+	author, ok := requireActor(c)
+	if !ok {
+		return
+	}
+
+	var req dto.UpdateCommentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body"})
+		return
+	}
+
+	response, err := h.commentService.UpdateComment(c.Request.Context(), applicationID, commentID, author, &req)
+	if err != nil {
+		h.logger.Error("Failed to update comment", zap.String("id", commentID), zap.Error(err))
+		h.respondError(c, err, "Failed to update comment")
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// DeleteComment handles DELETE /api/v1/applications/:id/comments/:commentId
+func (h *CommentHandler) DeleteComment(c *gin.Context) {
+	applicationID := c.Param("id")
+	commentID := c.Param("commentId")
+	author, ok := requireActor(c)
+	if !ok {
+		return
+	}
+
+	if err := h.commentService.DeleteComment(c.Request.Context(), applicationID, commentID, author); err != nil {
+		h.logger.Error("Failed to delete comment", zap.String("id", commentID), zap.Error(err))
+		h.respondError(c, err, "Failed to delete comment")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Comment deleted successfully"})
+}
+
+// respondError maps service errors to HTTP responses
+func (h *CommentHandler) respondError(c *gin.Context, err error, fallback string) {
+	switch {
+	case errors.Is(err, domainErrors.ErrApplicationNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": "Application not found"})
+	case errors.Is(err, domainErrors.ErrCommentNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": "Comment not found"})
+	case errors.Is(err, domainErrors.ErrForbidden):
+		c.JSON(http.StatusForbidden, gin.H{"error": "Only the author can change this comment"})
+	case errors.Is(err, domainErrors.ErrValidationFailed):
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": fallback})
+	}
+}
+
+// requireActor reads the acting staff member from the X-Actor header,
+// answering 400 if it is missing
+func requireActor(c *gin.Context) (string, bool) {
+	actor := middleware.Actor(c)
+	if actor == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "The " + middleware.ActorHeader + " header is required"})
+		return "", false
+	}
+	return actor, true
+}
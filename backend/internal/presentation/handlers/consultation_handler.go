@@ -0,0 +1,146 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"super2025-backend/internal/application/dto"
+	"super2025-backend/internal/application/services"
+	domainErrors "super2025-backend/internal/domain/errors"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// defaultConsultationListDays is how far ahead the admin consultation list looks by default
+const defaultConsultationListDays = 30
+
+// ConsultationHandler handles HTTP requests for consultation booking
+type ConsultationHandler struct {
+	consultationService *services.ConsultationService
+	logger              *zap.Logger
+}
+
+// NewConsultationHandler creates a new consultation handler
+func NewConsultationHandler(consultationService *services.ConsultationService, logger *zap.Logger) *ConsultationHandler {
+	return &ConsultationHandler{
+		consultationService: consultationService,
+		logger:              logger,
+	}
+}
+
+// GetAvailability handles GET /api/v1/consultations/availability
+func (h *ConsultationHandler) GetAvailability(c *gin.Context) {
+	response, err := h.consultationService.GetAvailability(c.Request.Context())
+	if err != nil {
+		h.respondError(c, err, "Failed to get availability")
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// UpdateAvailability handles PUT /api/v1/consultations/availability
+func (h *ConsultationHandler) UpdateAvailability(c *gin.Context) {
+	actor, ok := requireActor(c)
+	if !ok {
+		return
+	}
+
+	var req dto.UpdateAvailabilityRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body"})
+		return
+	}
+
+	response, err := h.consultationService.UpdateAvailability(c.Request.Context(), actor, &req)
+	if err != nil {
+		h.logger.Error("Failed to update availability", zap.Error(err))
+		h.respondError(c, err, "Failed to update availability")
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// GetSlots handles GET /api/v1/consultations/slots
+func (h *ConsultationHandler) GetSlots(c *gin.Context) {
+	from := c.Query("from")
+	to := c.Query("to")
+	if from == "" || to == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "from and to are required (YYYY-MM-DD)"})
+		return
+	}
+
+	response, err := h.consultationService.ListSlots(c.Request.Context(), from, to, c.Query("timezone"))
+	if err != nil {
+		h.respondError(c, err, "Failed to get available slots")
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// BookConsultation handles POST /api/v1/consultations
+func (h *ConsultationHandler) BookConsultation(c *gin.Context) {
+	var req dto.BookConsultationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body"})
+		return
+	}
+
+	response, err := h.consultationService.BookConsultation(c.Request.Context(), &req)
+	if err != nil {
+		h.logger.Warn("Failed to book consultation", zap.Time("start_at", req.StartAt), zap.Error(err))
+		h.respondError(c, err, "Failed to book consultation")
+		return
+	}
+
+	c.JSON(http.StatusCreated, response)
+}
+
+// GetConsultations handles GET /api/v1/consultations
+func (h *ConsultationHandler) GetConsultations(c *gin.Context) {
+	from := time.Now()
+	if value := c.Query("from"); value != "" {
+		parsed, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "from must be an RFC 3339 timestamp"})
+			return
+		}
+		from = parsed
+	}
+	to := from.AddDate(0, 0, defaultConsultationListDays)
+	if value := c.Query("to"); value != "" {
+		parsed, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "to must be an RFC 3339 timestamp"})
+			return
+		}
+		to = parsed
+	}
+
+	response, err := h.consultationService.ListConsultations(c.Request.Context(), from, to)
+	if err != nil {
+		h.logger.Error("Failed to get consultations", zap.Error(err))
+		h.respondError(c, err, "Failed to get consultations")
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// respondError maps service errors to HTTP responses
+func (h *ConsultationHandler) respondError(c *gin.Context, err error, fallback string) {
+	switch {
+	case errors.Is(err, domainErrors.ErrAvailabilityNotConfigured):
+		c.JSON(http.StatusNotFound, gin.H{"error": "Consultation availability has not been configured"})
+	case errors.Is(err, domainErrors.ErrConsultationSlotUnavailable):
+		c.JSON(http.StatusConflict, gin.H{"error": "This time is no longer available, please pick another"})
+	case errors.Is(err, domainErrors.ErrValidationFailed):
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": fallback})
+	}
+}
@@ -0,0 +1,88 @@
+package handlers
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strings"
+
+	"super2025-backend/internal/infrastructure/file_storage"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// imageContentTypes are the formats stored for resized team and project images
+var imageContentTypes = map[string]string{
+	".jpg": "image/jpeg",
+	".png": "image/png",
+}
+
+// ImageHandler serves uploaded team and project images
+type ImageHandler struct {
+	imageStorage *file_storage.LocalStorage
+	logger       *zap.Logger
+}
+
+// NewImageHandler creates a new image handler
+func NewImageHandler(imageStorage *file_storage.LocalStorage, logger *zap.Logger) *ImageHandler {
+	return &ImageHandler{
+		imageStorage: imageStorage,
+		logger:       logger,
+	}
+}
+
+// GetImageFile handles GET /api/v1/files/images/:filename
+// Stored images are never overwritten, so they can be cached indefinitely.
+func (h *ImageHandler) GetImageFile(c *gin.Context) {
+	filename := c.Param("filename")
+	contentType, ok := imageContentTypes[strings.ToLower(filepath.Ext(filename))]
+	if !ok {
+		c.JSON(http.StatusNotFound, gin.H{"error": "File not found"})
+		return
+	}
+
+	file, size, err := h.imageStorage.ServeFile(filename)
+	if err != nil {
+		h.logger.Warn("Failed to serve image", zap.String("filename", filename), zap.Error(err))
+		c.JSON(http.StatusNotFound, gin.H{"error": "File not found"})
+		return
+	}
+	defer func() {
+		if closer, ok := file.(io.Closer); ok {
+			closer.Close()
+		}
+	}()
+
+	c.DataFromReader(http.StatusOK, size, contentType, file, map[string]string{
+		"Cache-Control":          "public, max-age=31536000, immutable",
+		"X-Content-Type-Options": "nosniff",
+	})
+}
+
+// readImageUpload reads the "image" file of a multipart upload. It responds with
+// 400 and returns false when the file is missing or larger than maxSize.
+func readImageUpload(c *gin.Context, maxSize int64) ([]byte, bool) {
+	// Leave room for the multipart framing around the file
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, maxSize+64*1024)
+
+	file, header, err := c.Request.FormFile("image")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("An image file of at most %d bytes is required", maxSize)})
+		return nil, false
+	}
+	defer file.Close()
+
+	if header.Size > maxSize {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("File size exceeds maximum allowed size of %d bytes", maxSize)})
+		return nil, false
+	}
+
+	content, err := io.ReadAll(file)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Failed to read image file"})
+		return nil, false
+	}
+	return content, true
+}
@@ -0,0 +1,125 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"super2025-backend/internal/application/dto"
+	"super2025-backend/internal/application/services"
+	"super2025-backend/internal/domain/entities"
+	domainErrors "super2025-backend/internal/domain/errors"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// InquiryHandler handles HTTP requests for contact form inquiries
+type InquiryHandler struct {
+	inquiryService *services.InquiryService
+	logger         *zap.Logger
+}
+
+// NewInquiryHandler creates a new inquiry handler
+func NewInquiryHandler(inquiryService *services.InquiryService, logger *zap.Logger) *InquiryHandler {
+	return &InquiryHandler{
+		inquiryService: inquiryService,
+		logger:         logger,
+	}
+}
+
+// CreateInquiry handles POST /api/v1/inquiries
+func (h *InquiryHandler) CreateInquiry(c *gin.Context) {
+	var req dto.CreateInquiryRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body"})
+		return
+	}
+
+	metadata := map[string]string{
+		"ip_address": c.ClientIP(),
+		"user_agent": c.GetHeader("User-Agent"),
+	}
+
+	if err := h.inquiryService.CreateInquiry(c.Request.Context(), &req, metadata); err != nil {
+		h.logger.Warn("Failed to create inquiry", zap.Error(err))
+		h.respondError(c, err, "Failed to send your message")
+		return
+	}
+
+	c.JSON(http.StatusAccepted, gin.H{"message": "Thank you for reaching out, we will get back to you shortly"})
+}
+
+// GetInquiries handles GET /api/v1/inquiries
+func (h *InquiryHandler) GetInquiries(c *gin.Context) {
+	page, err := strconv.Atoi(c.DefaultQuery("page", "1"))
+	if err != nil || page < 1 {
+		page = 1
+	}
+	pageSize, err := strconv.Atoi(c.DefaultQuery("page_size", "20"))
+	if err != nil || pageSize < 1 || pageSize > 100 {
+		pageSize = 20
+	}
+	status := entities.InquiryStatus(c.Query("status"))
+
+	response, err := h.inquiryService.ListInquiries(c.Request.Context(), status, page, pageSize)
+	if err != nil {
+		h.logger.Error("Failed to get inquiries", zap.Error(err))
+		h.respondError(c, err, "Failed to get inquiries")
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// GetInquiry handles GET /api/v1/inquiries/:id
+func (h *InquiryHandler) GetInquiry(c *gin.Context) {
+	id := c.Param("id")
+
+	response, err := h.inquiryService.GetInquiry(c.Request.Context(), id)
+	if err != nil {
+		h.logger.Error("Failed to get inquiry", zap.String("id", id), zap.Error(err))
+		h.respondError(c, err, "Failed to get inquiry")
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// UpdateInquiryStatus handles PUT /api/v1/inquiries/:id/status
+func (h *InquiryHandler) UpdateInquiryStatus(c *gin.Context) {
+	id := c.Param("id")
+	actor, ok := requireActor(c)
+	if !ok {
+		return
+	}
+
+	var req dto.UpdateInquiryStatusRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body"})
+		return
+	}
+
+	response, err := h.inquiryService.UpdateInquiryStatus(c.Request.Context(), id, actor, &req)
+	if err != nil {
+		h.logger.Error("Failed to update inquiry status", zap.String("id", id), zap.Error(err))
+		h.respondError(c, err, "Failed to update inquiry status")
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// respondError maps service errors to HTTP responses
+func (h *InquiryHandler) respondError(c *gin.Context, err error, fallback string) {
+	switch {
+	case errors.Is(err, domainErrors.ErrInquiryNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": "Inquiry not found"})
+	case errors.Is(err, domainErrors.ErrTooManyInquiries):
+		c.JSON(http.StatusTooManyRequests, gin.H{"error": "Too many messages sent, please try again later"})
+	case errors.Is(err, domainErrors.ErrValidationFailed):
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": fallback})
+	}
+}
@@ -0,0 +1,139 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"super2025-backend/internal/application/dto"
+	"super2025-backend/internal/application/services"
+	domainErrors "super2025-backend/internal/domain/errors"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// InterviewHandler handles HTTP requests for interview scheduling
+type InterviewHandler struct {
+	interviewService *services.InterviewService
+	logger           *zap.Logger
+}
+
+// NewInterviewHandler creates a new interview handler
+func NewInterviewHandler(interviewService *services.InterviewService, logger *zap.Logger) *InterviewHandler {
+	return &InterviewHandler{
+		interviewService: interviewService,
+		logger:           logger,
+	}
+}
+
+// ScheduleInterview handles POST /api/v1/applications/:id/interviews
+func (h *InterviewHandler) ScheduleInterview(c *gin.Context) {
+	applicationID := c.Param("id")
+	actor, ok := requireActor(c)
+	if !ok {
+		return
+	}
+
+	var req dto.ScheduleInterviewRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body"})
+		return
+	}
+
+	response, err := h.interviewService.ScheduleInterview(c.Request.Context(), applicationID, actor, &req)
+	if err != nil {
+		h.logger.Error("Failed to schedule interview", zap.String("application_id", applicationID), zap.Error(err))
+		h.respondError(c, err, "Failed to schedule interview")
+		return
+	}
+
+	c.JSON(http.StatusCreated, response)
+}
+
+// GetApplicationInterviews handles GET /api/v1/applications/:id/interviews
+func (h *InterviewHandler) GetApplicationInterviews(c *gin.Context) {
+	applicationID := c.Param("id")
+
+	response, err := h.interviewService.ListInterviews(c.Request.Context(), applicationID)
+	if err != nil {
+		h.logger.Error("Failed to get interviews", zap.String("application_id", applicationID), zap.Error(err))
+		h.respondError(c, err, "Failed to get interviews")
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// GetInterview handles GET /api/v1/interviews/:id
+func (h *InterviewHandler) GetInterview(c *gin.Context) {
+	id := c.Param("id")
+
+	response, err := h.interviewService.GetInterview(c.Request.Context(), id)
+	if err != nil {
+		h.logger.Error("Failed to get interview", zap.String("id", id), zap.Error(err))
+		h.respondError(c, err, "Failed to get interview")
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// UpdateInterview handles PUT /api/v1/interviews/:id
+func (h *InterviewHandler) UpdateInterview(c *gin.Context) {
+	id := c.Param("id")
+	actor, ok := requireActor(c)
+	if !ok {
+		return
+	}
+
+	var req dto.UpdateInterviewRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body"})
+		return
+	}
+
+	response, err := h.interviewService.UpdateInterview(c.Request.Context(), id, actor, &req)
+	if err != nil {
+		h.logger.Error("Failed to update interview", zap.String("id", id), zap.Error(err))
+		h.respondError(c, err, "Failed to update interview")
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// CancelInterview handles DELETE /api/v1/interviews/:id
+func (h *InterviewHandler) CancelInterview(c *gin.Context) {
+	id := c.Param("id")
+	actor, ok := requireActor(c)
+	if !ok {
+		return
+	}
+
+	response, err := h.interviewService.CancelInterview(c.Request.Context(), id, actor)
+	if err != nil {
+		h.logger.Error("Failed to cancel interview", zap.String("id", id), zap.Error(err))
+		h.respondError(c, err, "Failed to cancel interview")
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// respondError maps service errors to HTTP responses
+func (h *InterviewHandler) respondError(c *gin.Context, err error, fallback string) {
+	switch {
+	case errors.Is(err, domainErrors.ErrApplicationNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": "Application not found"})
+	case errors.Is(err, domainErrors.ErrInterviewNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": "Interview not found"})
+	case errors.Is(err, domainErrors.ErrInterviewCancelled):
+		c.JSON(http.StatusConflict, gin.H{"error": "Interview has been cancelled"})
+	case errors.Is(err, domainErrors.ErrInterviewerUnavailable):
+		c.JSON(http.StatusConflict, gin.H{"error": "An interviewer already has an interview at that time"})
+	case errors.Is(err, domainErrors.ErrValidationFailed):
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": fallback})
+	}
+}
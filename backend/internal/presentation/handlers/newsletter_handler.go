@@ -0,0 +1,146 @@
+package handlers
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"super2025-backend/internal/application/dto"
+	"super2025-backend/internal/application/services"
+	domainErrors "super2025-backend/internal/domain/errors"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// NewsletterHandler handles HTTP requests for newsletter subscriptions
+type NewsletterHandler struct {
+	newsletterService *services.NewsletterService
+	logger            *zap.Logger
+}
+
+// NewNewsletterHandler creates a new newsletter handler
+func NewNewsletterHandler(newsletterService *services.NewsletterService, logger *zap.Logger) *NewsletterHandler {
+	return &NewsletterHandler{
+		newsletterService: newsletterService,
+		logger:            logger,
+	}
+}
+
+// Subscribe handles POST /api/v1/subscriptions
+func (h *NewsletterHandler) Subscribe(c *gin.Context) {
+	var req dto.SubscribeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body"})
+		return
+	}
+
+	metadata := map[string]string{
+		"ip_address": c.ClientIP(),
+	}
+
+	if err := h.newsletterService.Subscribe(c.Request.Context(), &req, metadata); err != nil {
+		h.logger.Warn("Failed to subscribe", zap.Error(err))
+		h.respondError(c, err, "Failed to subscribe")
+		return
+	}
+
+	c.JSON(http.StatusAccepted, gin.H{"message": "Please check your inbox to confirm your subscription"})
+}
+
+// ConfirmSubscription handles POST /api/v1/subscriptions/confirm/:token
+func (h *NewsletterHandler) ConfirmSubscription(c *gin.Context) {
+	response, err := h.newsletterService.Confirm(c.Request.Context(), c.Param("token"))
+	if err != nil {
+		h.respondError(c, err, "Failed to confirm subscription")
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// GetSubscription handles GET /api/v1/subscriptions/unsubscribe/:token
+// It only shows the subscription; per RFC 8058 a GET must never unsubscribe.
+func (h *NewsletterHandler) GetSubscription(c *gin.Context) {
+	response, err := h.newsletterService.GetSubscription(c.Request.Context(), c.Param("token"))
+	if err != nil {
+		h.respondError(c, err, "Failed to load subscription")
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// Unsubscribe handles POST /api/v1/subscriptions/unsubscribe/:token
+// Mail clients call it for one-click unsubscribe with the form body
+// "List-Unsubscribe=One-Click"; the unsubscribe page calls it without a body.
+func (h *NewsletterHandler) Unsubscribe(c *gin.Context) {
+	response, err := h.newsletterService.Unsubscribe(c.Request.Context(), c.Param("token"))
+	if err != nil {
+		h.respondError(c, err, "Failed to unsubscribe")
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// ExportSubscribers handles GET /api/v1/subscriptions/export
+// It returns confirmed subscribers as CSV, optionally only those who chose ?topic=.
+func (h *NewsletterHandler) ExportSubscribers(c *gin.Context) {
+	rows, err := h.newsletterService.ExportConfirmed(c.Request.Context(), c.Query("topic"))
+	if err != nil {
+		h.logger.Error("Failed to export subscribers", zap.Error(err))
+		h.respondError(c, err, "Failed to export subscribers")
+		return
+	}
+
+	filename := fmt.Sprintf("subscribers-%s.csv", time.Now().UTC().Format("2006-01-02"))
+	c.Header("Content-Type", "text/csv; charset=utf-8")
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=\"%s\"", filename))
+	c.Status(http.StatusOK)
+
+	writer := csv.NewWriter(c.Writer)
+	writer.Write([]string{"email", "name", "topics", "confirmed_at", "unsubscribe_url"})
+	for _, row := range rows {
+		writer.Write([]string{
+			csvSafe(row.Email),
+			csvSafe(row.Name),
+			strings.Join(row.Topics, ";"),
+			row.ConfirmedAt.UTC().Format(time.RFC3339),
+			row.UnsubscribeURL,
+		})
+	}
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		h.logger.Error("Failed to write subscriber export", zap.Error(err))
+	}
+}
+
+// csvSafe stops spreadsheet applications from evaluating user-supplied values as formulas
+func csvSafe(value string) string {
+	if value != "" && strings.ContainsRune("=+-@\t\r", rune(value[0])) {
+		return "'" + value
+	}
+	return value
+}
+
+// respondError maps service errors to HTTP responses
+func (h *NewsletterHandler) respondError(c *gin.Context, err error, fallback string) {
+	switch {
+	case errors.Is(err, domainErrors.ErrInvalidLink):
+		c.JSON(http.StatusNotFound, gin.H{"error": "Subscription link is invalid"})
+	case errors.Is(err, domainErrors.ErrLinkExpired):
+		c.JSON(http.StatusGone, gin.H{"error": "Confirmation link has expired, please subscribe again"})
+	case errors.Is(err, domainErrors.ErrTooManySubscriptions):
+		c.JSON(http.StatusTooManyRequests, gin.H{"error": "Too many requests, please try again later"})
+	case errors.Is(err, domainErrors.ErrInvalidEmail):
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Please enter a valid email address"})
+	case errors.Is(err, domainErrors.ErrValidationFailed):
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": fallback})
+	}
+}
@@ -0,0 +1,146 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"super2025-backend/internal/application/dto"
+	"super2025-backend/internal/application/services"
+	domainErrors "super2025-backend/internal/domain/errors"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// OfferHandler handles HTTP requests for job offers
+type OfferHandler struct {
+	offerService *services.OfferService
+	logger       *zap.Logger
+}
+
+// NewOfferHandler creates a new offer handler
+func NewOfferHandler(offerService *services.OfferService, logger *zap.Logger) *OfferHandler {
+	return &OfferHandler{
+		offerService: offerService,
+		logger:       logger,
+	}
+}
+
+// CreateOffer handles POST /api/v1/applications/:id/offers
+func (h *OfferHandler) CreateOffer(c *gin.Context) {
+	applicationID := c.Param("id")
+	actor, ok := requireActor(c)
+	if !ok {
+		return
+	}
+
+	var req dto.CreateOfferRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body"})
+		return
+	}
+
+	response, err := h.offerService.CreateOffer(c.Request.Context(), applicationID, actor, &req)
+	if err != nil {
+		h.logger.Error("Failed to create offer", zap.String("application_id", applicationID), zap.Error(err))
+		h.respondError(c, err, "Failed to create offer")
+		return
+	}
+
+	c.JSON(http.StatusCreated, response)
+}
+
+// GetApplicationOffers handles GET /api/v1/applications/:id/offers
+func (h *OfferHandler) GetApplicationOffers(c *gin.Context) {
+	applicationID := c.Param("id")
+
+	response, err := h.offerService.ListOffers(c.Request.Context(), applicationID)
+	if err != nil {
+		h.logger.Error("Failed to get offers", zap.String("application_id", applicationID), zap.Error(err))
+		h.respondError(c, err, "Failed to get offers")
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// GetOffer handles GET /api/v1/offers/:id
+func (h *OfferHandler) GetOffer(c *gin.Context) {
+	id := c.Param("id")
+
+	response, err := h.offerService.GetOffer(c.Request.Context(), id)
+	if err != nil {
+		h.logger.Error("Failed to get offer", zap.String("id", id), zap.Error(err))
+		h.respondError(c, err, "Failed to get offer")
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// RescindOffer handles POST /api/v1/offers/:id/rescind
+func (h *OfferHandler) RescindOffer(c *gin.Context) {
+	id := c.Param("id")
+	actor, ok := requireActor(c)
+	if !ok {
+		return
+	}
+
+	response, err := h.offerService.RescindOffer(c.Request.Context(), id, actor)
+	if err != nil {
+		h.logger.Error("Failed to rescind offer", zap.String("id", id), zap.Error(err))
+		h.respondError(c, err, "Failed to rescind offer")
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// GetOfferLetter handles GET /api/v1/offer-responses/:token
+func (h *OfferHandler) GetOfferLetter(c *gin.Context) {
+	response, err := h.offerService.GetOfferLetter(c.Request.Context(), c.Param("token"))
+	if err != nil {
+		h.respondError(c, err, "Failed to load offer")
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// RespondToOffer handles POST /api/v1/offer-responses/:token
+func (h *OfferHandler) RespondToOffer(c *gin.Context) {
+	var req dto.RespondToOfferRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body"})
+		return
+	}
+
+	response, err := h.offerService.RespondToOffer(c.Request.Context(), c.Param("token"), &req)
+	if err != nil {
+		h.logger.Warn("Failed to respond to offer", zap.String("decision", string(req.Decision)), zap.Error(err))
+		h.respondError(c, err, "Failed to respond to offer")
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// respondError maps service errors to HTTP responses
+func (h *OfferHandler) respondError(c *gin.Context, err error, fallback string) {
+	switch {
+	case errors.Is(err, domainErrors.ErrApplicationNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": "Application not found"})
+	case errors.Is(err, domainErrors.ErrOfferNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": "Offer not found"})
+	case errors.Is(err, domainErrors.ErrOfferAlreadyPending):
+		c.JSON(http.StatusConflict, gin.H{"error": "This application already has a pending offer; rescind it first"})
+	case errors.Is(err, domainErrors.ErrOfferNotPending):
+		c.JSON(http.StatusConflict, gin.H{"error": "This offer is no longer open"})
+	case errors.Is(err, domainErrors.ErrOfferExpired):
+		c.JSON(http.StatusGone, gin.H{"error": "This offer has expired"})
+	case errors.Is(err, domainErrors.ErrValidationFailed):
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": fallback})
+	}
+}
@@ -0,0 +1,94 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"super2025-backend/internal/application/dto"
+	"super2025-backend/internal/application/services"
+	domainErrors "super2025-backend/internal/domain/errors"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// PortalHandler handles HTTP requests for the candidate status portal
+type PortalHandler struct {
+	portalService *services.PortalService
+	logger        *zap.Logger
+}
+
+// NewPortalHandler creates a new portal handler
+func NewPortalHandler(portalService *services.PortalService, logger *zap.Logger) *PortalHandler {
+	return &PortalHandler{
+		portalService: portalService,
+		logger:        logger,
+	}
+}
+
+// RequestLogin handles POST /api/v1/portal/login
+func (h *PortalHandler) RequestLogin(c *gin.Context) {
+	var req dto.PortalLoginRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body"})
+		return
+	}
+
+	if err := h.portalService.RequestLogin(c.Request.Context(), req.Email); err != nil {
+		h.respondError(c, err, "Failed to send login link")
+		return
+	}
+
+	// The same answer whether or not the email is known
+	c.JSON(http.StatusAccepted, gin.H{"message": "If we have applications for this email address, a login link is on its way"})
+}
+
+// CreateSession handles POST /api/v1/portal/sessions
+func (h *PortalHandler) CreateSession(c *gin.Context) {
+	var req dto.PortalSessionRequest
+	if err := c.ShouldBindJSON(&req); err != nil || req.Token == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body"})
+		return
+	}
+
+	response, err := h.portalService.Redeem(c.Request.Context(), req.Token)
+	if err != nil {
+		h.respondError(c, err, "Failed to log in")
+		return
+	}
+
+	c.JSON(http.StatusCreated, response)
+}
+
+// GetApplications handles GET /api/v1/portal/applications
+func (h *PortalHandler) GetApplications(c *gin.Context) {
+	sessionToken := strings.TrimSpace(strings.TrimPrefix(c.GetHeader("Authorization"), "Bearer "))
+	if sessionToken == "" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Portal session required"})
+		return
+	}
+
+	response, err := h.portalService.ListApplications(c.Request.Context(), sessionToken)
+	if err != nil {
+		h.respondError(c, err, "Failed to get applications")
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// respondError maps service errors to HTTP responses
+func (h *PortalHandler) respondError(c *gin.Context, err error, fallback string) {
+	switch {
+	case errors.Is(err, domainErrors.ErrInvalidEmail):
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid email address"})
+	case errors.Is(err, domainErrors.ErrPortalLoginInvalid):
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "This login link is invalid, expired or already used; please request a new one"})
+	case errors.Is(err, domainErrors.ErrPortalSessionInvalid):
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Your session has expired; please log in again"})
+	default:
+		h.logger.Error(fallback, zap.Error(err))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": fallback})
+	}
+}
@@ -0,0 +1,260 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"super2025-backend/internal/application/dto"
+	"super2025-backend/internal/application/services"
+	"super2025-backend/internal/domain/entities"
+	domainErrors "super2025-backend/internal/domain/errors"
+	"super2025-backend/internal/infrastructure/config"
+	"super2025-backend/internal/presentation/middleware"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// PositionHandler handles HTTP requests for job positions
+type PositionHandler struct {
+	positionService *services.PositionService
+	logger          *zap.Logger
+	config          *config.Config
+}
+
+// NewPositionHandler creates a new position handler
+func NewPositionHandler(
+	positionService *services.PositionService,
+	logger *zap.Logger,
+	config *config.Config,
+) *PositionHandler {
+	return &PositionHandler{
+		positionService: positionService,
+		logger:          logger,
+		config:          config,
+	}
+}
+
+// CreatePosition handles POST /api/v1/positions
+func (h *PositionHandler) CreatePosition(c *gin.Context) {
+	var req dto.CreatePositionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body"})
+		return
+	}
+
+	response, err := h.positionService.CreatePosition(c.Request.Context(), &req)
+	if err != nil {
+		h.logger.Error("Failed to create position", zap.Error(err))
+		h.respondError(c, err, "Failed to create position")
+		return
+	}
+
+	c.JSON(http.StatusCreated, response)
+}
+
+// GetPositions handles GET /api/v1/positions
+// Public callers only see open positions; admins may filter by any status.
+func (h *PositionHandler) GetPositions(c *gin.Context) {
+	page, err := strconv.Atoi(c.DefaultQuery("page", "1"))
+	if err != nil || page < 1 {
+		page = 1
+	}
+
+	pageSize, err := strconv.Atoi(c.DefaultQuery("page_size", "20"))
+	if err != nil || pageSize < 1 || pageSize > 100 {
+		pageSize = 20
+	}
+
+	req := &dto.ListPositionsRequest{
+		Status:         entities.PositionStatus(c.Query("status")),
+		Department:     c.Query("department"),
+		EmploymentType: entities.EmploymentType(c.Query("employment_type")),
+		Page:           page,
+		PageSize:       pageSize,
+	}
+	if !middleware.IsAdmin(c, h.config) {
+		req.Status = entities.PositionStatusOpen
+	}
+
+	response, err := h.positionService.ListPositions(c.Request.Context(), req)
+	if err != nil {
+		h.logger.Error("Failed to get positions", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get positions"})
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// GetPosition handles GET /api/v1/positions/:id
+func (h *PositionHandler) GetPosition(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid position ID"})
+		return
+	}
+
+	response, err := h.positionService.GetPosition(c.Request.Context(), id, middleware.IsAdmin(c, h.config))
+	if err != nil {
+		h.logger.Error("Failed to get position", zap.String("id", id), zap.Error(err))
+		h.respondError(c, err, "Failed to get position")
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// UpdatePosition handles PUT /api/v1/positions/:id
+func (h *PositionHandler) UpdatePosition(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid position ID"})
+		return
+	}
+
+	var req dto.UpdatePositionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body"})
+		return
+	}
+
+	response, err := h.positionService.UpdatePosition(c.Request.Context(), id, &req)
+	if err != nil {
+		h.logger.Error("Failed to update position", zap.String("id", id), zap.Error(err))
+		h.respondError(c, err, "Failed to update position")
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// DeletePosition handles DELETE /api/v1/positions/:id
+func (h *PositionHandler) DeletePosition(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid position ID"})
+		return
+	}
+
+	if err := h.positionService.DeletePosition(c.Request.Context(), id); err != nil {
+		h.logger.Error("Failed to delete position", zap.String("id", id), zap.Error(err))
+		h.respondError(c, err, "Failed to delete position")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Position deleted successfully"})
+}
+
+// OpenPosition handles POST /api/v1/positions/:id/open
+func (h *PositionHandler) OpenPosition(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid position ID"})
+		return
+	}
+
+	response, err := h.positionService.OpenPosition(c.Request.Context(), id)
+	if err != nil {
+		h.logger.Error("Failed to open position", zap.String("id", id), zap.Error(err))
+		h.respondError(c, err, "Failed to open position")
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// ClosePosition handles POST /api/v1/positions/:id/close
+func (h *PositionHandler) ClosePosition(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid position ID"})
+		return
+	}
+
+	// The body is optional
+	var req dto.ClosePositionRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body"})
+			return
+		}
+	}
+
+	response, err := h.positionService.ClosePosition(c.Request.Context(), id, &req)
+	if err != nil {
+		h.logger.Error("Failed to close position", zap.String("id", id), zap.Error(err))
+		h.respondError(c, err, "Failed to close position")
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// GetPositionClosures handles GET /api/v1/positions/:id/closures
+func (h *PositionHandler) GetPositionClosures(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid position ID"})
+		return
+	}
+
+	closures, err := h.positionService.ListPositionClosures(c.Request.Context(), id)
+	if err != nil {
+		h.logger.Error("Failed to get position closures", zap.String("id", id), zap.Error(err))
+		h.respondError(c, err, "Failed to get position closures")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"closures": closures})
+}
+
+// GetQuestions handles GET /api/v1/positions/:id/questions
+// It returns the custom questions of the position's application form.
+func (h *PositionHandler) GetQuestions(c *gin.Context) {
+	id := c.Param("id")
+
+	response, err := h.positionService.GetQuestions(c.Request.Context(), id, middleware.IsAdmin(c, h.config))
+	if err != nil {
+		h.logger.Error("Failed to get position questions", zap.String("id", id), zap.Error(err))
+		h.respondError(c, err, "Failed to get position questions")
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// ReplaceQuestions handles PUT /api/v1/positions/:id/questions
+func (h *PositionHandler) ReplaceQuestions(c *gin.Context) {
+	id := c.Param("id")
+
+	var req dto.ReplaceQuestionsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body"})
+		return
+	}
+
+	response, err := h.positionService.ReplaceQuestions(c.Request.Context(), id, &req)
+	if err != nil {
+		h.logger.Error("Failed to update position questions", zap.String("id", id), zap.Error(err))
+		h.respondError(c, err, "Failed to update position questions")
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// respondError maps service errors to HTTP responses
+func (h *PositionHandler) respondError(c *gin.Context, err error, fallback string) {
+	switch {
+	case errors.Is(err, domainErrors.ErrPositionNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": "Position not found"})
+	case errors.Is(err, domainErrors.ErrPositionAlreadyExists):
+		c.JSON(http.StatusConflict, gin.H{"error": "Position already exists"})
+	case errors.Is(err, domainErrors.ErrValidationFailed):
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": fallback})
+	}
+}
@@ -0,0 +1,134 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"super2025-backend/internal/application/dto"
+	"super2025-backend/internal/application/services"
+	domainErrors "super2025-backend/internal/domain/errors"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// PrivacyHandler handles HTTP requests for data subject requests and data retention
+type PrivacyHandler struct {
+	privacyService   *services.PrivacyService
+	retentionService *services.RetentionService
+	logger           *zap.Logger
+}
+
+// NewPrivacyHandler creates a new privacy handler
+func NewPrivacyHandler(privacyService *services.PrivacyService, retentionService *services.RetentionService, logger *zap.Logger) *PrivacyHandler {
+	return &PrivacyHandler{
+		privacyService:   privacyService,
+		retentionService: retentionService,
+		logger:           logger,
+	}
+}
+
+// ExportSubjectData handles POST /api/v1/privacy/exports. The email is sent in the
+// body rather than the URL so it does not end up in access logs.
+func (h *PrivacyHandler) ExportSubjectData(c *gin.Context) {
+	actor, ok := requireActor(c)
+	if !ok {
+		return
+	}
+
+	var req dto.ExportSubjectDataRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body"})
+		return
+	}
+
+	archive, err := h.privacyService.ExportSubjectData(c.Request.Context(), req.Email, actor)
+	if err != nil {
+		h.logger.Error("Failed to export subject data", zap.Error(err))
+		h.respondError(c, err, "Failed to export subject data")
+		return
+	}
+
+	filename := fmt.Sprintf("subject-access-%s.zip", time.Now().UTC().Format("2006-01-02"))
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=\"%s\"", filename))
+	c.Header("Cache-Control", "no-store")
+	c.Data(http.StatusOK, "application/zip", archive)
+}
+
+// EraseSubjectData handles POST /api/v1/privacy/erasures. Erasure cannot be undone;
+// the response is the receipt recording it.
+func (h *PrivacyHandler) EraseSubjectData(c *gin.Context) {
+	actor, ok := requireActor(c)
+	if !ok {
+		return
+	}
+
+	var req dto.EraseSubjectDataRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body"})
+		return
+	}
+
+	receipt, err := h.privacyService.EraseSubjectData(c.Request.Context(), req.Email, req.Reason, actor)
+	if err != nil {
+		h.logger.Error("Failed to erase subject data", zap.Error(err))
+		h.respondError(c, err, "Failed to erase subject data")
+		return
+	}
+
+	c.JSON(http.StatusCreated, receipt)
+}
+
+// ListErasureReceipts handles GET /api/v1/privacy/erasures, optionally filtered by ?email=
+func (h *PrivacyHandler) ListErasureReceipts(c *gin.Context) {
+	response, err := h.privacyService.ListErasureReceipts(c.Request.Context(), c.Query("email"))
+	if err != nil {
+		h.logger.Error("Failed to list erasure receipts", zap.Error(err))
+		h.respondError(c, err, "Failed to list erasure receipts")
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// VerifyErasureReceipts handles GET /api/v1/privacy/erasures/verify
+func (h *PrivacyHandler) VerifyErasureReceipts(c *gin.Context) {
+	response, err := h.privacyService.VerifyErasureReceipts(c.Request.Context())
+	if err != nil {
+		h.logger.Error("Failed to verify erasure receipts", zap.Error(err))
+		h.respondError(c, err, "Failed to verify erasure receipts")
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// PreviewRetention handles GET /api/v1/privacy/retention/preview
+func (h *PrivacyHandler) PreviewRetention(c *gin.Context) {
+	response, err := h.retentionService.Preview(c.Request.Context())
+	if err != nil {
+		h.logger.Error("Failed to preview retention", zap.Error(err))
+		h.respondError(c, err, "Failed to preview retention")
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// respondError maps service errors to HTTP responses
+func (h *PrivacyHandler) respondError(c *gin.Context, err error, fallback string) {
+	switch {
+	case errors.Is(err, domainErrors.ErrInvalidEmail):
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid email"})
+	case errors.Is(err, domainErrors.ErrValidationFailed):
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	case errors.Is(err, domainErrors.ErrCandidateNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": "No personal data is held for this email"})
+	case errors.Is(err, domainErrors.ErrErasureNotConfigured):
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": fallback})
+	}
+}
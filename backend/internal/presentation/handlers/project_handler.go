@@ -0,0 +1,181 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"super2025-backend/internal/application/dto"
+	"super2025-backend/internal/application/services"
+	domainErrors "super2025-backend/internal/domain/errors"
+	"super2025-backend/internal/infrastructure/config"
+	"super2025-backend/internal/presentation/middleware"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// ProjectHandler handles HTTP requests for the project showcase
+type ProjectHandler struct {
+	projectService *services.ProjectService
+	logger         *zap.Logger
+	config         *config.Config
+}
+
+// NewProjectHandler creates a new project handler
+func NewProjectHandler(
+	projectService *services.ProjectService,
+	logger *zap.Logger,
+	config *config.Config,
+) *ProjectHandler {
+	return &ProjectHandler{
+		projectService: projectService,
+		logger:         logger,
+		config:         config,
+	}
+}
+
+// CreateProject handles POST /api/v1/projects
+func (h *ProjectHandler) CreateProject(c *gin.Context) {
+	var req dto.CreateProjectRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body"})
+		return
+	}
+
+	response, err := h.projectService.CreateProject(c.Request.Context(), &req)
+	if err != nil {
+		h.logger.Error("Failed to create project", zap.Error(err))
+		h.respondError(c, err, "Failed to create project")
+		return
+	}
+
+	c.JSON(http.StatusCreated, response)
+}
+
+// GetProjects handles GET /api/v1/projects
+// Public callers only see published projects; admins see all of them.
+func (h *ProjectHandler) GetProjects(c *gin.Context) {
+	category := c.Query("category")
+
+	response, err := h.projectService.ListProjects(c.Request.Context(), category, middleware.IsAdmin(c, h.config))
+	if err != nil {
+		h.logger.Error("Failed to get projects", zap.Error(err))
+		h.respondError(c, err, "Failed to get projects")
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// GetProject handles GET /api/v1/projects/:slug
+func (h *ProjectHandler) GetProject(c *gin.Context) {
+	slug := c.Param("slug")
+
+	response, err := h.projectService.GetProject(c.Request.Context(), slug, middleware.IsAdmin(c, h.config))
+	if err != nil {
+		h.respondError(c, err, "Failed to get project")
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// UpdateProject handles PUT /api/v1/projects/:id
+func (h *ProjectHandler) UpdateProject(c *gin.Context) {
+	id := c.Param("id")
+
+	var req dto.UpdateProjectRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body"})
+		return
+	}
+
+	response, err := h.projectService.UpdateProject(c.Request.Context(), id, &req)
+	if err != nil {
+		h.logger.Error("Failed to update project", zap.String("id", id), zap.Error(err))
+		h.respondError(c, err, "Failed to update project")
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// ReorderProjects handles PUT /api/v1/projects/order
+func (h *ProjectHandler) ReorderProjects(c *gin.Context) {
+	var req dto.ReorderRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body"})
+		return
+	}
+
+	response, err := h.projectService.ReorderProjects(c.Request.Context(), &req)
+	if err != nil {
+		h.logger.Error("Failed to reorder projects", zap.Error(err))
+		h.respondError(c, err, "Failed to reorder projects")
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// UploadImage handles POST /api/v1/projects/:id/image
+func (h *ProjectHandler) UploadImage(c *gin.Context) {
+	id := c.Param("id")
+
+	content, ok := readImageUpload(c, h.config.FileStorage.MaxFileSize)
+	if !ok {
+		return
+	}
+
+	response, err := h.projectService.UploadImage(c.Request.Context(), id, content)
+	if err != nil {
+		h.logger.Error("Failed to upload project image", zap.String("id", id), zap.Error(err))
+		h.respondError(c, err, "Failed to upload image")
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// DeleteImage handles DELETE /api/v1/projects/:id/image
+func (h *ProjectHandler) DeleteImage(c *gin.Context) {
+	id := c.Param("id")
+
+	response, err := h.projectService.DeleteImage(c.Request.Context(), id)
+	if err != nil {
+		h.logger.Error("Failed to delete project image", zap.String("id", id), zap.Error(err))
+		h.respondError(c, err, "Failed to delete image")
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// DeleteProject handles DELETE /api/v1/projects/:id
+func (h *ProjectHandler) DeleteProject(c *gin.Context) {
+	id := c.Param("id")
+
+	if err := h.projectService.DeleteProject(c.Request.Context(), id); err != nil {
+		h.logger.Error("Failed to delete project", zap.String("id", id), zap.Error(err))
+		h.respondError(c, err, "Failed to delete project")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Project deleted successfully"})
+}
+
+// respondError maps service errors to HTTP responses
+func (h *ProjectHandler) respondError(c *gin.Context, err error, fallback string) {
+	switch {
+	case errors.Is(err, domainErrors.ErrProjectNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": "Project not found"})
+	case errors.Is(err, domainErrors.ErrProjectSlugTaken):
+		c.JSON(http.StatusConflict, gin.H{"error": "A project with this slug already exists"})
+	case errors.Is(err, domainErrors.ErrInvalidFileType):
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Only JPEG, PNG and GIF images are allowed"})
+	case errors.Is(err, domainErrors.ErrValidationFailed):
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": fallback})
+	}
+}
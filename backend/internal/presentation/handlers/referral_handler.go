@@ -0,0 +1,113 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"super2025-backend/internal/application/dto"
+	"super2025-backend/internal/application/services"
+	domainErrors "super2025-backend/internal/domain/errors"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// ReferralHandler handles HTTP requests for employee referral codes
+type ReferralHandler struct {
+	referralService *services.ReferralService
+	logger          *zap.Logger
+}
+
+// NewReferralHandler creates a new referral handler
+func NewReferralHandler(referralService *services.ReferralService, logger *zap.Logger) *ReferralHandler {
+	return &ReferralHandler{
+		referralService: referralService,
+		logger:          logger,
+	}
+}
+
+// CreateCode handles POST /api/v1/referral-codes
+func (h *ReferralHandler) CreateCode(c *gin.Context) {
+	actor, ok := requireActor(c)
+	if !ok {
+		return
+	}
+
+	var req dto.CreateReferralCodeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body"})
+		return
+	}
+
+	response, err := h.referralService.CreateCode(c.Request.Context(), &req, actor)
+	if err != nil {
+		h.logger.Error("Failed to create referral code", zap.Error(err))
+		h.respondError(c, err, "Failed to create referral code")
+		return
+	}
+
+	c.JSON(http.StatusCreated, response)
+}
+
+// GetCodes handles GET /api/v1/referral-codes
+func (h *ReferralHandler) GetCodes(c *gin.Context) {
+	activeOnly := c.Query("active") == "true"
+
+	response, err := h.referralService.ListCodes(c.Request.Context(), activeOnly)
+	if err != nil {
+		h.logger.Error("Failed to get referral codes", zap.Error(err))
+		h.respondError(c, err, "Failed to get referral codes")
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// GetCode handles GET /api/v1/referral-codes/:id
+func (h *ReferralHandler) GetCode(c *gin.Context) {
+	id := c.Param("id")
+
+	response, err := h.referralService.GetCode(c.Request.Context(), id)
+	if err != nil {
+		h.respondError(c, err, "Failed to get referral code")
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// UpdateCode handles PUT /api/v1/referral-codes/:id
+func (h *ReferralHandler) UpdateCode(c *gin.Context) {
+	id := c.Param("id")
+
+	var req dto.UpdateReferralCodeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body"})
+		return
+	}
+
+	response, err := h.referralService.UpdateCode(c.Request.Context(), id, &req)
+	if err != nil {
+		h.logger.Error("Failed to update referral code", zap.String("id", id), zap.Error(err))
+		h.respondError(c, err, "Failed to update referral code")
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// respondError maps service errors to HTTP responses
+func (h *ReferralHandler) respondError(c *gin.Context, err error, fallback string) {
+	switch {
+	case errors.Is(err, domainErrors.ErrReferralCodeNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": "Referral code not found"})
+	case errors.Is(err, domainErrors.ErrReferralCodeTaken):
+		c.JSON(http.StatusConflict, gin.H{"error": "This referral code is already in use"})
+	case errors.Is(err, domainErrors.ErrInvalidEmail):
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid employee email"})
+	case errors.Is(err, domainErrors.ErrValidationFailed):
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": fallback})
+	}
+}
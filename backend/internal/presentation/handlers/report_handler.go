@@ -0,0 +1,85 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"super2025-backend/internal/application/dto"
+	"super2025-backend/internal/application/services"
+	domainErrors "super2025-backend/internal/domain/errors"
+	"super2025-backend/internal/domain/repositories"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// ReportHandler handles HTTP requests for reports
+type ReportHandler struct {
+	reportService *services.ReportService
+	logger        *zap.Logger
+}
+
+// NewReportHandler creates a new report handler
+func NewReportHandler(reportService *services.ReportService, logger *zap.Logger) *ReportHandler {
+	return &ReportHandler{
+		reportService: reportService,
+		logger:        logger,
+	}
+}
+
+// GetTimeInStage handles GET /api/v1/reports/time-in-stage
+func (h *ReportHandler) GetTimeInStage(c *gin.Context) {
+	req := reportRequestFromQuery(c)
+
+	response, err := h.reportService.TimeInStage(c.Request.Context(), req)
+	if err != nil {
+		h.logger.Error("Failed to get time-in-stage report", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to build report"})
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// GetReferralBonuses handles GET /api/v1/reports/referral-bonuses
+// The date range applies to when each referred application was first offered.
+func (h *ReportHandler) GetReferralBonuses(c *gin.Context) {
+	req := reportRequestFromQuery(c)
+
+	response, err := h.reportService.ReferralBonuses(c.Request.Context(), req)
+	if err != nil {
+		h.logger.Error("Failed to get referral bonus report", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to build report"})
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// GetSourceEffectiveness handles GET /api/v1/reports/source-effectiveness
+func (h *ReportHandler) GetSourceEffectiveness(c *gin.Context) {
+	req := reportRequestFromQuery(c)
+	groupBy := repositories.SourceGrouping(c.Query("group_by"))
+
+	response, err := h.reportService.SourceEffectiveness(c.Request.Context(), req, groupBy)
+	if err != nil {
+		if errors.Is(err, domainErrors.ErrValidationFailed) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		h.logger.Error("Failed to get source effectiveness report", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to build report"})
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// reportRequestFromQuery reads the common report filters from the query string
+func reportRequestFromQuery(c *gin.Context) *dto.ReportRequest {
+	return &dto.ReportRequest{
+		PositionID: c.Query("position_id"),
+		DateFrom:   c.Query("date_from"),
+		DateTo:     c.Query("date_to"),
+	}
+}
@@ -0,0 +1,112 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"super2025-backend/internal/application/dto"
+	"super2025-backend/internal/application/services"
+	domainErrors "super2025-backend/internal/domain/errors"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// SchedulingHandler handles HTTP requests for candidate self-scheduling
+type SchedulingHandler struct {
+	schedulingService *services.SchedulingService
+	logger            *zap.Logger
+}
+
+// NewSchedulingHandler creates a new scheduling handler
+func NewSchedulingHandler(schedulingService *services.SchedulingService, logger *zap.Logger) *SchedulingHandler {
+	return &SchedulingHandler{
+		schedulingService: schedulingService,
+		logger:            logger,
+	}
+}
+
+// CreateInvitation handles POST /api/v1/applications/:id/scheduling-invitations
+func (h *SchedulingHandler) CreateInvitation(c *gin.Context) {
+	applicationID := c.Param("id")
+	actor, ok := requireActor(c)
+	if !ok {
+		return
+	}
+
+	var req dto.CreateSchedulingInvitationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body"})
+		return
+	}
+
+	response, err := h.schedulingService.CreateInvitation(c.Request.Context(), applicationID, actor, &req)
+	if err != nil {
+		h.logger.Error("Failed to create scheduling invitation", zap.String("application_id", applicationID), zap.Error(err))
+		h.respondError(c, err, "Failed to create scheduling invitation")
+		return
+	}
+
+	c.JSON(http.StatusCreated, response)
+}
+
+// GetInvitations handles GET /api/v1/applications/:id/scheduling-invitations
+func (h *SchedulingHandler) GetInvitations(c *gin.Context) {
+	applicationID := c.Param("id")
+
+	response, err := h.schedulingService.ListInvitations(c.Request.Context(), applicationID)
+	if err != nil {
+		h.logger.Error("Failed to get scheduling invitations", zap.String("application_id", applicationID), zap.Error(err))
+		h.respondError(c, err, "Failed to get scheduling invitations")
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// GetSchedulingPage handles GET /api/v1/scheduling/:token
+func (h *SchedulingHandler) GetSchedulingPage(c *gin.Context) {
+	response, err := h.schedulingService.GetSchedulingPage(c.Request.Context(), c.Param("token"))
+	if err != nil {
+		h.respondError(c, err, "Failed to load interview slots")
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// BookSlot handles POST /api/v1/scheduling/:token
+func (h *SchedulingHandler) BookSlot(c *gin.Context) {
+	var req dto.BookSlotRequest
+	if err := c.ShouldBindJSON(&req); err != nil || req.SlotID == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body"})
+		return
+	}
+
+	response, err := h.schedulingService.BookSlot(c.Request.Context(), c.Param("token"), &req)
+	if err != nil {
+		h.logger.Warn("Failed to book interview slot", zap.String("slot_id", req.SlotID), zap.Error(err))
+		h.respondError(c, err, "Failed to book interview slot")
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// respondError maps service errors to HTTP responses
+func (h *SchedulingHandler) respondError(c *gin.Context, err error, fallback string) {
+	switch {
+	case errors.Is(err, domainErrors.ErrApplicationNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": "Application not found"})
+	case errors.Is(err, domainErrors.ErrSchedulingLinkNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": "Scheduling link not found"})
+	case errors.Is(err, domainErrors.ErrSchedulingLinkExpired):
+		c.JSON(http.StatusGone, gin.H{"error": "This scheduling link has expired"})
+	case errors.Is(err, domainErrors.ErrSlotUnavailable):
+		c.JSON(http.StatusConflict, gin.H{"error": "This time is no longer available, please pick another"})
+	case errors.Is(err, domainErrors.ErrValidationFailed):
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": fallback})
+	}
+}
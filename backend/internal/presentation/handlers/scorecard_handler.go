@@ -0,0 +1,112 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"super2025-backend/internal/application/dto"
+	"super2025-backend/internal/application/services"
+	domainErrors "super2025-backend/internal/domain/errors"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// ScorecardHandler handles HTTP requests for interview scorecards
+type ScorecardHandler struct {
+	scorecardService *services.ScorecardService
+	logger           *zap.Logger
+}
+
+// NewScorecardHandler creates a new scorecard handler
+func NewScorecardHandler(scorecardService *services.ScorecardService, logger *zap.Logger) *ScorecardHandler {
+	return &ScorecardHandler{
+		scorecardService: scorecardService,
+		logger:           logger,
+	}
+}
+
+// SubmitScorecard handles POST /api/v1/applications/:id/scorecards
+func (h *ScorecardHandler) SubmitScorecard(c *gin.Context) {
+	applicationID := c.Param("id")
+	interviewer, ok := requireActor(c)
+	if !ok {
+		return
+	}
+
+	var req dto.SubmitScorecardRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body"})
+		return
+	}
+
+	response, err := h.scorecardService.SubmitScorecard(c.Request.Context(), applicationID, interviewer, &req)
+	if err != nil {
+		h.logger.Error("Failed to submit scorecard", zap.String("application_id", applicationID), zap.Error(err))
+		h.respondError(c, err, "Failed to submit scorecard")
+		return
+	}
+
+	c.JSON(http.StatusCreated, response)
+}
+
+// GetScorecards handles GET /api/v1/applications/:id/scorecards
+func (h *ScorecardHandler) GetScorecards(c *gin.Context) {
+	applicationID := c.Param("id")
+	viewer, ok := requireActor(c)
+	if !ok {
+		return
+	}
+
+	response, err := h.scorecardService.ListScorecards(c.Request.Context(), applicationID, viewer)
+	if err != nil {
+		h.logger.Error("Failed to get scorecards", zap.String("application_id", applicationID), zap.Error(err))
+		h.respondError(c, err, "Failed to get scorecards")
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// UpdateScorecard handles PUT /api/v1/applications/:id/scorecards/:scorecardId
+func (h *ScorecardHandler) UpdateScorecard(c *gin.Context) {
+	applicationID := c.Param("id")
+	scorecardID := c.Param("scorecardId")
+	interviewer, ok := requireActor(c)
+	if !ok {
+		return
+	}
+
+	var req dto.SubmitScorecardRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body"})
+		return
+	}
+
+	response, err := h.scorecardService.UpdateScorecard(c.Request.Context(), applicationID, scorecardID, interviewer, &req)
+	if err != nil {
+		h.logger.Error("Failed to update scorecard", zap.String("id", scorecardID), zap.Error(err))
+		h.respondError(c, err, "Failed to update scorecard")
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// respondError maps service errors to HTTP responses
+func (h *ScorecardHandler) respondError(c *gin.Context, err error, fallback string) {
+	switch {
+	case errors.Is(err, domainErrors.ErrApplicationNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": "Application not found"})
+	case errors.Is(err, domainErrors.ErrScorecardNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": "Scorecard not found"})
+	case errors.Is(err, domainErrors.ErrScorecardAlreadySubmitted):
+		c.JSON(http.StatusConflict, gin.H{"error": "You have already submitted a scorecard for this application; update it instead"})
+	case errors.Is(err, domainErrors.ErrForbidden):
+		c.JSON(http.StatusForbidden, gin.H{"error": "Only the interviewer who submitted this scorecard can change it"})
+	case errors.Is(err, domainErrors.ErrValidationFailed):
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": fallback})
+	}
+}
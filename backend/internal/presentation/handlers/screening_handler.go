@@ -0,0 +1,128 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"super2025-backend/internal/application/dto"
+	"super2025-backend/internal/application/services"
+	domainErrors "super2025-backend/internal/domain/errors"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// ScreeningHandler handles HTTP requests for automatic screening rules
+type ScreeningHandler struct {
+	screeningService *services.ScreeningService
+	logger           *zap.Logger
+}
+
+// NewScreeningHandler creates a new screening handler
+func NewScreeningHandler(screeningService *services.ScreeningService, logger *zap.Logger) *ScreeningHandler {
+	return &ScreeningHandler{
+		screeningService: screeningService,
+		logger:           logger,
+	}
+}
+
+// GetRules handles GET /api/v1/positions/:id/screening-rules
+func (h *ScreeningHandler) GetRules(c *gin.Context) {
+	positionID := c.Param("id")
+
+	response, err := h.screeningService.ListRules(c.Request.Context(), positionID)
+	if err != nil {
+		h.respondError(c, err, "Failed to get screening rules")
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// CreateRule handles POST /api/v1/positions/:id/screening-rules
+func (h *ScreeningHandler) CreateRule(c *gin.Context) {
+	actor, ok := requireActor(c)
+	if !ok {
+		return
+	}
+	positionID := c.Param("id")
+
+	var req dto.ScreeningRuleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body"})
+		return
+	}
+
+	response, err := h.screeningService.CreateRule(c.Request.Context(), positionID, &req, actor)
+	if err != nil {
+		h.logger.Error("Failed to create screening rule", zap.String("position_id", positionID), zap.Error(err))
+		h.respondError(c, err, "Failed to create screening rule")
+		return
+	}
+
+	c.JSON(http.StatusCreated, response)
+}
+
+// UpdateRule handles PUT /api/v1/positions/:id/screening-rules/:ruleId
+func (h *ScreeningHandler) UpdateRule(c *gin.Context) {
+	positionID := c.Param("id")
+	ruleID := c.Param("ruleId")
+
+	var req dto.ScreeningRuleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body"})
+		return
+	}
+
+	response, err := h.screeningService.UpdateRule(c.Request.Context(), positionID, ruleID, &req)
+	if err != nil {
+		h.logger.Error("Failed to update screening rule", zap.String("id", ruleID), zap.Error(err))
+		h.respondError(c, err, "Failed to update screening rule")
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// DeleteRule handles DELETE /api/v1/positions/:id/screening-rules/:ruleId
+func (h *ScreeningHandler) DeleteRule(c *gin.Context) {
+	positionID := c.Param("id")
+	ruleID := c.Param("ruleId")
+
+	if err := h.screeningService.DeleteRule(c.Request.Context(), positionID, ruleID); err != nil {
+		h.logger.Error("Failed to delete screening rule", zap.String("id", ruleID), zap.Error(err))
+		h.respondError(c, err, "Failed to delete screening rule")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Screening rule deleted successfully"})
+}
+
+// GetApplicationScreening handles GET /api/v1/applications/:id/screening
+func (h *ScreeningHandler) GetApplicationScreening(c *gin.Context) {
+	applicationID := c.Param("id")
+
+	response, err := h.screeningService.GetResults(c.Request.Context(), applicationID)
+	if err != nil {
+		h.respondError(c, err, "Failed to get screening results")
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// respondError maps service errors to HTTP responses
+func (h *ScreeningHandler) respondError(c *gin.Context, err error, fallback string) {
+	switch {
+	case errors.Is(err, domainErrors.ErrPositionNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": "Position not found"})
+	case errors.Is(err, domainErrors.ErrScreeningRuleNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": "Screening rule not found"})
+	case errors.Is(err, domainErrors.ErrApplicationNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": "Application not found"})
+	case errors.Is(err, domainErrors.ErrValidationFailed):
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": fallback})
+	}
+}
@@ -0,0 +1,88 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"super2025-backend/internal/application/dto"
+	"super2025-backend/internal/application/services"
+	domainErrors "super2025-backend/internal/domain/errors"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// TagHandler handles HTTP requests for application tags
+type TagHandler struct {
+	tagService *services.TagService
+	logger     *zap.Logger
+}
+
+// NewTagHandler creates a new tag handler
+func NewTagHandler(tagService *services.TagService, logger *zap.Logger) *TagHandler {
+	return &TagHandler{
+		tagService: tagService,
+		logger:     logger,
+	}
+}
+
+// GetTags handles GET /api/v1/tags
+func (h *TagHandler) GetTags(c *gin.Context) {
+	tags, err := h.tagService.ListTags(c.Request.Context())
+	if err != nil {
+		h.logger.Error("Failed to get tags", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get tags"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"tags": tags})
+}
+
+// AddTags handles POST /api/v1/applications/:id/tags
+func (h *TagHandler) AddTags(c *gin.Context) {
+	applicationID := c.Param("id")
+
+	var req dto.AddTagsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body"})
+		return
+	}
+
+	response, err := h.tagService.AddTags(c.Request.Context(), applicationID, &req)
+	if err != nil {
+		h.logger.Error("Failed to tag application", zap.String("application_id", applicationID), zap.Error(err))
+		h.respondError(c, err, "Failed to tag application")
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// RemoveTag handles DELETE /api/v1/applications/:id/tags/:tag
+func (h *TagHandler) RemoveTag(c *gin.Context) {
+	applicationID := c.Param("id")
+	tag := c.Param("tag")
+
+	response, err := h.tagService.RemoveTag(c.Request.Context(), applicationID, tag)
+	if err != nil {
+		h.logger.Error("Failed to untag application", zap.String("application_id", applicationID), zap.Error(err))
+		h.respondError(c, err, "Failed to remove tag")
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// respondError maps service errors to HTTP responses
+func (h *TagHandler) respondError(c *gin.Context, err error, fallback string) {
+	switch {
+	case errors.Is(err, domainErrors.ErrApplicationNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": "Application not found"})
+	case errors.Is(err, domainErrors.ErrTagNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": "Tag not found on this application"})
+	case errors.Is(err, domainErrors.ErrValidationFailed):
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": fallback})
+	}
+}
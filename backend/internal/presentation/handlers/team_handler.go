@@ -0,0 +1,182 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"super2025-backend/internal/application/dto"
+	"super2025-backend/internal/application/services"
+	"super2025-backend/internal/domain/entities"
+	domainErrors "super2025-backend/internal/domain/errors"
+	"super2025-backend/internal/infrastructure/config"
+	"super2025-backend/internal/presentation/middleware"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// TeamHandler handles HTTP requests for the team page
+type TeamHandler struct {
+	teamService *services.TeamService
+	logger      *zap.Logger
+	config      *config.Config
+}
+
+// NewTeamHandler creates a new team handler
+func NewTeamHandler(
+	teamService *services.TeamService,
+	logger *zap.Logger,
+	config *config.Config,
+) *TeamHandler {
+	return &TeamHandler{
+		teamService: teamService,
+		logger:      logger,
+		config:      config,
+	}
+}
+
+// CreateMember handles POST /api/v1/team
+func (h *TeamHandler) CreateMember(c *gin.Context) {
+	var req dto.CreateTeamMemberRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body"})
+		return
+	}
+
+	response, err := h.teamService.CreateMember(c.Request.Context(), &req)
+	if err != nil {
+		h.logger.Error("Failed to create team member", zap.Error(err))
+		h.respondError(c, err, "Failed to create team member")
+		return
+	}
+
+	c.JSON(http.StatusCreated, response)
+}
+
+// GetMembers handles GET /api/v1/team
+// Public callers only see published members; admins see everyone.
+func (h *TeamHandler) GetMembers(c *gin.Context) {
+	role := entities.TeamRole(c.Query("role"))
+
+	response, err := h.teamService.ListMembers(c.Request.Context(), role, middleware.IsAdmin(c, h.config))
+	if err != nil {
+		h.logger.Error("Failed to get team members", zap.Error(err))
+		h.respondError(c, err, "Failed to get team members")
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// GetMember handles GET /api/v1/team/:slug
+func (h *TeamHandler) GetMember(c *gin.Context) {
+	slug := c.Param("slug")
+
+	response, err := h.teamService.GetMember(c.Request.Context(), slug, middleware.IsAdmin(c, h.config))
+	if err != nil {
+		h.respondError(c, err, "Failed to get team member")
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// UpdateMember handles PUT /api/v1/team/:id
+func (h *TeamHandler) UpdateMember(c *gin.Context) {
+	id := c.Param("id")
+
+	var req dto.UpdateTeamMemberRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body"})
+		return
+	}
+
+	response, err := h.teamService.UpdateMember(c.Request.Context(), id, &req)
+	if err != nil {
+		h.logger.Error("Failed to update team member", zap.String("id", id), zap.Error(err))
+		h.respondError(c, err, "Failed to update team member")
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// ReorderMembers handles PUT /api/v1/team/order
+func (h *TeamHandler) ReorderMembers(c *gin.Context) {
+	var req dto.ReorderRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body"})
+		return
+	}
+
+	response, err := h.teamService.ReorderMembers(c.Request.Context(), &req)
+	if err != nil {
+		h.logger.Error("Failed to reorder team members", zap.Error(err))
+		h.respondError(c, err, "Failed to reorder team members")
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// UploadPhoto handles POST /api/v1/team/:id/photo
+func (h *TeamHandler) UploadPhoto(c *gin.Context) {
+	id := c.Param("id")
+
+	content, ok := readImageUpload(c, h.config.FileStorage.MaxFileSize)
+	if !ok {
+		return
+	}
+
+	response, err := h.teamService.UploadPhoto(c.Request.Context(), id, content)
+	if err != nil {
+		h.logger.Error("Failed to upload team member photo", zap.String("id", id), zap.Error(err))
+		h.respondError(c, err, "Failed to upload photo")
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// DeletePhoto handles DELETE /api/v1/team/:id/photo
+func (h *TeamHandler) DeletePhoto(c *gin.Context) {
+	id := c.Param("id")
+
+	response, err := h.teamService.DeletePhoto(c.Request.Context(), id)
+	if err != nil {
+		h.logger.Error("Failed to delete team member photo", zap.String("id", id), zap.Error(err))
+		h.respondError(c, err, "Failed to delete photo")
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// DeleteMember handles DELETE /api/v1/team/:id
+func (h *TeamHandler) DeleteMember(c *gin.Context) {
+	id := c.Param("id")
+
+	if err := h.teamService.DeleteMember(c.Request.Context(), id); err != nil {
+		h.logger.Error("Failed to delete team member", zap.String("id", id), zap.Error(err))
+		h.respondError(c, err, "Failed to delete team member")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Team member deleted successfully"})
+}
+
+// respondError maps service errors to HTTP responses
+func (h *TeamHandler) respondError(c *gin.Context, err error, fallback string) {
+	switch {
+	case errors.Is(err, domainErrors.ErrTeamMemberNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": "Team member not found"})
+	case errors.Is(err, domainErrors.ErrTeamMemberSlugTaken):
+		c.JSON(http.StatusConflict, gin.H{"error": "A team member with this slug already exists"})
+	case errors.Is(err, domainErrors.ErrInvalidFileType):
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Only JPEG, PNG and GIF images are allowed"})
+	case errors.Is(err, domainErrors.ErrValidationFailed):
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": fallback})
+	}
+}
@@ -0,0 +1,51 @@
+package middleware
+
+import (
+	"crypto/subtle"
+	"net/http"
+	"strings"
+
+	"super2025-backend/internal/infrastructure/config"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	// AdminKeyHeader is the header carrying the admin API key
+	AdminKeyHeader = "X-Admin-Key"
+
+	// ActorHeader identifies the staff member acting on an admin request (usually their email)
+	ActorHeader = "X-Actor"
+)
+
+// RequireAdmin rejects requests that do not carry the configured admin API key
+func RequireAdmin(cfg *config.Config) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !IsAdmin(c, cfg) {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Admin authentication required"})
+			return
+		}
+		c.Next()
+	}
+}
+
+// IsAdmin reports whether the request carries the configured admin API key.
+// An empty ADMIN_API_KEY disables admin access entirely.
+func IsAdmin(c *gin.Context, cfg *config.Config) bool {
+	expected := cfg.Security.AdminAPIKey
+	if expected == "" {
+		return false
+	}
+
+	provided := c.GetHeader(AdminKeyHeader)
+	if provided == "" {
+		provided = strings.TrimPrefix(c.GetHeader("Authorization"), "Bearer ")
+	}
+
+	return subtle.ConstantTimeCompare([]byte(provided), []byte(expected)) == 1
+}
+
+// Actor returns the staff member identified by the X-Actor header, if any
+func Actor(c *gin.Context) string {
+	return strings.TrimSpace(c.GetHeader(ActorHeader))
+}
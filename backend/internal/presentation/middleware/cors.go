@@ -36,6 +36,8 @@ func SetupCORS(cfg *config.Config) gin.HandlerFunc {
 		"Origin",
 		"Cache-Control",
 		"X-Requested-With",
+		AdminKeyHeader,
+		ActorHeader,
 	}
 	
 	corsConfig.ExposeHeaders = []string{
@@ -12,6 +12,26 @@ import (
 func SetupRoutes(
 	router *gin.Engine, 
 	applicationHandler *handlers.ApplicationHandler,
+	positionHandler *handlers.PositionHandler,
+	candidateHandler *handlers.CandidateHandler,
+	commentHandler *handlers.CommentHandler,
+	tagHandler *handlers.TagHandler,
+	interviewHandler *handlers.InterviewHandler,
+	schedulingHandler *handlers.SchedulingHandler,
+	scorecardHandler *handlers.ScorecardHandler,
+	offerHandler *handlers.OfferHandler,
+	portalHandler *handlers.PortalHandler,
+	inquiryHandler *handlers.InquiryHandler,
+	consultationHandler *handlers.ConsultationHandler,
+	blogHandler *handlers.BlogHandler,
+	teamHandler *handlers.TeamHandler,
+	projectHandler *handlers.ProjectHandler,
+	imageHandler *handlers.ImageHandler,
+	newsletterHandler *handlers.NewsletterHandler,
+	referralHandler *handlers.ReferralHandler,
+	screeningHandler *handlers.ScreeningHandler,
+	privacyHandler *handlers.PrivacyHandler,
+	reportHandler *handlers.ReportHandler,
 	cfg *config.Config,
 ) {
 	// Setup CORS middleware
@@ -46,13 +66,221 @@ func SetupRoutes(
 			applications.GET("", applicationHandler.GetApplications)
 			applications.GET("/:id", applicationHandler.GetApplication)
 			applications.PUT("/:id/status", applicationHandler.UpdateApplicationStatus)
+			applications.GET("/:id/timeline", middleware.RequireAdmin(cfg), applicationHandler.GetApplicationTimeline)
 			applications.DELETE("/:id", applicationHandler.DeleteApplication)
+
+			// Reviewer comments
+			comments := applications.Group("/:id/comments", middleware.RequireAdmin(cfg))
+			{
+				comments.GET("", commentHandler.GetComments)
+				comments.POST("", commentHandler.CreateComment)
+				comments.PUT("/:commentId", commentHandler.UpdateComment)
+				comments.DELETE("/:commentId", commentHandler.DeleteComment)
+			}
+
+			// Recruiter tags
+			applications.POST("/:id/tags", middleware.RequireAdmin(cfg), tagHandler.AddTags)
+			applications.DELETE("/:id/tags/:tag", middleware.RequireAdmin(cfg), tagHandler.RemoveTag)
+
+			// Interviews
+			applications.GET("/:id/interviews", middleware.RequireAdmin(cfg), interviewHandler.GetApplicationInterviews)
+			applications.POST("/:id/interviews", middleware.RequireAdmin(cfg), interviewHandler.ScheduleInterview)
+			applications.GET("/:id/scheduling-invitations", middleware.RequireAdmin(cfg), schedulingHandler.GetInvitations)
+			applications.POST("/:id/scheduling-invitations", middleware.RequireAdmin(cfg), schedulingHandler.CreateInvitation)
+
+			// Interview scorecards
+			scorecards := applications.Group("/:id/scorecards", middleware.RequireAdmin(cfg))
+			{
+				scorecards.GET("", scorecardHandler.GetScorecards)
+				scorecards.POST("", scorecardHandler.SubmitScorecard)
+				scorecards.PUT("/:scorecardId", scorecardHandler.UpdateScorecard)
+			}
+
+			// Offers
+			applications.GET("/:id/offers", middleware.RequireAdmin(cfg), offerHandler.GetApplicationOffers)
+			applications.POST("/:id/offers", middleware.RequireAdmin(cfg), offerHandler.CreateOffer)
+
+			// Screening rules that fired for the application
+			applications.GET("/:id/screening", middleware.RequireAdmin(cfg), screeningHandler.GetApplicationScreening)
+		}
+
+		// Interview routes
+		interviews := v1.Group("/interviews", middleware.RequireAdmin(cfg))
+		{
+			interviews.GET("/:id", interviewHandler.GetInterview)
+			interviews.PUT("/:id", interviewHandler.UpdateInterview)
+			interviews.DELETE("/:id", interviewHandler.CancelInterview)
+		}
+
+		// Offer routes
+		offers := v1.Group("/offers", middleware.RequireAdmin(cfg))
+		{
+			offers.GET("/:id", offerHandler.GetOffer)
+			offers.POST("/:id/rescind", offerHandler.RescindOffer)
+		}
+
+		// Candidate offer responses (public, authorized by the signed link)
+		offerResponses := v1.Group("/offer-responses")
+		{
+			offerResponses.GET("/:token", offerHandler.GetOfferLetter)
+			offerResponses.POST("/:token", offerHandler.RespondToOffer)
+		}
+
+		// Candidate withdrawals (public, authorized by the signed link)
+		withdrawals := v1.Group("/withdrawals")
+		{
+			withdrawals.GET("/:token", applicationHandler.GetWithdrawal)
+			withdrawals.POST("/:token", applicationHandler.WithdrawApplication)
+		}
+
+		// Candidate status portal (public; applications require a portal session)
+		portal := v1.Group("/portal")
+		{
+			portal.POST("/login", portalHandler.RequestLogin)
+			portal.POST("/sessions", portalHandler.CreateSession)
+			portal.GET("/applications", portalHandler.GetApplications)
+		}
+
+		// Candidate self-scheduling (public, authorized by the link token)
+		scheduling := v1.Group("/scheduling")
+		{
+			scheduling.GET("/:token", schedulingHandler.GetSchedulingPage)
+			scheduling.POST("/:token", schedulingHandler.BookSlot)
+		}
+
+		// Contact form inquiries (submitting is public, follow-up is admin)
+		inquiries := v1.Group("/inquiries")
+		{
+			inquiries.POST("", inquiryHandler.CreateInquiry)
+			inquiries.GET("", middleware.RequireAdmin(cfg), inquiryHandler.GetInquiries)
+			inquiries.GET("/:id", middleware.RequireAdmin(cfg), inquiryHandler.GetInquiry)
+			inquiries.PUT("/:id/status", middleware.RequireAdmin(cfg), inquiryHandler.UpdateInquiryStatus)
+		}
+
+		// Consultation booking (availability and bookings are admin, slots and booking are public)
+		consultations := v1.Group("/consultations")
+		{
+			consultations.GET("/slots", consultationHandler.GetSlots)
+			consultations.POST("", consultationHandler.BookConsultation)
+			consultations.GET("", middleware.RequireAdmin(cfg), consultationHandler.GetConsultations)
+			consultations.GET("/availability", middleware.RequireAdmin(cfg), consultationHandler.GetAvailability)
+			consultations.PUT("/availability", middleware.RequireAdmin(cfg), consultationHandler.UpdateAvailability)
+		}
+
+		// Blog routes (reading is public, admins also see drafts and manage posts)
+		blog := v1.Group("/blog")
+		{
+			blog.GET("/posts", blogHandler.GetPosts)
+			blog.GET("/posts/:slug", blogHandler.GetPost)
+			blog.GET("/categories", blogHandler.GetCategories)
+			blog.GET("/feed.rss", blogHandler.GetRSSFeed)
+			blog.GET("/feed.atom", blogHandler.GetAtomFeed)
+			blog.POST("/posts", middleware.RequireAdmin(cfg), blogHandler.CreatePost)
+			blog.PUT("/posts/:id", middleware.RequireAdmin(cfg), blogHandler.UpdatePost)
+			blog.DELETE("/posts/:id", middleware.RequireAdmin(cfg), blogHandler.DeletePost)
+		}
+
+		// Team page routes (reading is public, admins also see unpublished members)
+		team := v1.Group("/team")
+		{
+			team.GET("", teamHandler.GetMembers)
+			team.GET("/:slug", teamHandler.GetMember)
+			team.POST("", middleware.RequireAdmin(cfg), teamHandler.CreateMember)
+			team.PUT("/order", middleware.RequireAdmin(cfg), teamHandler.ReorderMembers)
+			team.PUT("/:id", middleware.RequireAdmin(cfg), teamHandler.UpdateMember)
+			team.DELETE("/:id", middleware.RequireAdmin(cfg), teamHandler.DeleteMember)
+			team.POST("/:id/photo", middleware.RequireAdmin(cfg), teamHandler.UploadPhoto)
+			team.DELETE("/:id/photo", middleware.RequireAdmin(cfg), teamHandler.DeletePhoto)
+		}
+
+		// Project showcase routes (reading is public, admins also see unpublished projects)
+		projects := v1.Group("/projects")
+		{
+			projects.GET("", projectHandler.GetProjects)
+			projects.GET("/:slug", projectHandler.GetProject)
+			projects.POST("", middleware.RequireAdmin(cfg), projectHandler.CreateProject)
+			projects.PUT("/order", middleware.RequireAdmin(cfg), projectHandler.ReorderProjects)
+			projects.PUT("/:id", middleware.RequireAdmin(cfg), projectHandler.UpdateProject)
+			projects.DELETE("/:id", middleware.RequireAdmin(cfg), projectHandler.DeleteProject)
+			projects.POST("/:id/image", middleware.RequireAdmin(cfg), projectHandler.UploadImage)
+			projects.DELETE("/:id/image", middleware.RequireAdmin(cfg), projectHandler.DeleteImage)
+		}
+
+		// Newsletter routes (subscribing, confirming and unsubscribing are public, export is admin)
+		subscriptions := v1.Group("/subscriptions")
+		{
+			subscriptions.POST("", newsletterHandler.Subscribe)
+			subscriptions.POST("/confirm/:token", newsletterHandler.ConfirmSubscription)
+			subscriptions.GET("/unsubscribe/:token", newsletterHandler.GetSubscription)
+			subscriptions.POST("/unsubscribe/:token", newsletterHandler.Unsubscribe)
+			subscriptions.GET("/export", middleware.RequireAdmin(cfg), newsletterHandler.ExportSubscribers)
+		}
+
+		// Position routes
+		positions := v1.Group("/positions")
+		{
+			positions.GET("", positionHandler.GetPositions)
+			positions.GET("/:id", positionHandler.GetPosition)
+			positions.POST("", middleware.RequireAdmin(cfg), positionHandler.CreatePosition)
+			positions.PUT("/:id", middleware.RequireAdmin(cfg), positionHandler.UpdatePosition)
+			positions.DELETE("/:id", middleware.RequireAdmin(cfg), positionHandler.DeletePosition)
+			positions.POST("/:id/open", middleware.RequireAdmin(cfg), positionHandler.OpenPosition)
+			positions.POST("/:id/close", middleware.RequireAdmin(cfg), positionHandler.ClosePosition)
+			positions.GET("/:id/closures", middleware.RequireAdmin(cfg), positionHandler.GetPositionClosures)
+			positions.GET("/:id/questions", positionHandler.GetQuestions)
+			positions.PUT("/:id/questions", middleware.RequireAdmin(cfg), positionHandler.ReplaceQuestions)
+
+			// Automatic screening rules
+			screening := positions.Group("/:id/screening-rules", middleware.RequireAdmin(cfg))
+			{
+				screening.GET("", screeningHandler.GetRules)
+				screening.POST("", screeningHandler.CreateRule)
+				screening.PUT("/:ruleId", screeningHandler.UpdateRule)
+				screening.DELETE("/:ruleId", screeningHandler.DeleteRule)
+			}
+		}
+
+		// Tag routes
+		v1.GET("/tags", middleware.RequireAdmin(cfg), tagHandler.GetTags)
+
+		// Employee referral codes
+		referralCodes := v1.Group("/referral-codes", middleware.RequireAdmin(cfg))
+		{
+			referralCodes.POST("", referralHandler.CreateCode)
+			referralCodes.GET("", referralHandler.GetCodes)
+			referralCodes.GET("/:id", referralHandler.GetCode)
+			referralCodes.PUT("/:id", referralHandler.UpdateCode)
+		}
+
+		// Candidate routes
+		candidates := v1.Group("/candidates", middleware.RequireAdmin(cfg))
+		{
+			candidates.GET("/:id", candidateHandler.GetCandidate)
+		}
+
+		// Data subject requests
+		privacy := v1.Group("/privacy", middleware.RequireAdmin(cfg))
+		{
+			privacy.POST("/exports", privacyHandler.ExportSubjectData)
+			privacy.POST("/erasures", privacyHandler.EraseSubjectData)
+			privacy.GET("/erasures", privacyHandler.ListErasureReceipts)
+			privacy.GET("/erasures/verify", privacyHandler.VerifyErasureReceipts)
+			privacy.GET("/retention/preview", privacyHandler.PreviewRetention)
+		}
+
+		// Report routes
+		reports := v1.Group("/reports")
+		{
+			reports.GET("/time-in-stage", middleware.RequireAdmin(cfg), reportHandler.GetTimeInStage)
+			reports.GET("/referral-bonuses", middleware.RequireAdmin(cfg), reportHandler.GetReferralBonuses)
+			reports.GET("/source-effectiveness", middleware.RequireAdmin(cfg), reportHandler.GetSourceEffectiveness)
 		}
 
 		// File serving routes
 		files := v1.Group("/files")
 		{
 			files.GET("/resumes/:filename", applicationHandler.GetResumeFile)
+			files.GET("/images/:filename", imageHandler.GetImageFile)
 		}
 
 		// Test routes (for development/debugging)